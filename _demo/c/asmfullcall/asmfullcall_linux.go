@@ -27,4 +27,37 @@ func verify() {
 		"b": 17,
 	})
 	check(42, int(res2))
+
+	// float operands
+	var root float64
+	asmFull("sqrtsd {x}, {r}", map[string]any{
+		"=r": &root,
+		"x":  16.0,
+	})
+	check(4, int(root))
+
+	// vector operands
+	var roots [4]float32
+	asmFull("sqrtps {x}, {r}", map[string]any{
+		"=r": &roots,
+		"x":  [4]float32{1, 4, 9, 16},
+	})
+	check(10, int(roots[0]+roots[1]+roots[2]+roots[3]))
+
+	// memory operand & clobbers
+	asmFull("movq {value}, {mem}", map[string]any{
+		"*mem":  &testVar,
+		"value": 44,
+		"~":     "memory",
+	})
+	check(44, testVar)
+
+	// multiple outputs
+	var lo, hi int
+	asmFull("movq {a}, {lo}\n\tleaq 1({a}), {hi}", map[string]any{
+		"=lo": &lo,
+		"=hi": &hi,
+		"a":   20,
+	})
+	check(41, lo+hi)
 }
@@ -28,4 +28,29 @@ func verify() {
 		"b": 17,
 	})
 	check(42, int(res2))
+
+	// float operands
+	var root float64
+	asmFull("fsqrt {r}, {x}", map[string]any{
+		"=r": &root,
+		"x":  16.0,
+	})
+	check(4, int(root))
+
+	// memory operand & clobbers
+	asmFull("str {value}, {mem}", map[string]any{
+		"*mem":  &testVar,
+		"value": 44,
+		"~":     "memory",
+	})
+	check(44, testVar)
+
+	// multiple outputs
+	var lo, hi int
+	asmFull("mov {lo}, {a}\n\tadd {hi}, {a}, #1", map[string]any{
+		"=lo": &lo,
+		"=hi": &hi,
+		"a":   20,
+	})
+	check(41, lo+hi)
 }
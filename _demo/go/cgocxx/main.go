@@ -0,0 +1,20 @@
+package main
+
+/*
+#cgo CPPFLAGS: -DSCALE=10
+#cgo CXXFLAGS: -std=c++17
+#include "sum.h"
+*/
+import "C"
+
+import "fmt"
+
+func main() {
+	nums := []C.int{1, 2, 3, 4}
+	sum := C.sum_scaled(&nums[0], C.int(len(nums)))
+	fmt.Println("sum_scaled:", sum)
+	fmt.Println("asm_twice:", C.asm_twice(21))
+	if sum != 100 || C.asm_twice(21) != 42 {
+		panic("cgocxx: wrong results")
+	}
+}
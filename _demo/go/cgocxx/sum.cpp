@@ -0,0 +1,9 @@
+#include <numeric>
+#include <vector>
+
+#include "sum.h"
+
+int sum_scaled(const int* nums, int n) {
+    std::vector<int> v(nums, nums + n);
+    return std::accumulate(v.begin(), v.end(), 0) * SCALE;
+}
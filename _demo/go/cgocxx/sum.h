@@ -0,0 +1,18 @@
+#ifndef CGOCXX_SUM_H
+#define CGOCXX_SUM_H
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+// sum_scaled returns the sum of the n numbers at nums times SCALE.
+int sum_scaled(const int* nums, int n);
+
+// asm_twice returns 2*x.
+int asm_twice(int x);
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif
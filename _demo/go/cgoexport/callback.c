@@ -0,0 +1,49 @@
+#include <pthread.h>
+#include <unistd.h>
+
+#include "_cgo_export.h"
+
+static void *worker(void *arg) {
+	struct point p = {3, 4};
+	*(int *)arg = goScale(&p, 4);
+	return NULL;
+}
+
+int run_threads(int n) {
+	pthread_t threads[16];
+	int results[16];
+	int total = 0;
+	if (n > 16) {
+		n = 16;
+	}
+	for (int i = 0; i < n; i++) {
+		pthread_create(&threads[i], NULL, worker, &results[i]);
+	}
+	for (int i = 0; i < n; i++) {
+		pthread_join(threads[i], NULL);
+		total += results[i];
+	}
+	return total;
+}
+
+int sum_point(struct point p) {
+	return goDouble(p.x) + goDouble(p.y);
+}
+
+static void *sender(void *arg) {
+	int n = *(int *)arg;
+	for (int i = 0; i < n; i++) {
+		goSend(i);
+		// outlast the deadlock detector while main waits for the next value
+		usleep(200 * 1000);
+	}
+	return NULL;
+}
+
+void start_sender(int n) {
+	static int count;
+	pthread_t thread;
+	count = n;
+	pthread_create(&thread, NULL, sender, &count);
+	pthread_detach(thread);
+}
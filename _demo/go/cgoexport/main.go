@@ -0,0 +1,62 @@
+package main
+
+/*
+#cgo LDFLAGS: -lpthread
+#include <stdint.h>
+
+struct point { int x; int y; };
+
+int run_threads(int n);
+int sum_point(struct point p);
+void start_sender(int n);
+*/
+import "C"
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+var calls atomic.Int32
+
+//export goScale
+func goScale(p *C.struct_point, n C.int) C.int {
+	calls.Add(1)
+	// allocate on the foreign thread to exercise the collector
+	parts := make([]string, 0, int(n))
+	for i := 0; i < int(n); i++ {
+		parts = append(parts, fmt.Sprint(int(p.x)+int(p.y)))
+	}
+	return C.int(len(strings.Join(parts, "+")))
+}
+
+var sent = make(chan int)
+
+//export goSend
+func goSend(v C.int) {
+	sent <- int(v)
+}
+
+//export goDouble
+func goDouble(v C.int) C.int {
+	return v * 2
+}
+
+func main() {
+	fmt.Println("sum_point:", C.sum_point(C.struct_point{x: 3, y: 4}))
+	total := C.run_threads(4)
+	fmt.Println("run_threads:", total, "calls:", calls.Load())
+	if total != 4*7 || calls.Load() != 4 {
+		panic("cgoexport: wrong results")
+	}
+
+	// main blocks on values sent by a C thread: not a deadlock
+	C.start_sender(3)
+	for i := 0; i < 3; i++ {
+		if v := <-sent; v != i {
+			panic("cgoexport: wrong value sent")
+		}
+	}
+	fmt.Println("sent: 3")
+}
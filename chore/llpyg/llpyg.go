@@ -185,25 +185,32 @@ func (ctx *context) genParams(pkg *gogen.Package, sig string) (*types.Tuple, boo
 	}
 	n := len(args)
 	objPtr := ctx.objPtr
-	list := make([]*types.Var, 0, n)
+	list := make([]*types.Var, 0, n+2)
+	kwOnly, kwargs, variadic := false, false, false
 	for i := 0; i < n; i++ {
 		name := args[i].Name
-		if name == "/" {
-			continue
-		}
-		if name == "*" || name == "\\*" {
-			break
-		}
-		if strings.HasPrefix(name, "*") {
-			if name[1] != '*' {
-				list = append(list, ssa.VArg())
-				return types.NewTuple(list...), true
-			}
-			return types.NewTuple(list...), false
+		switch {
+		case name == "/":
+		case name == "*" || name == "\\*":
+			kwOnly = true
+		case strings.HasPrefix(name, "**"):
+			kwargs = true
+		case strings.HasPrefix(name, "*"):
+			kwOnly, variadic = true, true
+		case kwOnly:
+			// keyword-only parameters are passed by the kwargs dict
+			kwargs = true
+		default:
+			list = append(list, pkg.NewParam(0, genName(name, 0), objPtr))
 		}
-		list = append(list, pkg.NewParam(0, genName(name, 0), objPtr))
 	}
-	return types.NewTuple(list...), false
+	if kwargs {
+		list = append(list, pkg.NewParam(0, ssa.NamePyKwargs, objPtr))
+	}
+	if variadic {
+		list = append(list, ssa.VArg())
+	}
+	return types.NewTuple(list...), variadic
 }
 
 func genName(name string, idxDontTitle int) string {
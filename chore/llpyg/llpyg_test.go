@@ -0,0 +1,57 @@
+//go:build !llgo
+// +build !llgo
+
+/*
+ * Copyright (c) 2024 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/goplus/gogen"
+	"github.com/goplus/llgo/ssa"
+)
+
+func TestGenParams(t *testing.T) {
+	pkg := gogen.NewPackage("", "foo", nil)
+	obj := types.NewNamed(types.NewTypeName(0, pkg.Types, "Object", nil), types.Typ[types.Int], nil)
+	ctx := &context{pkg: pkg, obj: obj, objPtr: types.NewPointer(obj)}
+	cases := []struct {
+		sig      string
+		names    string
+		variadic bool
+	}{
+		{"(a, b=1)", "a b", false},
+		{"(a, /, b)", "a b", false},
+		{"(a, *, key=None)", "a " + ssa.NamePyKwargs, false},
+		{"(a, **kwargs)", "a " + ssa.NamePyKwargs, false},
+		{"(a, *args)", "a " + ssa.NameValist, true},
+		{"(a, *args, key=None, **kw)", "a " + ssa.NamePyKwargs + " " + ssa.NameValist, true},
+	}
+	for _, c := range cases {
+		params, variadic := ctx.genParams(pkg, c.sig)
+		names := make([]string, params.Len())
+		for i := range names {
+			names[i] = params.At(i).Name()
+		}
+		if got := strings.Join(names, " "); got != c.names || variadic != c.variadic {
+			t.Errorf("genParams(%q) = (%s), %v; want (%s), %v", c.sig, got, variadic, c.names, c.variadic)
+		}
+	}
+}
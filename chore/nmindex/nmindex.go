@@ -64,6 +64,7 @@ func makeIndex() {
 		usrLib(true),
 		stdLib("LLGO_STDROOT"),
 		stdLib("LLGO_USRROOT"),
+		stdLib("LLGO_ROOT"),
 		pythonLib(),
 	}
 	err := b.Index(libDirs, idxDir, func(path string) {
@@ -89,9 +90,9 @@ func query(q string) {
 }
 
 func indexDir() string {
-	home, err := os.UserHomeDir()
+	dir, err := nmindex.DefaultDir()
 	check(err)
-	return home + "/.llgo/nmindex"
+	return dir
 }
 
 func stdLib(where string) string {
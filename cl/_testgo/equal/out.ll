@@ -326,9 +326,9 @@ _llgo_0:
   %4 = insertvalue %"github.com/goplus/llgo/runtime/internal/runtime.Slice" undef, ptr %0, 0
   %5 = insertvalue %"github.com/goplus/llgo/runtime/internal/runtime.Slice" %4, i64 3, 1
   %6 = insertvalue %"github.com/goplus/llgo/runtime/internal/runtime.Slice" %5, i64 3, 2
-  %7 = call ptr @"github.com/goplus/llgo/runtime/internal/runtime.AllocZ"(i64 16)
+  %7 = call ptr @"github.com/goplus/llgo/runtime/internal/runtime.AllocZNoScan"(i64 16)
   %8 = call %"github.com/goplus/llgo/runtime/internal/runtime.Slice" @"github.com/goplus/llgo/runtime/internal/runtime.NewSlice3"(ptr %7, i64 8, i64 2, i64 0, i64 2, i64 2)
-  %9 = call ptr @"github.com/goplus/llgo/runtime/internal/runtime.AllocZ"(i64 16)
+  %9 = call ptr @"github.com/goplus/llgo/runtime/internal/runtime.AllocZNoScan"(i64 16)
   %10 = call %"github.com/goplus/llgo/runtime/internal/runtime.Slice" @"github.com/goplus/llgo/runtime/internal/runtime.NewSlice3"(ptr %9, i64 8, i64 2, i64 0, i64 0, i64 2)
   call void @"github.com/goplus/llgo/cl/_testgo/equal.assert"(i1 true)
   %11 = extractvalue %"github.com/goplus/llgo/runtime/internal/runtime.Slice" %6, 0
@@ -488,6 +488,8 @@ declare i1 @"github.com/goplus/llgo/runtime/internal/runtime.StringEqual"(%"gith
 
 declare i1 @"github.com/goplus/llgo/runtime/internal/runtime.EfaceEqual"(%"github.com/goplus/llgo/runtime/internal/runtime.eface", %"github.com/goplus/llgo/runtime/internal/runtime.eface")
 
+declare ptr @"github.com/goplus/llgo/runtime/internal/runtime.AllocZNoScan"(i64)
+
 declare %"github.com/goplus/llgo/runtime/internal/runtime.Slice" @"github.com/goplus/llgo/runtime/internal/runtime.NewSlice3"(ptr, i64, i64, i64, i64, i64)
 
 declare i1 @"github.com/goplus/llgo/runtime/internal/runtime.memequal0"(ptr, ptr)
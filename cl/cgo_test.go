@@ -107,6 +107,11 @@ func _Cfunc_add(a int32, b int32) int32 {
 	if strings.Contains(ir, "cliteErrno") {
 		t.Fatalf("unexpected cliteErrno in Cfunc wrapper, got:\n%s", ir)
 	}
+	enter := strings.Index(ir, "runtime.EnterBlocking")
+	exit := strings.Index(ir, "runtime.ExitBlocking")
+	if enter < 0 || exit < enter {
+		t.Fatalf("expected the C call between EnterBlocking and ExitBlocking, got:\n%s", ir)
+	}
 }
 
 func TestCgoInstr_C2func(t *testing.T) {
@@ -28,6 +28,7 @@ import (
 	"strings"
 
 	"github.com/goplus/llgo/cl/blocks"
+	"github.com/goplus/llgo/internal/env"
 	"github.com/goplus/llgo/internal/goembed"
 	"github.com/goplus/llgo/internal/typepatch"
 	"golang.org/x/tools/go/ssa"
@@ -276,6 +277,20 @@ func isCgoExternSymbol(f *ssa.Function) bool {
 	return isCgoCfunc(name) || isCgoCmacro(name) || isCgoC2func(name)
 }
 
+// isCgoCallback reports whether the function named link is exported to C
+// for code outside the runtime, which may call it on threads of its own.
+func isCgoCallback(pkg llssa.Package, link string) bool {
+	if path := pkg.Path(); path == "runtime" || strings.HasPrefix(path, env.LLGoRuntimePkg+"/") {
+		return false
+	}
+	for _, export := range pkg.ExportFuncs() {
+		if export == link {
+			return true
+		}
+	}
+	return false
+}
+
 func isCgoCfpvar(name string) bool {
 	return strings.HasPrefix(name, "_Cfpvar_")
 }
@@ -496,6 +511,9 @@ func (p *context) compileBlock(b llssa.Builder, block *ssa.BasicBlock, n int, do
 	if enableDbgSyms && block.Parent().Origin() == nil && block.Index == 0 {
 		p.debugParams(b, block.Parent())
 	}
+	if block.Index == 0 && prog.CgoCallbacks() && isCgoCallback(pkg, fn.Name()) {
+		b.CgoCallback()
+	}
 
 	if doModInit {
 		if p.state != pkgInPatch {
@@ -800,7 +818,11 @@ func (p *context) compileInstrOrValue(b llssa.Builder, iv instrOrValue, asValue
 			return
 		}
 		elem := p.type_(t.Elem(), llssa.InGo)
-		ret = b.Alloc(elem, v.Heap)
+		if v.Heap && v.Comment == "makeslice" {
+			ret = b.MakeArray(elem)
+		} else {
+			ret = b.Alloc(elem, v.Heap)
+		}
 	case *ssa.IndexAddr:
 		vx := v.X
 		if _, ok := p.isVArgs(vx); ok { // varargs: this is a varargs index
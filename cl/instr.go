@@ -23,6 +23,7 @@ import (
 	"go/types"
 	"log"
 	"regexp"
+	"sort"
 	"strings"
 
 	"golang.org/x/tools/go/ssa"
@@ -30,7 +31,7 @@ import (
 	llssa "github.com/goplus/llgo/ssa"
 )
 
-var asmRegisterRegex = regexp.MustCompile(`\{[a-zA-Z]+\}`)
+var asmRegisterRegex = regexp.MustCompile(`\{[a-zA-Z_]+\}`)
 
 // -----------------------------------------------------------------------------
 
@@ -88,7 +89,12 @@ func (p *context) asm(b llssa.Builder, args []ssa.Value) (ret llssa.Expr) {
 		return llssa.Expr{Type: b.Prog.Void()}
 	}
 
+	// operands are keyed by name: "name" is an input, "*name" the memory
+	// pointed to by an input, "=name" an output stored to a pointer, and
+	// "~" a comma-separated list of clobbered registers.
 	registers := make(map[string]llssa.Expr)
+	kinds := make(map[string]byte)
+	var clobbers []string
 	if registerMap, ok := args[1].(*ssa.MakeMap); ok {
 		referrers := registerMap.Referrers()
 		for _, r := range *referrers {
@@ -103,8 +109,34 @@ func (p *context) asm(b llssa.Builder, args []ssa.Value) (ret llssa.Expr) {
 				if !ok {
 					panic("asm: register key must be a string constant")
 				}
-				llvmValue := p.compileValue(b, r.Value.(*ssa.MakeInterface).X)
-				registers[key] = llvmValue
+				x := r.Value.(*ssa.MakeInterface).X
+				if key == "~" {
+					list, ok := constStr(x)
+					if !ok {
+						panic("asm: clobber list must be a string constant")
+					}
+					for _, c := range strings.Split(list, ",") {
+						if c = strings.TrimSpace(c); c != "" {
+							clobbers = append(clobbers, c)
+						}
+					}
+					continue
+				}
+				name, kind := key, byte(0)
+				if key != "" && (key[0] == '=' || key[0] == '*') {
+					name, kind = key[1:], key[0]
+				}
+				if _, ok := registers[name]; ok {
+					panic(fmt.Sprintf("asm: duplicate operand: %s", name))
+				}
+				value := p.compileValue(b, x)
+				if kind == '=' {
+					if _, ok := value.Type.RawType().Underlying().(*types.Pointer); !ok {
+						panic(fmt.Sprintf("asm: output %s must be a pointer to a variable", name))
+					}
+				}
+				registers[name] = value
+				kinds[name] = kind
 			default:
 				panic(fmt.Sprintf("asm: don't know how to handle argument to inline assembly: %s", r.String()))
 			}
@@ -113,16 +145,26 @@ func (p *context) asm(b llssa.Builder, args []ssa.Value) (ret llssa.Expr) {
 
 	finalAsm := asmString
 	var hasOutput bool
-	var inputValues []llssa.Expr
-	var constraints []string
+	var outputs, inputs []llssa.AsmOperand
 	registerNumbers := map[string]int{}
 
 	if strings.Contains(finalAsm, "{}") {
 		finalAsm = strings.ReplaceAll(finalAsm, "{}", "$0")
-		constraints = append(constraints, "=&r")
+		outputs = append(outputs, llssa.AsmOperand{Type: b.Prog.Uintptr(), Early: true})
 		registerNumbers[""] = 0
 		hasOutput = true
 	}
+	var outNames []string
+	for name, kind := range kinds {
+		if kind == '=' {
+			outNames = append(outNames, name)
+		}
+	}
+	sort.Strings(outNames)
+	for _, name := range outNames {
+		registerNumbers[name] = len(outputs)
+		outputs = append(outputs, llssa.AsmOperand{Name: name, Type: b.Prog.Elem(registers[name].Type)})
+	}
 
 	finalAsm = asmRegisterRegex.ReplaceAllStringFunc(finalAsm, func(s string) string {
 		// TODO: skip strings like {r4} etc. that look like ARM push/pop
@@ -133,33 +175,27 @@ func (p *context) asm(b llssa.Builder, args []ssa.Value) (ret llssa.Expr) {
 			panic(fmt.Sprintf("asm: register not found: %s", name))
 		}
 		if _, ok := registerNumbers[name]; !ok {
-			// Type checking - only allow integer basic types
-			rawType := value.Type.RawType()
-			if basic, ok := rawType.Underlying().(*types.Basic); ok && basic.Info()&types.IsInteger != 0 {
-				registerNumbers[name] = len(registerNumbers)
-				inputValues = append(inputValues, value)
-				constraints = append(constraints, "r")
-			} else {
-				// Pointer operands support was dropped, following TinyGo
-				// NOTE(tinygo): Memory references require a type starting with LLVM 14, probably as a preparation for opaque pointers.
-				panic(fmt.Sprintf("asm: unsupported type in inline assembly for operand: %s, only integer types are supported", name))
-			}
+			registerNumbers[name] = len(outputs) + len(inputs)
+			inputs = append(inputs, llssa.AsmOperand{Name: name, Value: value, Memory: kinds[name] == '*'})
 		}
 		return fmt.Sprintf("${%v}", registerNumbers[name])
 	})
 
-	constraintStr := strings.Join(constraints, ",")
 	if debugInstr {
-		log.Printf("asm: %q -> %q, constraints: %q", asmString, finalAsm, constraintStr)
+		log.Printf("asm: %q -> %q", asmString, finalAsm)
+	}
+	rets, err := b.InlineAsmEx(finalAsm, outputs, inputs, clobbers)
+	if err != nil {
+		panic("asm: " + err.Error())
+	}
+	for i, name := range outNames {
+		b.Store(registers[name], rets[len(rets)-len(outNames)+i])
 	}
-
 	if !hasOutput {
 		// Make sure we return something valid
-		b.InlineAsmFull(finalAsm, constraintStr, b.Prog.Void(), inputValues)
 		return b.Prog.Val((uintptr(0)))
 	}
-
-	return b.InlineAsmFull(finalAsm, constraintStr, b.Prog.Uintptr(), inputValues)
+	return rets[0]
 }
 
 // -----------------------------------------------------------------------------
@@ -248,6 +284,8 @@ func (p *context) cgoCgocall(b llssa.Builder, args []ssa.Value) (ret llssa.Expr)
 	}
 	pfn.Type = p.prog.Pointer(fnTy)
 	fn := b.Load(pfn)
+	// the C function may block: don't hold a proc meanwhile
+	b.EnterBlocking()
 	p.cgoRet = b.Call(fn, p.cgoArgs...)
 
 	if isC2 {
@@ -255,8 +293,10 @@ func (p *context) cgoCgocall(b llssa.Builder, args []ssa.Value) (ret llssa.Expr)
 			types.NewTuple(types.NewVar(token.NoPos, nil, "", types.Typ[types.Int32])), false)
 		errnoFn := b.Pkg.NewFunc("cliteErrno", errnoSig, llssa.InC)
 		p.cgoErrno = b.Call(errnoFn.Expr)
+		b.ExitBlocking()
 		return p.cgoErrno
 	}
+	b.ExitBlocking()
 	i32 := p.type_(types.Typ[types.Int32], llssa.InGo)
 	p.cgoErrno = p.prog.Zero(i32)
 	return p.cgoErrno
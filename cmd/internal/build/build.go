@@ -43,6 +43,7 @@ func init() {
 	flags.AddEmulatorFlags(&Cmd.Flag)
 	flags.AddEmbeddedFlags(&Cmd.Flag)
 	flags.AddOutputFlags(&Cmd.Flag)
+	flags.AddJSONFlags(&Cmd.Flag)
 }
 
 func runCmd(cmd *base.Command, args []string) {
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package envcmd implements the "llgo env" command.
+package envcmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/goplus/llgo/cmd/internal/base"
+	"github.com/goplus/llgo/cmd/internal/compilerhash"
+	"github.com/goplus/llgo/internal/build"
+	"github.com/goplus/llgo/internal/env"
+	"github.com/goplus/llgo/internal/mockable"
+)
+
+// llgo env
+var Cmd = &base.Command{
+	UsageLine: "llgo env [-json] [-u] [-w] [var ...]",
+	Short:     "Print LLGo environment information",
+}
+
+var (
+	envJSON  bool
+	envUnset bool
+	envWrite bool
+)
+
+func init() {
+	Cmd.Run = runCmd
+	Cmd.Flag.BoolVar(&envJSON, "json", false, "Print the environment in JSON format")
+	Cmd.Flag.BoolVar(&envUnset, "u", false, "Unset the defaults of the named variables set by 'llgo env -w'")
+	Cmd.Flag.BoolVar(&envWrite, "w", false, "Set the defaults of the variables given as NAME=VALUE in the config file")
+}
+
+func runCmd(cmd *base.Command, args []string) {
+	if err := cmd.Flag.Parse(args); err != nil {
+		return
+	}
+	args = cmd.Flag.Args()
+
+	var err error
+	switch {
+	case envWrite && envUnset:
+		err = fmt.Errorf("llgo: cannot use -w with -u")
+	case envWrite:
+		err = writeEnv(args)
+	case envUnset:
+		err = unsetEnv(args)
+	default:
+		conf := build.NewDefaultConf(0)
+		conf.CompilerHash = compilerhash.Value()
+		err = printEnv(os.Stdout, build.Env(conf), args, envJSON)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		mockable.Exit(1)
+	}
+}
+
+// printEnv prints the variables of vars named by names, or all of them.
+func printEnv(w io.Writer, vars []build.EnvVar, names []string, asJSON bool) error {
+	if len(names) > 0 {
+		named := make([]build.EnvVar, 0, len(names))
+		for _, name := range names {
+			named = append(named, build.EnvVar{Name: name, Value: lookupEnv(vars, name)})
+		}
+		vars = named
+	}
+	if asJSON {
+		m := make(map[string]string, len(vars))
+		for _, v := range vars {
+			m[v.Name] = v.Value
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "\t")
+		return enc.Encode(m)
+	}
+	for _, v := range vars {
+		if len(names) > 0 {
+			fmt.Fprintln(w, v.Value)
+		} else {
+			fmt.Fprintf(w, "%s=%s\n", v.Name, shellQuote(v.Value))
+		}
+	}
+	return nil
+}
+
+func lookupEnv(vars []build.EnvVar, name string) string {
+	for _, v := range vars {
+		if v.Name == name {
+			return v.Value
+		}
+	}
+	return ""
+}
+
+// shellQuote quotes s for POSIX shells, as go env does.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func writeEnv(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("llgo: no NAME=VALUE arguments to 'llgo env -w'")
+	}
+	set := make(map[string]string, len(args))
+	for _, arg := range args {
+		name, val, ok := strings.Cut(arg, "=")
+		if !ok {
+			return fmt.Errorf("llgo: arguments must be NAME=VALUE: invalid argument: %s", arg)
+		}
+		if err := checkSettable(name); err != nil {
+			return err
+		}
+		if os.Getenv(name) != "" {
+			fmt.Fprintf(os.Stderr, "llgo: warning: %s set in the environment overrides the config file\n", name)
+		}
+		set[name] = val
+	}
+	return env.UpdateConfig(set, nil)
+}
+
+func unsetEnv(names []string) error {
+	if len(names) == 0 {
+		return fmt.Errorf("llgo: no variables to 'llgo env -u'")
+	}
+	for _, name := range names {
+		if err := checkSettable(name); err != nil {
+			return err
+		}
+	}
+	return env.UpdateConfig(nil, names)
+}
+
+// checkSettable reports an error if name can't be set by llgo env -w.
+func checkSettable(name string) error {
+	if !build.IsEnvSetting(name) {
+		return fmt.Errorf("llgo: unknown llgo env variable %s", name)
+	}
+	return nil
+}
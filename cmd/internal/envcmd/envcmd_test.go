@@ -0,0 +1,75 @@
+//go:build !llgo
+
+package envcmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/goplus/llgo/internal/build"
+	"github.com/goplus/llgo/internal/env"
+)
+
+func TestPrintEnv(t *testing.T) {
+	vars := []build.EnvVar{
+		{Name: "GOOS", Value: "linux"},
+		{Name: "CGO_CFLAGS", Value: "-DMSG='hi'"},
+	}
+
+	var buf bytes.Buffer
+	if err := printEnv(&buf, vars, nil, false); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "GOOS='linux'\nCGO_CFLAGS='-DMSG='\\''hi'\\'''\n"; got != want {
+		t.Fatalf("printEnv = %q, want %q", got, want)
+	}
+
+	buf.Reset()
+	if err := printEnv(&buf, vars, []string{"CGO_CFLAGS", "UNKNOWN"}, false); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "-DMSG='hi'\n\n"; got != want {
+		t.Fatalf("printEnv with names = %q, want %q", got, want)
+	}
+
+	buf.Reset()
+	if err := printEnv(&buf, vars, []string{"GOOS"}, true); err != nil {
+		t.Fatal(err)
+	}
+	var m map[string]string
+	if err := json.Unmarshal(buf.Bytes(), &m); err != nil {
+		t.Fatal(err)
+	}
+	if len(m) != 1 || m["GOOS"] != "linux" {
+		t.Fatalf("printEnv -json = %v", m)
+	}
+}
+
+func TestWriteUnsetEnv(t *testing.T) {
+	t.Setenv(env.LLGoEnvVar, filepath.Join(t.TempDir(), "env"))
+	t.Setenv("LLGO_OPTIMIZE", "")
+
+	if err := writeEnv([]string{"LLGO_OPTIMIZE=0"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := env.Getenv("LLGO_OPTIMIZE"); got != "0" {
+		t.Fatalf("LLGO_OPTIMIZE = %q after -w, want 0", got)
+	}
+	if err := unsetEnv([]string{"LLGO_OPTIMIZE"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := env.Getenv("LLGO_OPTIMIZE"); got != "" {
+		t.Fatalf("LLGO_OPTIMIZE = %q after -u, want empty", got)
+	}
+
+	for _, args := range [][]string{nil, {"LLGO_OPTIMIZE"}, {"GOOS=linux"}, {"NOT_LLGO=1"}} {
+		if err := writeEnv(args); err == nil {
+			t.Errorf("writeEnv(%q) should fail", args)
+		}
+	}
+	if err := unsetEnv([]string{"GOOS"}); err == nil {
+		t.Error("unsetEnv(GOOS) should fail")
+	}
+}
@@ -2,6 +2,7 @@ package flags
 
 import (
 	"flag"
+	"os"
 
 	"github.com/goplus/llgo/cmd/internal/compilerhash"
 	"github.com/goplus/llgo/internal/build"
@@ -27,6 +28,7 @@ func AddOutputFlags(fs *flag.FlagSet) {
 var Verbose bool
 var BuildEnv string
 var BuildMode string
+var CXXHeader bool
 var Tags string
 var Target string
 var Emulator bool
@@ -69,9 +71,21 @@ func AddBuildFlags(fs *flag.FlagSet) {
 
 func AddBuildModeFlags(fs *flag.FlagSet) {
 	fs.StringVar(&BuildMode, "buildmode", "exe", "Build mode (exe, c-archive, c-shared)")
+	fs.BoolVar(&CXXHeader, "cxxheader", false, "Also generate a C++ wrapper header (c-archive, c-shared)")
+}
+
+var BuildJSON bool
+
+// AddJSONFlags adds -json, which prints the build events as JSON, to stderr
+// for llgo run. llgo test has its own -json (see AddTestBinaryFlags), which
+// covers the build events too.
+func AddJSONFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&BuildJSON, "json", false, "Print the build events as JSON")
 }
 
 var Gen bool
+var CmpTestParallel int
+var CmpTestJUnit string
 var CompileOnly bool
 
 // Test binary flags
@@ -170,6 +184,8 @@ func AddEmbeddedFlags(fs *flag.FlagSet) {
 
 func AddCmpTestFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&Gen, "gen", false, "Generate llgo.expect file")
+	fs.IntVar(&CmpTestParallel, "parallel", 0, "Number of packages to run in parallel (default GOMAXPROCS)")
+	fs.StringVar(&CmpTestJUnit, "junit", "", "Write a JUnit XML report to the named file")
 }
 
 func UpdateConfig(conf *build.Config) error {
@@ -191,6 +207,14 @@ func UpdateConfig(conf *build.Config) error {
 		}
 	}
 
+	if BuildJSON || (conf.Mode == build.ModeTest && TestJSON) {
+		conf.Events = os.Stdout
+		if conf.Mode == build.ModeRun {
+			// stdout is the program's
+			conf.Events = os.Stderr
+		}
+	}
+
 	switch conf.Mode {
 	case build.ModeBuild:
 		conf.OutFile = OutputFile
@@ -212,6 +236,8 @@ func UpdateConfig(conf *build.Config) error {
 	case build.ModeCmpTest:
 		conf.Emulator = Emulator
 		conf.GenExpect = Gen
+		conf.CmpTestParallel = CmpTestParallel
+		conf.CmpTestJUnit = CmpTestJUnit
 	}
 	if buildenv.Dev {
 		conf.AbiMode = build.AbiMode(AbiMode)
@@ -232,6 +258,7 @@ func UpdateBuildConfig(conf *build.Config) error {
 		return err
 	}
 	conf.BuildMode = build.BuildMode(BuildMode)
+	conf.CXXHeader = CXXHeader
 
 	return nil
 }
@@ -38,6 +38,7 @@ func init() {
 	flags.AddCommonFlags(&Cmd.Flag)
 	flags.AddBuildFlags(&Cmd.Flag)
 	flags.AddEmbeddedFlags(&Cmd.Flag)
+	flags.AddJSONFlags(&Cmd.Flag)
 }
 
 func runCmd(cmd *base.Command, args []string) {
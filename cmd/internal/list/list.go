@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package list implements the "llgo list" command.
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/goplus/llgo/cmd/internal/base"
+	"github.com/goplus/llgo/cmd/internal/flags"
+	"github.com/goplus/llgo/internal/build"
+	"github.com/goplus/llgo/internal/mockable"
+)
+
+// llgo list
+var Cmd = &base.Command{
+	UsageLine: "llgo list [-json] [-deps] [-target platform] [build flags] [packages]",
+	Short:     "List packages and how llgo builds them",
+}
+
+var (
+	listJSON bool
+	listDeps bool
+)
+
+func init() {
+	Cmd.Run = runCmd
+	Cmd.Flag.BoolVar(&listJSON, "json", false, "Print the packages in JSON format")
+	Cmd.Flag.BoolVar(&listDeps, "deps", false, "Also list the dependencies of the packages")
+	Cmd.Flag.StringVar(&flags.Target, "target", "", "Target platform (e.g., rp2040, wasi)")
+	flags.AddCommonFlags(&Cmd.Flag)
+	flags.AddBuildFlags(&Cmd.Flag)
+}
+
+func runCmd(cmd *base.Command, args []string) {
+	if err := cmd.Flag.Parse(args); err != nil {
+		return
+	}
+
+	conf := build.NewDefaultConf(build.ModeList)
+	if err := flags.UpdateConfig(conf); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		mockable.Exit(1)
+	}
+
+	pkgs, err := build.List(cmd.Flag.Args(), conf, listDeps)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		mockable.Exit(1)
+	}
+	if err = printPkgs(os.Stdout, pkgs, listJSON); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		mockable.Exit(1)
+	}
+}
+
+// printPkgs prints the import paths of pkgs, or pkgs as a stream of JSON
+// objects like go list -json.
+func printPkgs(w io.Writer, pkgs []*build.ListPackage, asJSON bool) error {
+	if !asJSON {
+		for _, pkg := range pkgs {
+			fmt.Fprintln(w, pkg.ImportPath)
+		}
+		return nil
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	for _, pkg := range pkgs {
+		if err := enc.Encode(pkg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+//go:build !llgo
+
+package list
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/goplus/llgo/internal/build"
+)
+
+func TestPrintPkgs(t *testing.T) {
+	pkgs := []*build.ListPackage{
+		{ImportPath: "fmt", Name: "fmt", DepOnly: true, LLGo: &build.PkgInfo{Kind: "Normal", CacheHit: true}},
+		{ImportPath: "example.com/hello", Name: "main", LLGo: &build.PkgInfo{
+			Kind:     "LinkExtern",
+			LinkArgs: []string{"-lm"},
+			AltPkg:   "github.com/goplus/llgo/runtime/internal/lib/example.com/hello",
+		}},
+	}
+
+	var buf bytes.Buffer
+	if err := printPkgs(&buf, pkgs, false); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "fmt\nexample.com/hello\n"; got != want {
+		t.Fatalf("printPkgs = %q, want %q", got, want)
+	}
+
+	buf.Reset()
+	if err := printPkgs(&buf, pkgs, true); err != nil {
+		t.Fatal(err)
+	}
+	dec := json.NewDecoder(&buf)
+	var got []build.ListPackage
+	for {
+		var pkg build.ListPackage
+		if err := dec.Decode(&pkg); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, pkg)
+	}
+	if len(got) != 2 {
+		t.Fatalf("decoded %d packages, want 2", len(got))
+	}
+	if !got[0].DepOnly || !got[0].LLGo.CacheHit || got[0].LLGo.Kind != "Normal" {
+		t.Errorf("fmt decoded as %+v", got[0])
+	}
+	if info := got[1].LLGo; info.Kind != "LinkExtern" || len(info.LinkArgs) != 1 || info.AltPkg == "" {
+		t.Errorf("example.com/hello decoded as %+v", info)
+	}
+}
@@ -40,7 +40,7 @@ var Cmd = &base.Command{
 
 // llgo cmptest
 var CmpTestCmd = &base.Command{
-	UsageLine: "llgo cmptest [-gen] [build flags] package [arguments...]",
+	UsageLine: "llgo cmptest [-gen] [-parallel n] [-junit file] [build flags] package [arguments...]",
 	Short:     "Compile and run with llgo, compare result (stdout/stderr/exitcode) with go or llgo.expect; generate llgo.expect file if -gen is specified",
 }
 
@@ -52,6 +52,7 @@ func init() {
 	flags.AddBuildFlags(&Cmd.Flag)
 	flags.AddEmulatorFlags(&Cmd.Flag)
 	flags.AddEmbeddedFlags(&Cmd.Flag) // for -target support
+	flags.AddJSONFlags(&Cmd.Flag)
 
 	base.PassBuildFlags(CmpTestCmd)
 	flags.AddCommonFlags(&CmpTestCmd.Flag)
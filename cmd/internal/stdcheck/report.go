@@ -0,0 +1,263 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdcheck
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Status classifies the result of checking a package.
+type Status string
+
+const (
+	StatusOK           Status = "ok"            // llgo passes the tests go passes
+	StatusNoTests      Status = "no tests"      // the package has no tests
+	StatusGoError      Status = "go error"      // go test fails to build the tests, nothing to compare
+	StatusCompileError Status = "compile error" // llgo fails to compile the tests
+	StatusLinkError    Status = "link error"    // llgo fails to link the test binary
+	StatusPanic        Status = "runtime panic" // the test binary panics or crashes
+	StatusMismatch     Status = "test mismatch" // tests passing with go fail with llgo
+	StatusTimeout      Status = "timeout"       // llgo does not finish in time
+)
+
+// Statuses lists the statuses in the order the report summarizes them.
+var Statuses = []Status{
+	StatusOK, StatusNoTests, StatusGoError, StatusCompileError,
+	StatusLinkError, StatusPanic, StatusMismatch, StatusTimeout,
+}
+
+// Result is the result of checking a package.
+type Result struct {
+	Package    string
+	Status     Status
+	Tests      int      `json:",omitempty"` // top-level tests passing with go
+	Passed     int      `json:",omitempty"` // the ones of them passing with llgo
+	Mismatches []string `json:",omitempty"` // the ones of them not passing with llgo
+	Detail     string   `json:",omitempty"` // first line telling what went wrong
+}
+
+// Report is the compatibility matrix written by "llgo stdcheck". It holds
+// no timing, so that reports of different llgo versions can be diffed.
+type Report struct {
+	LLGoVersion string
+	GoVersion   string
+	GOOS        string
+	GOARCH      string
+	Target      string `json:",omitempty"`
+	Results     []*Result
+}
+
+// Count returns the number of results of status s.
+func (r *Report) Count(s Status) int {
+	n := 0
+	for _, res := range r.Results {
+		if res.Status == s {
+			n++
+		}
+	}
+	return n
+}
+
+// WriteJSON writes r as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(r)
+}
+
+// WriteMarkdown writes r as a markdown summary and a table of the packages.
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var b bytes.Buffer
+	platform := r.GOOS + "/" + r.GOARCH
+	if r.Target != "" {
+		platform = r.Target
+	}
+	fmt.Fprintf(&b, "# Standard library compatibility\n\n")
+	fmt.Fprintf(&b, "llgo %s, %s, %s\n\n", r.LLGoVersion, r.GoVersion, platform)
+	fmt.Fprintf(&b, "| Status | Packages |\n|---|---|\n")
+	for _, s := range Statuses {
+		if n := r.Count(s); n > 0 {
+			fmt.Fprintf(&b, "| %s | %d |\n", s, n)
+		}
+	}
+	fmt.Fprintf(&b, "\n| Package | Status | Tests | Details |\n|---|---|---|---|\n")
+	for _, res := range r.Results {
+		tests := ""
+		if res.Tests > 0 {
+			tests = fmt.Sprintf("%d/%d", res.Passed, res.Tests)
+		}
+		detail := res.Detail
+		if len(res.Mismatches) > 0 {
+			detail = strings.Join(res.Mismatches, ", ")
+		}
+		detail = strings.ReplaceAll(detail, "|", `\|`)
+		fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", res.Package, res.Status, tests, detail)
+	}
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// testEvent is an event printed by go test -json, see cmd/test2json.
+type testEvent struct {
+	Action string
+	Test   string
+	Output string
+}
+
+// parseGoTestJSON returns the results of the top-level tests printed by
+// go test -json, and whether the package has no test files.
+func parseGoTestJSON(data []byte) (results map[string]string, noTests bool) {
+	results = make(map[string]string)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var ev testEvent
+		if err := dec.Decode(&ev); err != nil {
+			break
+		}
+		switch ev.Action {
+		case "pass", "fail", "skip":
+			if ev.Test == "" {
+				continue
+			}
+			if !strings.Contains(ev.Test, "/") {
+				results[ev.Test] = ev.Action
+			}
+		case "output":
+			if ev.Test == "" && strings.Contains(ev.Output, "[no test files]") {
+				noTests = true
+			}
+		}
+	}
+	return
+}
+
+// testResultLine matches the result line of a top-level test printed by a
+// test binary run with -test.v; subtests are indented.
+var testResultLine = regexp.MustCompile(`^--- (PASS|FAIL|SKIP): (\S+) \(`)
+
+// parseTestOutput returns the results of the top-level tests in the output
+// of a test binary run with -test.v.
+func parseTestOutput(data []byte) map[string]string {
+	results := make(map[string]string)
+	s := bufio.NewScanner(bytes.NewReader(data))
+	s.Buffer(nil, 1<<20)
+	for s.Scan() {
+		if m := testResultLine.FindSubmatch(s.Bytes()); m != nil {
+			results[string(m[2])] = strings.ToLower(string(m[1]))
+		}
+	}
+	return results
+}
+
+var linkErrors = []string{
+	"undefined symbol",
+	"undefined reference",
+	"linker command failed",
+	"ld.lld: error",
+	"ld64.lld: error",
+	"wasm-ld: error",
+	"ld: error",
+}
+
+// classifyBuild tells a link error from a compile error by the output of a
+// failed build, and returns the line telling what went wrong.
+func classifyBuild(output []byte) (Status, string) {
+	for _, line := range lines(output) {
+		for _, pat := range linkErrors {
+			if strings.Contains(line, pat) {
+				return StatusLinkError, line
+			}
+		}
+	}
+	return StatusCompileError, firstLine(output)
+}
+
+var crashes = []string{"panic: ", "fatal error: ", "SIGSEGV", "SIGBUS", "SIGILL", "SIGABRT"}
+
+// classifyRun classifies the run of a test binary by its output and exit
+// status against the results of go test.
+func classifyRun(res *Result, goResults map[string]string, output []byte, failed bool) {
+	llResults := parseTestOutput(output)
+	var names []string
+	for name, action := range goResults {
+		if action == "pass" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	res.Tests = len(names)
+	res.Passed, res.Mismatches = 0, nil
+	for _, name := range names {
+		if llResults[name] == "pass" {
+			res.Passed++
+		} else {
+			res.Mismatches = append(res.Mismatches, name)
+		}
+	}
+	if failed {
+		for _, line := range lines(output) {
+			for _, pat := range crashes {
+				if strings.Contains(line, pat) {
+					res.Status, res.Detail = StatusPanic, line
+					return
+				}
+			}
+		}
+	}
+	switch {
+	case len(res.Mismatches) > 0:
+		res.Status = StatusMismatch
+	case failed && goFailed(goResults) == 0:
+		res.Status, res.Detail = StatusMismatch, lastLine(output)
+	default:
+		res.Status = StatusOK
+	}
+}
+
+func goFailed(results map[string]string) (n int) {
+	for _, action := range results {
+		if action == "fail" {
+			n++
+		}
+	}
+	return
+}
+
+func lines(data []byte) []string {
+	return strings.Split(strings.TrimSpace(string(data)), "\n")
+}
+
+func firstLine(data []byte) string {
+	for _, line := range lines(data) {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			return line
+		}
+	}
+	return ""
+}
+
+func lastLine(data []byte) string {
+	ls := lines(data)
+	return strings.TrimSpace(ls[len(ls)-1])
+}
@@ -0,0 +1,138 @@
+//go:build !llgo
+
+package stdcheck
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseGoTestJSON(t *testing.T) {
+	out := `{"Action":"start","Package":"p"}
+{"Action":"run","Package":"p","Test":"TestA"}
+{"Action":"pass","Package":"p","Test":"TestA"}
+{"Action":"pass","Package":"p","Test":"TestA/sub"}
+{"Action":"fail","Package":"p","Test":"TestB"}
+{"Action":"skip","Package":"p","Test":"TestC"}
+{"Action":"fail","Package":"p"}
+`
+	results, noTests := parseGoTestJSON([]byte(out))
+	if noTests {
+		t.Fatal("noTests = true")
+	}
+	want := map[string]string{"TestA": "pass", "TestB": "fail", "TestC": "skip"}
+	if len(results) != len(want) {
+		t.Fatalf("results = %v, want %v", results, want)
+	}
+	for name, action := range want {
+		if results[name] != action {
+			t.Errorf("results[%s] = %q, want %q", name, results[name], action)
+		}
+	}
+
+	out = `{"Action":"output","Package":"p","Output":"?   \tp\t[no test files]\n"}
+{"Action":"skip","Package":"p"}
+`
+	if _, noTests = parseGoTestJSON([]byte(out)); !noTests {
+		t.Fatal("noTests = false")
+	}
+}
+
+func TestParseTestOutput(t *testing.T) {
+	out := `=== RUN   TestA
+--- PASS: TestA (0.00s)
+=== RUN   TestB
+    --- FAIL: TestB/sub (0.00s)
+--- FAIL: TestB (0.01s)
+--- SKIP: TestC (0.00s)
+FAIL
+`
+	results := parseTestOutput([]byte(out))
+	want := map[string]string{"TestA": "pass", "TestB": "fail", "TestC": "skip"}
+	if len(results) != len(want) {
+		t.Fatalf("results = %v, want %v", results, want)
+	}
+	for name, action := range want {
+		if results[name] != action {
+			t.Errorf("results[%s] = %q, want %q", name, results[name], action)
+		}
+	}
+}
+
+func TestClassifyBuild(t *testing.T) {
+	status, detail := classifyBuild([]byte("# strings\nld.lld: error: undefined symbol: foo\n"))
+	if status != StatusLinkError || !strings.Contains(detail, "undefined symbol: foo") {
+		t.Errorf("link: got %q, %q", status, detail)
+	}
+	status, detail = classifyBuild([]byte("# strings\nstrings.go:1:1: unsupported\n"))
+	if status != StatusCompileError || detail != "strings.go:1:1: unsupported" {
+		t.Errorf("compile: got %q, %q", status, detail)
+	}
+}
+
+func TestClassifyRun(t *testing.T) {
+	goResults := map[string]string{"TestA": "pass", "TestB": "pass", "TestC": "fail"}
+	tests := []struct {
+		name       string
+		output     string
+		failed     bool
+		status     Status
+		mismatches int
+	}{
+		{"ok", "--- PASS: TestA (0s)\n--- PASS: TestB (0s)\n--- FAIL: TestC (0s)\nFAIL\n", true, StatusOK, 0},
+		{"mismatch", "--- PASS: TestA (0s)\n--- FAIL: TestB (0s)\nFAIL\n", true, StatusMismatch, 1},
+		{"panic", "--- PASS: TestA (0s)\npanic: runtime error: index out of range\n", true, StatusPanic, 1},
+		{"missing", "--- PASS: TestA (0s)\nPASS\n", false, StatusMismatch, 1},
+	}
+	for _, tt := range tests {
+		res := &Result{Package: "p"}
+		classifyRun(res, goResults, []byte(tt.output), tt.failed)
+		if res.Status != tt.status || len(res.Mismatches) != tt.mismatches || res.Tests != 2 {
+			t.Errorf("%s: got %+v", tt.name, res)
+		}
+	}
+}
+
+func TestReport(t *testing.T) {
+	r := &Report{
+		LLGoVersion: "v0.1.0",
+		GoVersion:   "go1.24.0",
+		GOOS:        "linux",
+		GOARCH:      "amd64",
+		Results: []*Result{
+			{Package: "errors", Status: StatusOK, Tests: 3, Passed: 3},
+			{Package: "strings", Status: StatusMismatch, Tests: 2, Passed: 1, Mismatches: []string{"TestMap"}},
+			{Package: "unsafe", Status: StatusNoTests},
+		},
+	}
+	var b bytes.Buffer
+	if err := r.WriteMarkdown(&b); err != nil {
+		t.Fatal(err)
+	}
+	md := b.String()
+	for _, want := range []string{
+		"llgo v0.1.0, go1.24.0, linux/amd64",
+		"| ok | 1 |",
+		"| test mismatch | 1 |",
+		"| strings | test mismatch | 1/2 | TestMap |",
+		"| unsafe | no tests |  |  |",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("markdown lacks %q:\n%s", want, md)
+		}
+	}
+
+	b.Reset()
+	if err := r.WriteJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+	var got Report
+	if err := json.Unmarshal(b.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Results) != 3 || got.Results[1].Mismatches[0] != "TestMap" {
+		t.Errorf("JSON round trip = %+v", got)
+	}
+}
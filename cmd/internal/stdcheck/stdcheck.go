@@ -0,0 +1,240 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package stdcheck implements the "llgo stdcheck" command.
+package stdcheck
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/goplus/llgo/cmd/internal/base"
+	"github.com/goplus/llgo/internal/env"
+	"github.com/goplus/llgo/internal/mockable"
+)
+
+// llgo stdcheck
+var Cmd = &base.Command{
+	UsageLine: "llgo stdcheck [-json] [-o file] [-target platform] [-tags tags] [-timeout d] [packages|all]",
+	Short:     "Check which standard library packages pass their tests with llgo",
+}
+
+var (
+	checkJSON    bool
+	checkOutput  string
+	checkTarget  string
+	checkTags    string
+	checkTimeout time.Duration
+)
+
+func init() {
+	Cmd.Run = runCmd
+	Cmd.Flag.BoolVar(&checkJSON, "json", false, "Write the report in JSON format instead of markdown")
+	Cmd.Flag.StringVar(&checkOutput, "o", "", "Write the report to the named file instead of stdout")
+	Cmd.Flag.StringVar(&checkTarget, "target", "", "Target platform to run the llgo tests on (e.g., wasi)")
+	Cmd.Flag.StringVar(&checkTags, "tags", "", "Build tags")
+	Cmd.Flag.DurationVar(&checkTimeout, "timeout", 10*time.Minute, "Timeout of each of running go test, building the tests with llgo and running them, for a package")
+}
+
+func runCmd(cmd *base.Command, args []string) {
+	if err := cmd.Flag.Parse(args); err != nil {
+		return
+	}
+	report, err := check(cmd.Flag.Args(), os.Stderr)
+	if err == nil {
+		err = writeReport(report)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		mockable.Exit(1)
+	}
+}
+
+func writeReport(report *Report) (err error) {
+	w := io.Writer(os.Stdout)
+	if checkOutput != "" {
+		f, err := os.Create(checkOutput)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+		}()
+		w = f
+	}
+	if checkJSON {
+		return report.WriteJSON(w)
+	}
+	return report.WriteMarkdown(w)
+}
+
+// check checks the std packages matched by patterns, logging the progress
+// to log.
+func check(patterns []string, log io.Writer) (*Report, error) {
+	pkgs, err := stdPackages(patterns)
+	if err != nil {
+		return nil, err
+	}
+	llgo, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	tmpDir, err := os.MkdirTemp("", "llgo-stdcheck-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	report := &Report{
+		LLGoVersion: env.Version(),
+		GoVersion:   runtime.Version(),
+		GOOS:        runtime.GOOS,
+		GOARCH:      runtime.GOARCH,
+		Target:      checkTarget,
+	}
+	for _, pkg := range pkgs {
+		start := time.Now()
+		res := checkPkg(llgo, tmpDir, pkg)
+		fmt.Fprintf(log, "%s: %s (%v)\n", pkg, res.Status, time.Since(start).Round(time.Millisecond))
+		report.Results = append(report.Results, res)
+	}
+	return report, nil
+}
+
+// stdPackages returns the std packages matched by patterns; "all" stands for
+// the std packages users can import.
+func stdPackages(patterns []string) ([]string, error) {
+	if len(patterns) == 0 {
+		return nil, errors.New("no packages to check, use \"all\" for all of std")
+	}
+	var pkgs []string
+	for _, pattern := range patterns {
+		if pattern != "all" {
+			pkgs = append(pkgs, pattern)
+			continue
+		}
+		out, err := exec.Command("go", "list", "std").Output()
+		if err != nil {
+			return nil, fmt.Errorf("go list std: %w", err)
+		}
+		for _, pkg := range strings.Fields(string(out)) {
+			if isPublicStd(pkg) {
+				pkgs = append(pkgs, pkg)
+			}
+		}
+	}
+	sort.Strings(pkgs)
+	return pkgs, nil
+}
+
+func isPublicStd(pkg string) bool {
+	for _, elem := range strings.Split(pkg, "/") {
+		if elem == "internal" || elem == "vendor" {
+			return false
+		}
+	}
+	return true
+}
+
+// checkPkg runs the tests of pkg with go as the reference, then builds and
+// runs them with llgo, and compares the results. Each of the three phases
+// gets checkTimeout of its own.
+func checkPkg(llgo, tmpDir, pkg string) *Result {
+	res := &Result{Package: pkg}
+
+	goArgs := []string{"test", "-json", "-timeout", checkTimeout.String()}
+	if checkTags != "" {
+		goArgs = append(goArgs, "-tags", checkTags)
+	}
+	goCtx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	goOut, _ := exec.CommandContext(goCtx, "go", append(goArgs, pkg)...).Output()
+	cancel()
+	goResults, noTests := parseGoTestJSON(goOut)
+	switch {
+	case noTests:
+		res.Status = StatusNoTests
+		return res
+	case len(goResults) == 0:
+		res.Status, res.Detail = StatusGoError, "go test ran no tests"
+		return res
+	}
+
+	testBin := filepath.Join(tmpDir, strings.ReplaceAll(pkg, "/", "_")+".test")
+	buildArgs := []string{"test", "-c", "-o", testBin}
+	buildArgs = appendBuildFlags(buildArgs)
+	buildCtx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+	buildOut, err := exec.CommandContext(buildCtx, llgo, append(buildArgs, pkg)...).CombinedOutput()
+	if buildCtx.Err() != nil {
+		res.Status, res.Detail = StatusTimeout, "building the tests timed out"
+		return res
+	}
+	if err != nil {
+		res.Status, res.Detail = classifyBuild(buildOut)
+		return res
+	}
+
+	runCtx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+	var run *exec.Cmd
+	if checkTarget == "" {
+		run = exec.CommandContext(runCtx, testBin, "-test.v", "-test.timeout", checkTimeout.String())
+		run.Dir = pkgDir(pkg)
+	} else {
+		runArgs := appendBuildFlags([]string{"test", "-v", "-timeout", checkTimeout.String()})
+		run = exec.CommandContext(runCtx, llgo, append(runArgs, pkg)...)
+	}
+	var runOut bytes.Buffer
+	run.Stdout, run.Stderr = &runOut, &runOut
+	err = run.Run()
+	if runCtx.Err() != nil {
+		res.Status, res.Detail = StatusTimeout, "running the tests timed out"
+		return res
+	}
+	classifyRun(res, goResults, runOut.Bytes(), err != nil)
+	return res
+}
+
+func appendBuildFlags(args []string) []string {
+	if checkTarget != "" {
+		args = append(args, "-target", checkTarget)
+	}
+	if checkTags != "" {
+		args = append(args, "-tags", checkTags)
+	}
+	return args
+}
+
+// pkgDir returns the source directory of pkg, where go test runs its tests.
+func pkgDir(pkg string) string {
+	out, err := exec.Command("go", "list", "-f", "{{.Dir}}", pkg).Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
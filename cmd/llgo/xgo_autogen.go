@@ -7,9 +7,12 @@ import (
 	"github.com/goplus/cobra/xcmd"
 	"github.com/goplus/llgo/cmd/internal/build"
 	"github.com/goplus/llgo/cmd/internal/clean"
+	"github.com/goplus/llgo/cmd/internal/envcmd"
 	"github.com/goplus/llgo/cmd/internal/install"
+	"github.com/goplus/llgo/cmd/internal/list"
 	"github.com/goplus/llgo/cmd/internal/monitor"
 	"github.com/goplus/llgo/cmd/internal/run"
+	"github.com/goplus/llgo/cmd/internal/stdcheck"
 	"github.com/goplus/llgo/cmd/internal/test"
 	"github.com/goplus/llgo/internal/env"
 	"github.com/qiniu/x/stringutil"
@@ -30,6 +33,10 @@ type Cmd_cmptest struct {
 	xcmd.Command
 	*App
 }
+type Cmd_env struct {
+	xcmd.Command
+	*App
+}
 type Cmd_get struct {
 	xcmd.Command
 	*App
@@ -38,6 +45,10 @@ type Cmd_install struct {
 	xcmd.Command
 	*App
 }
+type Cmd_list struct {
+	xcmd.Command
+	*App
+}
 type App struct {
 	xcmd.App
 }
@@ -49,6 +60,10 @@ type Cmd_run struct {
 	xcmd.Command
 	*App
 }
+type Cmd_stdcheck struct {
+	xcmd.Command
+	*App
+}
 type Cmd_test struct {
 	xcmd.Command
 	*App
@@ -67,13 +82,16 @@ func (this *App) Main() {
 	_xgo_obj0 := &Cmd_build{App: this}
 	_xgo_obj1 := &Cmd_clean{App: this}
 	_xgo_obj2 := &Cmd_cmptest{App: this}
-	_xgo_obj3 := &Cmd_get{App: this}
-	_xgo_obj4 := &Cmd_install{App: this}
-	_xgo_obj5 := &Cmd_monitor{App: this}
-	_xgo_obj6 := &Cmd_run{App: this}
-	_xgo_obj7 := &Cmd_test{App: this}
-	_xgo_obj8 := &Cmd_version{App: this}
-	xcmd.Gopt_App_Main(this, _xgo_obj0, _xgo_obj1, _xgo_obj2, _xgo_obj3, _xgo_obj4, _xgo_obj5, _xgo_obj6, _xgo_obj7, _xgo_obj8)
+	_xgo_obj3 := &Cmd_env{App: this}
+	_xgo_obj4 := &Cmd_get{App: this}
+	_xgo_obj5 := &Cmd_install{App: this}
+	_xgo_obj6 := &Cmd_list{App: this}
+	_xgo_obj7 := &Cmd_monitor{App: this}
+	_xgo_obj8 := &Cmd_run{App: this}
+	_xgo_obj9 := &Cmd_stdcheck{App: this}
+	_xgo_obj10 := &Cmd_test{App: this}
+	_xgo_obj11 := &Cmd_version{App: this}
+	xcmd.Gopt_App_Main(this, _xgo_obj0, _xgo_obj1, _xgo_obj2, _xgo_obj3, _xgo_obj4, _xgo_obj5, _xgo_obj6, _xgo_obj7, _xgo_obj8, _xgo_obj9, _xgo_obj10, _xgo_obj11)
 }
 
 //line cmd/llgo/build_cmd.gox:20
@@ -133,6 +151,25 @@ func (this *Cmd_cmptest) Classfname() string {
 	return "cmptest"
 }
 
+//line cmd/llgo/env_cmd.gox:20
+func (this *Cmd_env) Main(_xgo_arg0 string) {
+	this.Command.Main(_xgo_arg0)
+//line cmd/llgo/env_cmd.gox:20:1
+	this.Use("env [flags] [var ...]")
+//line cmd/llgo/env_cmd.gox:22:1
+	this.Short("Print LLGo environment information")
+//line cmd/llgo/env_cmd.gox:24:1
+	this.FlagOff()
+//line cmd/llgo/env_cmd.gox:26:1
+	this.Run__1(func(args []string) {
+//line cmd/llgo/env_cmd.gox:27:1
+		envcmd.Cmd.Run(envcmd.Cmd, args)
+	})
+}
+func (this *Cmd_env) Classfname() string {
+	return "env"
+}
+
 //line cmd/llgo/get_cmd.gox:16
 func (this *Cmd_get) Main(_xgo_arg0 string) {
 	this.Command.Main(_xgo_arg0)
@@ -169,6 +206,25 @@ func (this *Cmd_install) Classfname() string {
 	return "install"
 }
 
+//line cmd/llgo/list_cmd.gox:20
+func (this *Cmd_list) Main(_xgo_arg0 string) {
+	this.Command.Main(_xgo_arg0)
+//line cmd/llgo/list_cmd.gox:20:1
+	this.Use("list [flags] [packages]")
+//line cmd/llgo/list_cmd.gox:22:1
+	this.Short("List packages and how llgo builds them")
+//line cmd/llgo/list_cmd.gox:24:1
+	this.FlagOff()
+//line cmd/llgo/list_cmd.gox:26:1
+	this.Run__1(func(args []string) {
+//line cmd/llgo/list_cmd.gox:27:1
+		list.Cmd.Run(list.Cmd, args)
+	})
+}
+func (this *Cmd_list) Classfname() string {
+	return "list"
+}
+
 //line cmd/llgo/monitor_cmd.gox:21
 func (this *Cmd_monitor) Main(_xgo_arg0 string) {
 	this.Command.Main(_xgo_arg0)
@@ -207,6 +263,25 @@ func (this *Cmd_run) Classfname() string {
 	return "run"
 }
 
+//line cmd/llgo/stdcheck_cmd.gox:20
+func (this *Cmd_stdcheck) Main(_xgo_arg0 string) {
+	this.Command.Main(_xgo_arg0)
+//line cmd/llgo/stdcheck_cmd.gox:20:1
+	this.Use("stdcheck [flags] [packages|all]")
+//line cmd/llgo/stdcheck_cmd.gox:22:1
+	this.Short("Check which standard library packages pass their tests with llgo")
+//line cmd/llgo/stdcheck_cmd.gox:24:1
+	this.FlagOff()
+//line cmd/llgo/stdcheck_cmd.gox:26:1
+	this.Run__1(func(args []string) {
+//line cmd/llgo/stdcheck_cmd.gox:27:1
+		stdcheck.Cmd.Run(stdcheck.Cmd, args)
+	})
+}
+func (this *Cmd_stdcheck) Classfname() string {
+	return "stdcheck"
+}
+
 //line cmd/llgo/test_cmd.gox:20
 func (this *Cmd_test) Main(_xgo_arg0 string) {
 	this.Command.Main(_xgo_arg0)
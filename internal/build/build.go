@@ -24,6 +24,7 @@ import (
 	"go/constant"
 	"go/token"
 	"go/types"
+	"io"
 	"log"
 	"os"
 	"os/exec"
@@ -35,6 +36,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"golang.org/x/tools/go/ssa"
 
@@ -69,6 +71,7 @@ const (
 	ModeTest
 	ModeCmpTest
 	ModeGen
+	ModeList // load packages and report how they would be built, see List
 )
 
 type BuildMode string
@@ -115,40 +118,46 @@ type OutFmtDetails struct {
 }
 
 type Config struct {
-	Goos          string
-	Goarch        string
-	Target        string // target name (e.g., "rp2040", "wasi") - takes precedence over Goos/Goarch
-	BinPath       string
-	AppExt        string  // ".exe" on Windows, empty on Unix
-	OutFile       string  // only valid for ModeBuild when len(pkgs) == 1
-	OutFmts       OutFmts // Output format specifications (only for Target != "")
-	CompileOnly   bool    // compile test binary but do not run it (only valid for ModeTest)
-	Emulator      bool    // run in emulator mode
-	Port          string  // target port for flashing
-	BaudRate      int     // baudrate for serial communication
-	RunArgs       []string
-	Mode          Mode
-	BuildMode     BuildMode // Build mode: exe, c-archive, c-shared
-	AbiMode       AbiMode
-	GenExpect     bool // only valid for ModeCmpTest
-	Verbose       bool
-	PrintCommands bool
-	GenLL         bool // generate pkg .ll files
-	CheckLLFiles  bool // check .ll files valid
-	CheckLinkArgs bool // check linkargs valid
-	ForceEspClang bool // force to use esp-clang
-	ForceRebuild  bool // force rebuilding of packages that are already up-to-date
-	Tags          string
-	SizeReport    bool   // print size report after successful build
-	SizeFormat    string // size report format: text,json (default text)
-	SizeLevel     string // size aggregation level: full,module,package (default module)
-	CompilerHash  string // metadata hash for the running compiler (development builds only)
+	Goos            string
+	Goarch          string
+	Target          string // target name (e.g., "rp2040", "wasi") - takes precedence over Goos/Goarch
+	BinPath         string
+	AppExt          string  // ".exe" on Windows, empty on Unix
+	OutFile         string  // only valid for ModeBuild when len(pkgs) == 1
+	OutFmts         OutFmts // Output format specifications (only for Target != "")
+	CompileOnly     bool    // compile test binary but do not run it (only valid for ModeTest)
+	Emulator        bool    // run in emulator mode
+	Port            string  // target port for flashing
+	BaudRate        int     // baudrate for serial communication
+	RunArgs         []string
+	Mode            Mode
+	BuildMode       BuildMode // Build mode: exe, c-archive, c-shared
+	CXXHeader       bool      // also generate a C++ wrapper header (only valid for c-archive and c-shared)
+	AbiMode         AbiMode
+	GenExpect       bool   // only valid for ModeCmpTest
+	CmpTestParallel int    // number of ModeCmpTest programs to run in parallel, GOMAXPROCS if 0
+	CmpTestJUnit    string // file to write a JUnit XML report of ModeCmpTest to
+	Verbose         bool
+	PrintCommands   bool
+	GenLL           bool // generate pkg .ll files
+	CheckLLFiles    bool // check .ll files valid
+	CheckLinkArgs   bool // check linkargs valid
+	ForceEspClang   bool // force to use esp-clang
+	ForceRebuild    bool // force rebuilding of packages that are already up-to-date
+	Tags            string
+	SizeReport      bool   // print size report after successful build
+	SizeFormat      string // size report format: text,json (default text)
+	SizeLevel       string // size aggregation level: full,module,package (default module)
+	CompilerHash    string // metadata hash for the running compiler (development builds only)
 	// GlobalRewrites specifies compile-time overrides for global string variables.
 	// Keys are fully qualified package paths (e.g. "main" or "github.com/user/pkg").
 	// Each Rewrites entry maps variable names to replacement string values. Only
 	// string-typed globals are supported and "main" applies to all root main
 	// packages in the current build.
 	GlobalRewrites map[string]Rewrites
+	// Events, if not nil, receives the events of the build as lines of JSON
+	// (see BuildEvent), like the output of go build -json.
+	Events io.Writer
 }
 
 type Rewrites map[string]string
@@ -203,7 +212,7 @@ const (
 	loadSyntax  = loadTypes | packages.NeedSyntax | packages.NeedTypesInfo
 )
 
-func Do(args []string, conf *Config) ([]Package, error) {
+func Do(args []string, conf *Config) (_ []Package, err error) {
 	if conf.Goos == "" {
 		conf.Goos = runtime.GOOS
 	}
@@ -277,9 +286,17 @@ func Do(args []string, conf *Config) ([]Package, error) {
 		GOOS:   conf.Goos,
 		GOARCH: conf.Goarch,
 		Target: conf.Target,
+
+		LLVMTarget: export.LLVMTarget,
 	}
 
 	prog := llssa.NewProgram(target)
+	// tinygogc, the GC of baremetal targets, scans heap objects precisely
+	if tagList := strings.Split(tags, ","); slices.Contains(tagList, "baremetal") && !slices.Contains(tagList, "nogc") {
+		prog.SetGCData(true)
+	}
+	// C code may call functions exported to C on threads of its own
+	prog.SetCgoCallbacks(true)
 	sizes := func(sizes types.Sizes, compiler, arch string) types.Sizes {
 		if arch == "wasm" {
 			sizes = &types.StdSizes{WordSize: 4, MaxAlign: 4}
@@ -335,6 +352,10 @@ func Do(args []string, conf *Config) ([]Package, error) {
 	prog.SetRuntime(func() *types.Package {
 		return altPkgs[0].Types
 	})
+	// reflect calls functions and implements MakeFunc through trampolines
+	// the compiler emits, and falls back to libffi for the rest. They are
+	// linkonce_odr, so each of them is linked once at most.
+	prog.SetReflectStubs(true)
 	prog.SetPython(func() *types.Package {
 		return dedup.Check(llssa.PkgPython).Types
 	})
@@ -386,6 +407,14 @@ func Do(args []string, conf *Config) ([]Package, error) {
 
 	allPkgs := append([]*aPackage{}, pkgs...)
 	allPkgs = append(allPkgs, depPkgs...)
+	if mode == ModeList {
+		if err = listPkgs(ctx, allPkgs); err != nil {
+			return nil, err
+		}
+		return allPkgs, nil
+	}
+	// programs end their build when linked, or with the error of the build
+	defer func() { ctx.unlinkedDone(err) }()
 	allPkgs, err = buildAllPkgs(ctx, allPkgs, verbose)
 	if err != nil {
 		return nil, err
@@ -412,6 +441,7 @@ func Do(args []string, conf *Config) ([]Package, error) {
 
 			// Link main package using the output path from buildOutFmts
 			err = linkMainPkg(ctx, pkg, allPkgs, outFmts.Out, verbose)
+			ctx.linkDone(pkg.PkgPath, err)
 			if err != nil {
 				return nil, err
 			}
@@ -431,7 +461,11 @@ func Do(args []string, conf *Config) ([]Package, error) {
 						pkgs = append(pkgs, p.LPkg)
 					}
 				}
-				headerErr := header.GenHeaderFile(prog, pkgs, libname, headerPath, verbose)
+				opts := &header.Options{
+					Pkgs: []header.SourcePkg{{Types: pkg.Types, Files: pkg.Syntax}},
+					CXX:  conf.CXXHeader,
+				}
+				headerErr := header.GenHeaderFileEx(prog, pkgs, libname, headerPath, opts, verbose)
 				if headerErr != nil {
 					return nil, headerErr
 				}
@@ -463,10 +497,11 @@ func Do(args []string, conf *Config) ([]Package, error) {
 				}
 
 			case ModeRun, ModeTest, ModeCmpTest:
-				if conf.Target == "" {
+				if conf.Target == "" && ctx.crossCompile.Emulator == "" {
 					err = runNative(ctx, outFmts.Out, pkg.Dir, pkg.PkgPath, conf, mode)
-				} else if conf.Emulator {
-					err = runInEmulator(ctx.crossCompile.Emulator, envMap, pkg.Dir, pkg.PkgPath, conf, mode, verbose)
+				} else if conf.Target == "" || conf.Emulator {
+					// GOOS/GOARCH cross builds run under their user-mode emulator
+					err = runInEmulator(ctx, ctx.crossCompile.Emulator, envMap, pkg.Dir, pkg.PkgPath, conf, mode, verbose)
 				} else {
 					err = flash.FlashDevice(ctx.crossCompile.Device, envMap, ctx.buildConf.Port, verbose)
 					if err != nil {
@@ -491,6 +526,11 @@ func Do(args []string, conf *Config) ([]Package, error) {
 	if mode == ModeTest && ctx.testFail {
 		mockable.Exit(1)
 	}
+	if mode == ModeCmpTest {
+		if err := runCmpTests(ctx.cmpTests, conf, os.Stderr); err != nil {
+			return nil, err
+		}
+	}
 
 	return allPkgs, nil
 }
@@ -553,6 +593,10 @@ type context struct {
 	cTransformer *cabi.Transformer
 
 	testFail bool
+	cmpTests []*cmpTestCase // programs to compare, run after all are linked
+
+	eventMu    sync.Mutex           // serializes writes to buildConf.Events
+	linkStarts map[string]time.Time // build-start of programs their link ends
 
 	// Cache related fields
 	cacheManager *cacheManager
@@ -642,7 +686,7 @@ func buildAllPkgs(ctx *context, pkgs []*aPackage, verbose bool) ([]*aPackage, er
 
 	var needRuntime, needPyInit bool
 
-	buildOne := func(aPkg *aPackage) error {
+	buildOne := func(aPkg *aPackage) (err error) {
 		pkg := aPkg.Package
 		if _, ok := built[pkg.ID]; ok {
 			// Already built, skip but keep ExportFile for linking
@@ -650,6 +694,14 @@ func buildAllPkgs(ctx *context, pkgs []*aPackage, verbose bool) ([]*aPackage, er
 		}
 		built[pkg.ID] = none{}
 
+		start := time.Now()
+		ctx.event(BuildEvent{ImportPath: pkg.PkgPath, Action: ActionBuildStart})
+		defer func() {
+			if err != nil || !ctx.linkLater(pkg, start) {
+				ctx.pkgDone(pkg.PkgPath, start, err)
+			}
+		}()
+
 		switch kind, param := cl.PkgKindOf(pkg.Types); kind {
 		case cl.PkgDeclOnly:
 			pkg.ExportFile = ""
@@ -659,13 +711,7 @@ func buildAllPkgs(ctx *context, pkgs []*aPackage, verbose bool) ([]*aPackage, er
 					return err
 				}
 				ctx.tryLoadFromCache(aPkg)
-				if verbose {
-					if aPkg.CacheHit {
-						fmt.Fprintf(os.Stderr, "CACHE HIT: %s\n", pkg.PkgPath)
-					} else {
-						fmt.Fprintf(os.Stderr, "CACHE MISS: %s\n", pkg.PkgPath)
-					}
-				}
+				ctx.reportCache(aPkg, verbose)
 				if err := buildPkg(ctx, aPkg, verbose); err != nil {
 					return err
 				}
@@ -691,13 +737,7 @@ func buildAllPkgs(ctx *context, pkgs []*aPackage, verbose bool) ([]*aPackage, er
 				return err
 			}
 			ctx.tryLoadFromCache(aPkg)
-			if verbose {
-				if aPkg.CacheHit {
-					fmt.Fprintf(os.Stderr, "CACHE HIT: %s\n", pkg.PkgPath)
-				} else {
-					fmt.Fprintf(os.Stderr, "CACHE MISS: %s\n", pkg.PkgPath)
-				}
-			}
+			ctx.reportCache(aPkg, verbose)
 			if err := buildPkg(ctx, aPkg, verbose); err != nil {
 				return err
 			}
@@ -735,17 +775,49 @@ func buildAllPkgs(ctx *context, pkgs []*aPackage, verbose bool) ([]*aPackage, er
 	return pkgs, nil
 }
 
+// reportCache reports whether aPkg was loaded from the build cache.
+func (c *context) reportCache(aPkg *aPackage, verbose bool) {
+	action := ActionCacheMiss
+	if aPkg.CacheHit {
+		action = ActionCacheHit
+	}
+	if verbose {
+		if aPkg.CacheHit {
+			fmt.Fprintf(os.Stderr, "CACHE HIT: %s\n", aPkg.PkgPath)
+		} else {
+			fmt.Fprintf(os.Stderr, "CACHE MISS: %s\n", aPkg.PkgPath)
+		}
+	}
+	c.event(BuildEvent{ImportPath: aPkg.PkgPath, Action: action, Fingerprint: aPkg.Fingerprint})
+}
+
 func appendExternalLinkArgs(ctx *context, aPkg *aPackage, spec string) {
+	expdArgs, pkgLinkArgs, nLibdir := expandLinkSpec(spec)
+	if len(expdArgs) == 0 {
+		panic(fmt.Sprintf("'%s' cannot locate the external library", spec))
+	}
+	atomic.AddInt32(&ctx.nLibdir, nLibdir)
+	if ctx.buildConf.CheckLinkArgs {
+		if err := ctx.compiler().CheckLinkArgs(pkgLinkArgs, isWasmTarget(ctx.buildConf.Goos)); err != nil {
+			panic(fmt.Sprintf("test link args '%s' failed\n\texpanded to: %v\n\tresolved to: %v\n\terror: %v", spec, expdArgs, pkgLinkArgs, err))
+		}
+	}
+	aPkg.LinkArgs = append(aPkg.LinkArgs, pkgLinkArgs...)
+}
+
+// expandLinkSpec expands the link spec of an external library package to
+// link args. It also returns the number of library dirs the args may add.
+func expandLinkSpec(spec string) (expdArgs, pkgLinkArgs []string, nLibdir int32) {
 	// need to be linked with external library
 	// format: ';' separated alternative link methods. e.g.
 	//   link: $LLGO_LIB_PYTHON; $(pkg-config --libs python3-embed); -lpython3
 	altParts := strings.Split(spec, ";")
-	expdArgs := make([]string, 0, len(altParts))
+	expdArgs = make([]string, 0, len(altParts))
 	for _, alt := range altParts {
 		alt = strings.TrimSpace(alt)
 		if strings.ContainsRune(alt, '$') {
 			expdArgs = append(expdArgs, xenv.ExpandEnvToArgs(alt)...)
-			atomic.AddInt32(&ctx.nLibdir, 1)
+			nLibdir++
 		} else {
 			fields := strings.Fields(alt)
 			expdArgs = append(expdArgs, fields...)
@@ -755,10 +827,10 @@ func appendExternalLinkArgs(ctx *context, aPkg *aPackage, spec string) {
 		}
 	}
 	if len(expdArgs) == 0 {
-		panic(fmt.Sprintf("'%s' cannot locate the external library", spec))
+		return
 	}
 
-	pkgLinkArgs := make([]string, 0, 3)
+	pkgLinkArgs = make([]string, 0, 3)
 	if expdArgs[0][0] == '-' {
 		pkgLinkArgs = append(pkgLinkArgs, expdArgs...)
 	} else {
@@ -767,15 +839,10 @@ func appendExternalLinkArgs(ctx *context, aPkg *aPackage, spec string) {
 		pkgLinkArgs = append(pkgLinkArgs, "-l"+lib)
 		if dir != "" {
 			pkgLinkArgs = append(pkgLinkArgs, "-L"+dir)
-			atomic.AddInt32(&ctx.nLibdir, 1)
-		}
-	}
-	if ctx.buildConf.CheckLinkArgs {
-		if err := ctx.compiler().CheckLinkArgs(pkgLinkArgs, isWasmTarget(ctx.buildConf.Goos)); err != nil {
-			panic(fmt.Sprintf("test link args '%s' failed\n\texpanded to: %v\n\tresolved to: %v\n\terror: %v", spec, expdArgs, pkgLinkArgs, err))
+			nLibdir++
 		}
 	}
-	aPkg.LinkArgs = append(aPkg.LinkArgs, pkgLinkArgs...)
+	return
 }
 
 var (
@@ -1019,7 +1086,7 @@ func linkMainPkg(ctx *context, pkg *packages.Package, pkgs []*aPackage, outputPa
 		}
 	}
 
-	err = linkObjFiles(ctx, outputPath, linkInputs, linkArgs, verbose)
+	err = linkObjFiles(ctx, pkg.PkgPath, outputPath, linkInputs, linkArgs, verbose)
 	if err != nil {
 		return err
 	}
@@ -1054,7 +1121,7 @@ func isRuntimePkg(pkgPath string) bool {
 	return pkgPath == rtRoot || strings.HasPrefix(pkgPath, rtRoot+"/")
 }
 
-func linkObjFiles(ctx *context, app string, objFiles, linkArgs []string, verbose bool) error {
+func linkObjFiles(ctx *context, pkgPath, app string, objFiles, linkArgs []string, verbose bool) error {
 	printCmds := ctx.shouldPrintCommands(verbose)
 	// Handle c-archive mode differently - use ar tool instead of linker
 	if ctx.buildConf.BuildMode == BuildModeCArchive {
@@ -1086,7 +1153,11 @@ func linkObjFiles(ctx *context, app string, objFiles, linkArgs []string, verbose
 				if printCmds {
 					fmt.Fprintln(os.Stderr, "clang", args)
 				}
-				if err := ctx.compiler().Compile(args...); err != nil {
+				cmd := ctx.compiler()
+				flush := ctx.captureOutput(pkgPath, &cmd.Stdout, &cmd.Stderr)
+				err := cmd.Compile(args...)
+				flush()
+				if err != nil {
 					return fmt.Errorf("failed to compile %s: %v", objFile, err)
 				}
 				compiledObjFiles = append(compiledObjFiles, oFile)
@@ -1099,9 +1170,22 @@ func linkObjFiles(ctx *context, app string, objFiles, linkArgs []string, verbose
 
 	buildArgs = append(buildArgs, objFiles...)
 
+	start := time.Now()
 	cmd := ctx.linker()
 	cmd.Verbose = printCmds
-	return cmd.Link(buildArgs...)
+	flush := ctx.captureOutput(pkgPath, &cmd.Stdout, &cmd.Stderr)
+	var linkOut bytes.Buffer
+	cmd.Stderr = io.MultiWriter(cmd.Stderr, &linkOut)
+	err := cmd.Link(buildArgs...)
+	flush()
+	if err != nil {
+		if diag := ctx.diagnoseLink(linkOut.Bytes()); diag != "" {
+			err = fmt.Errorf("%w\n%s", err, diag)
+		}
+	}
+	// the link fails the package with its build-fail event, see linkDone
+	ctx.timedEvent(BuildEvent{ImportPath: pkgPath, Action: ActionLink, Command: cmd.LinkCommand(buildArgs...)}, start, nil)
+	return err
 }
 
 // archiver returns the archiving tool to use for the current context.
@@ -1119,7 +1203,7 @@ func (c *context) archiver() string {
 		}
 	}
 	// Allow user override
-	if ar := os.Getenv("LLGO_AR"); ar != "" {
+	if ar := env.Getenv(llgoAr); ar != "" {
 		return ar
 	}
 	// For wasm targets, prefer llvm-ar from PATH (system ar cannot create valid wasm archives)
@@ -1247,7 +1331,7 @@ func buildPkg(ctx *context, aPkg *aPackage, verbose bool) error {
 	}
 
 	printCmds := ctx.shouldPrintCommands(verbose)
-	cgoLLFiles, cgoLdflags, err := buildCgo(ctx, aPkg, aPkg.Package.Syntax, externs, printCmds)
+	cgoLLFiles, cgoLdflags, err := buildCgo(ctx, aPkg, aPkg.Package.Syntax, aPkg.Package.OtherFiles, externs, printCmds)
 	if err != nil {
 		return fmt.Errorf("build cgo of %v failed: %v", pkgPath, err)
 	}
@@ -1266,7 +1350,7 @@ func buildPkg(ctx *context, aPkg *aPackage, verbose bool) error {
 	aPkg.LinkArgs = append(aPkg.LinkArgs, cgoLdflags...)
 	aPkg.LinkArgs = append(aPkg.LinkArgs, goCgoLinkArgs(ctx.buildConf.Goos, aPkg.Package.Syntax)...)
 	if aPkg.AltPkg != nil {
-		altLLFiles, altLdflags, e := buildCgo(ctx, aPkg, aPkg.AltPkg.Syntax, externs, printCmds)
+		altLLFiles, altLdflags, e := buildCgo(ctx, aPkg, aPkg.AltPkg.Syntax, aPkg.AltPkg.OtherFiles, externs, printCmds)
 		if e != nil {
 			return fmt.Errorf("build cgo of %v failed: %v", pkgPath, e)
 		}
@@ -1412,43 +1496,23 @@ type aPackage struct {
 	Fingerprint string // fingerprint digest
 	Manifest    string // manifest text content
 	CacheHit    bool   // whether cache was hit
+
+	Info    *PkgInfo // how the package is built, in ModeList only
+	depOnly bool     // in ModeList, a dependency not matched by the patterns
 }
 
 type Package = *aPackage
 
 func buildSSAPkgs(ctx *context, initial []*packages.Package, verbose bool) ([]*aPackage, error) {
-	prog := ctx.progSSA
-	var all []*aPackage
+	var pkgs []*packages.Package
 	var errs []*packages.Package
 	packages.Visit(initial, nil, func(p *packages.Package) {
 		if p.Types != nil && !p.IllTyped {
-			pkgPath := p.PkgPath
 			// Use p.ID to check duplicates since same pkgPath may have different IDs
-			if _, ok := ctx.pkgByID[p.ID]; ok || strings.HasPrefix(pkgPath, altPkgPathPrefix) {
+			if _, ok := ctx.pkgByID[p.ID]; ok || strings.HasPrefix(p.PkgPath, altPkgPathPrefix) {
 				return
 			}
-			var altPkg *packages.Cached
-			var ssaPkg = createSSAPkg(ctx, prog, p, verbose)
-			if ctx.hasAltPkg(pkgPath) {
-				if altPkg = ctx.dedup.Check(altPkgPathPrefix + pkgPath); altPkg == nil {
-					return
-				}
-			}
-			rewrites := collectRewriteVars(ctx, pkgPath)
-			aPkg := &aPackage{
-				Package:     p,
-				SSA:         ssaPkg,
-				AltPkg:      altPkg,
-				LPkg:        nil,
-				NeedRt:      false,
-				NeedPyInit:  false,
-				LinkArgs:    nil,
-				ObjFiles:    nil,
-				rewriteVars: rewrites,
-			}
-			ctx.pkgs[p] = aPkg
-			ctx.pkgByID[p.ID] = aPkg
-			all = append(all, aPkg)
+			pkgs = append(pkgs, p)
 		} else {
 			errs = append(errs, p)
 		}
@@ -1459,9 +1523,37 @@ func buildSSAPkgs(ctx *context, initial []*packages.Package, verbose bool) ([]*a
 				fmt.Fprintln(os.Stderr, err)
 			}
 			fmt.Fprintln(os.Stderr, "cannot build SSA for package", errPkg)
+			ctx.pkgErrors(errPkg)
 		}
 		return nil, fmt.Errorf("cannot build SSA for packages")
 	}
+
+	ssaPkgs := createSSAPkgs(ctx, ctx.progSSA, pkgs, verbose)
+	var all []*aPackage
+	for i, p := range pkgs {
+		pkgPath := p.PkgPath
+		var altPkg *packages.Cached
+		if ctx.hasAltPkg(pkgPath) {
+			if altPkg = ctx.dedup.Check(altPkgPathPrefix + pkgPath); altPkg == nil {
+				continue
+			}
+		}
+		rewrites := collectRewriteVars(ctx, pkgPath)
+		aPkg := &aPackage{
+			Package:     p,
+			SSA:         ssaPkgs[i],
+			AltPkg:      altPkg,
+			LPkg:        nil,
+			NeedRt:      false,
+			NeedPyInit:  false,
+			LinkArgs:    nil,
+			ObjFiles:    nil,
+			rewriteVars: rewrites,
+		}
+		ctx.pkgs[p] = aPkg
+		ctx.pkgByID[p.ID] = aPkg
+		all = append(all, aPkg)
+	}
 	return all, nil
 }
 
@@ -1567,19 +1659,63 @@ func applyPatches(ctx *context, p *packages.Package, verbose bool) {
 	}
 }
 
-func createSSAPkg(ctx *context, prog *ssa.Program, p *packages.Package, verbose bool) *ssa.Package {
-	pkgSSA := prog.ImportedPackage(p.ID)
-	if pkgSSA == nil {
+// ssaBuildJobs is the number of packages createSSAPkgs patches and builds
+// concurrently, GOMAXPROCS if 0.
+var ssaBuildJobs = 0
+
+// createSSAPkgs returns the SSA packages of pkgs, creating and building the
+// ones prog doesn't have yet. pkgs are patched and built concurrently: each
+// package is patched before any is built, as building a package may
+// instantiate generic functions of the others from their syntax.
+func createSSAPkgs(ctx *context, prog *ssa.Program, pkgs []*packages.Package, verbose bool) []*ssa.Package {
+	ret := make([]*ssa.Package, len(pkgs))
+	var created []int
+	for i, p := range pkgs {
+		if ret[i] = prog.ImportedPackage(p.ID); ret[i] == nil {
+			created = append(created, i)
+		}
+	}
+	// applyPatches only changes the type info of the package it patches
+	parallelDo(len(created), func(i int) {
+		applyPatches(ctx, pkgs[created[i]], verbose)
+	})
+	// CreatePackage isn't goroutine-safe, unlike Package.Build
+	for _, i := range created {
+		p := pkgs[i]
 		if debugBuild || verbose {
 			log.Println("==> BuildSSA", p.ID)
 		}
-		applyPatches(ctx, p, verbose)
-		pkgSSA = prog.CreatePackage(p.Types, p.Syntax, p.TypesInfo, true)
-		pkgSSA.Build() // TODO(xsw): build concurrently
+		ret[i] = prog.CreatePackage(p.Types, p.Syntax, p.TypesInfo, true)
+	}
+	parallelDo(len(created), func(i int) {
+		pkgSSA := ret[created[i]]
+		pkgSSA.Build()
 		// Apply local SSA fixups once when package SSA is first built.
 		fixSSAOrder(pkgSSA)
+	})
+	return ret
+}
+
+// parallelDo calls fn(0) ... fn(n-1), ssaBuildJobs at a time.
+func parallelDo(n int, fn func(i int)) {
+	jobs := ssaBuildJobs
+	if jobs <= 0 {
+		jobs = runtime.GOMAXPROCS(0)
+	}
+	sem := make(chan none, jobs)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- none{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			fn(i)
+		}()
 	}
-	return pkgSSA
+	wg.Wait()
 }
 
 /*
@@ -1612,22 +1748,23 @@ const llgoWasiThreads = "LLGO_WASI_THREADS"
 const llgoStdioNobuf = "LLGO_STDIO_NOBUF"
 const llgoFullRpath = "LLGO_FULL_RPATH"
 const llgoBuildCache = "LLGO_BUILD_CACHE"
+const llgoAr = "LLGO_AR"
 
 // for Plan9 asm translation debug
 const llgoPlan9ASMPkgs = "LLGO_PLAN9ASM_PKGS"
 
 const defaultWasmRuntime = "wasmtime"
 
-func defaultEnv(env string, defVal string) string {
-	envVal := os.Getenv(env)
+func defaultEnv(name string, defVal string) string {
+	envVal := env.Getenv(name)
 	if envVal == "" {
 		return defVal
 	}
 	return envVal
 }
 
-func isEnvOn(env string, defVal bool) bool {
-	envVal := strings.ToLower(os.Getenv(env))
+func isEnvOn(name string, defVal bool) bool {
+	envVal := strings.ToLower(env.Getenv(name))
 	if envVal == "" {
 		return defVal
 	}
@@ -1717,13 +1854,26 @@ func clFile(ctx *context, args []string, cFile, expFile, pkgPath string, procFil
 	ext := filepath.Ext(cFile)
 
 	// default clang++ will use c++ to compile c file,will cause symbol be mangled
-	if ext == ".c" {
+	isAsm := false
+	switch cgoSrcKindOf(cFile) {
+	case cgoSrcC:
 		args = append(args, "-x", "c")
+	case cgoSrcCXX:
+		args = append(args, "-x", "c++")
+	case cgoSrcObjC:
+		args = append(args, "-x", "objective-c")
+	case cgoSrcAsm:
+		isAsm = true
+		if ext == ".s" {
+			args = append(args, "-x", "assembler")
+		} else {
+			args = append(args, "-x", "assembler-with-cpp")
+		}
 	}
 
 	// If GenLL is enabled, first emit .ll for debugging, then compile to .o
 	printCmds := ctx.shouldPrintCommands(verbose)
-	if ctx.buildConf.GenLL {
+	if ctx.buildConf.GenLL && !isAsm {
 		llFile := baseName + ".ll"
 		llArgs := append(slices.Clone(args), "-emit-llvm", "-S", "-o", llFile, "-c", cFile)
 		if printCmds {
@@ -1731,7 +1881,9 @@ func clFile(ctx *context, args []string, cFile, expFile, pkgPath string, procFil
 			fmt.Fprintln(os.Stderr, "clang", llArgs)
 		}
 		cmd := ctx.compiler()
+		flush := ctx.captureOutput(pkgPath, &cmd.Stdout, &cmd.Stderr)
 		err := cmd.Compile(llArgs...)
+		flush()
 		check(err)
 	}
 
@@ -1742,8 +1894,13 @@ func clFile(ctx *context, args []string, cFile, expFile, pkgPath string, procFil
 		fmt.Fprintf(os.Stderr, "# compiling %s for pkg: %s\n", objFile, pkgPath)
 		fmt.Fprintln(os.Stderr, "clang", objArgs)
 	}
+	start := time.Now()
 	cmd := ctx.compiler()
+	flush := ctx.captureOutput(pkgPath, &cmd.Stdout, &cmd.Stderr)
 	err := cmd.Compile(objArgs...)
+	flush()
+	ctx.timedEvent(BuildEvent{ImportPath: pkgPath, Action: ActionCompile, File: cFile,
+		Command: cmd.CompileCommand(objArgs...)}, start, err)
 	check(err)
 	procFile(objFile)
 }
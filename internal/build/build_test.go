@@ -6,16 +6,20 @@ package build
 import (
 	"bytes"
 	"fmt"
+	"go/token"
 	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"testing"
 
+	"github.com/goplus/llgo/internal/crosscompile"
 	"github.com/goplus/llgo/internal/mockable"
 	"github.com/goplus/llgo/internal/packages"
+	"golang.org/x/tools/go/ssa"
 )
 
 func TestMain(m *testing.M) {
@@ -95,6 +99,34 @@ func TestExtest(t *testing.T) {
 	}
 }
 
+// TestRunLinuxCross builds a program for the Linux architectures supported
+// through GNU cross toolchains and runs it under qemu-user. Architectures
+// whose toolchain or emulator isn't installed are skipped.
+func TestRunLinuxCross(t *testing.T) {
+	if testing.Short() || runtime.GOOS != "linux" {
+		t.Skip("requires a Linux host with cross toolchains")
+	}
+	for _, goarch := range []string{"loong64", "ppc64le", "s390x", "mips64"} {
+		t.Run(goarch, func(t *testing.T) {
+			if runtime.GOARCH == goarch {
+				t.Skip("not a cross build")
+			}
+			export, err := crosscompile.Use("linux", goarch, "", false, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			emu := strings.Fields(export.Emulator) // qemu-<arch> -L <sysroot> {}
+			if _, err := exec.LookPath(emu[0]); err != nil {
+				t.Skipf("%s not installed", emu[0])
+			}
+			if _, err := os.Stat(emu[2]); err != nil {
+				t.Skipf("cross toolchain not installed: %v", err)
+			}
+			mockRun([]string{"../../cl/_testgo/print"}, &Config{Mode: ModeRun, Goos: "linux", Goarch: goarch})
+		})
+	}
+}
+
 func TestCmpTest(t *testing.T) {
 	mockRun([]string{"../../cl/_testgo/runtest"}, &Config{Mode: ModeCmpTest})
 }
@@ -318,3 +350,96 @@ func TestCmpTestNonexistentPatternReturnsError(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+// loadSSATestPkgs loads the packages of patterns as Do does, without alt
+// packages.
+func loadSSATestPkgs(tb testing.TB, patterns ...string) (*packages.Config, packages.Deduper, []*packages.Package) {
+	tb.Helper()
+	cfg := &packages.Config{
+		Mode:       loadSyntax | packages.NeedDeps,
+		BuildFlags: []string{"-tags=llgo,math_big_pure_go,purego"},
+		Fset:       token.NewFileSet(),
+	}
+	dedup := packages.NewDeduper()
+	initial, err := packages.LoadEx(dedup, nil, cfg, patterns...)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return cfg, dedup, initial
+}
+
+func newSSATestContext(cfg *packages.Config, dedup packages.Deduper) *context {
+	return &context{
+		conf:      cfg,
+		progSSA:   ssa.NewProgram(cfg.Fset, ssaBuildMode),
+		dedup:     dedup,
+		buildConf: &Config{Goos: runtime.GOOS, Goarch: runtime.GOARCH},
+		pkgs:      map[*packages.Package]Package{},
+		pkgByID:   map[string]Package{},
+	}
+}
+
+// ssaShape describes the functions of the package pkgPath in pkgs by their
+// blocks and instructions. The text of instructions calling generic functions
+// depends on which package instantiated them first, eg. with byte or uint8.
+func ssaShape(pkgs []*aPackage, pkgPath string) string {
+	var b bytes.Buffer
+	for _, p := range pkgs {
+		if p.PkgPath != pkgPath {
+			continue
+		}
+		names := make([]string, 0, len(p.SSA.Members))
+		for name := range p.SSA.Members {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if fn, ok := p.SSA.Members[name].(*ssa.Function); ok {
+				fmt.Fprintf(&b, "%s:", name)
+				for _, blk := range fn.Blocks {
+					fmt.Fprintf(&b, " %d", len(blk.Instrs))
+				}
+				fmt.Fprintf(&b, " anon %d\n", len(fn.AnonFuncs))
+			}
+		}
+	}
+	return b.String()
+}
+
+func TestBuildSSAPkgsConcurrently(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the SSA of encoding/json and its dependencies")
+	}
+	cfg, dedup, initial := loadSSATestPkgs(t, "encoding/json")
+	build := func(jobs int) string {
+		defer func(old int) { ssaBuildJobs = old }(ssaBuildJobs)
+		ssaBuildJobs = jobs
+		pkgs, err := buildSSAPkgs(newSSATestContext(cfg, dedup), initial, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ssaShape(pkgs, "encoding/json")
+	}
+	serial, parallel := build(1), build(8)
+	if serial == "" || serial != parallel {
+		t.Fatalf("SSA built concurrently differs from SSA built serially:\n%s\nvs\n%s", parallel, serial)
+	}
+}
+
+func BenchmarkBuildSSAPkgs(b *testing.B) {
+	cfg, dedup, initial := loadSSATestPkgs(b, "net/http")
+	for _, bm := range []struct {
+		name string
+		jobs int
+	}{{"serial", 1}, {"parallel", 0}} {
+		b.Run(bm.name, func(b *testing.B) {
+			defer func(old int) { ssaBuildJobs = old }(ssaBuildJobs)
+			ssaBuildJobs = bm.jobs
+			for i := 0; i < b.N; i++ {
+				if _, err := buildSSAPkgs(newSSATestContext(cfg, dedup), initial, false); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -26,17 +26,88 @@ import (
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/goplus/llgo/internal/buildtags"
+	"github.com/goplus/llgo/internal/env"
+	"github.com/goplus/llgo/internal/header"
 	llssa "github.com/goplus/llgo/ssa"
 	"github.com/goplus/llgo/xtool/safesplit"
 )
 
 type cgoDecl struct {
-	tag     string
-	cflags  []string
-	ldflags []string
+	tag      string
+	cppflags []string
+	cflags   []string
+	cxxflags []string
+	fflags   []string
+	ldflags  []string
+}
+
+// cgoFlags holds the flags a cgo package is built with, by kind, as taken
+// from the CGO_* environment variables and the #cgo directives.
+type cgoFlags struct {
+	cppflags []string
+	cflags   []string
+	cxxflags []string
+	fflags   []string
+	ldflags  []string
+}
+
+func (f *cgoFlags) add(decl *cgoDecl) {
+	f.cppflags = append(f.cppflags, decl.cppflags...)
+	f.cflags = append(f.cflags, decl.cflags...)
+	f.cxxflags = append(f.cxxflags, decl.cxxflags...)
+	f.fflags = append(f.fflags, decl.fflags...)
+	f.ldflags = append(f.ldflags, decl.ldflags...)
+}
+
+// cgoEnvVars are the environment variables buildCgo takes flags and tools
+// from, which go in the build fingerprint.
+var cgoEnvVars = []string{"CGO_CPPFLAGS", "CGO_CFLAGS", "CGO_CXXFLAGS", "CGO_FFLAGS", "CGO_LDFLAGS", "FC"}
+
+// cgoEnvFlags returns the flags of the CGO_* environment variables, which go
+// before the ones of #cgo directives, as with go build.
+func cgoEnvFlags() cgoFlags {
+	return cgoFlags{
+		cppflags: strings.Fields(env.Getenv("CGO_CPPFLAGS")),
+		cflags:   strings.Fields(env.Getenv("CGO_CFLAGS")),
+		cxxflags: strings.Fields(env.Getenv("CGO_CXXFLAGS")),
+		fflags:   strings.Fields(env.Getenv("CGO_FFLAGS")),
+		ldflags:  strings.Fields(env.Getenv("CGO_LDFLAGS")),
+	}
+}
+
+// cgoSrcKind tells how buildCgo compiles a source file of a cgo package.
+type cgoSrcKind int
+
+const (
+	cgoSrcNone cgoSrcKind = iota
+	cgoSrcC
+	cgoSrcCXX
+	cgoSrcObjC
+	cgoSrcAsm
+	cgoSrcFortran
+)
+
+// cgoSrcKindOf returns the kind of source file by its extension, which is the
+// same as for go build.
+func cgoSrcKindOf(file string) cgoSrcKind {
+	switch filepath.Ext(file) {
+	case ".c":
+		return cgoSrcC
+	case ".cc", ".cpp", ".cxx":
+		return cgoSrcCXX
+	case ".m":
+		return cgoSrcObjC
+	case ".s", ".S", ".sx":
+		return cgoSrcAsm
+	case ".f", ".F", ".for", ".f90":
+		return cgoSrcFortran
+	}
+	return cgoSrcNone
 }
 
 type cgoPreamble struct {
@@ -53,8 +124,8 @@ static void* _Cmalloc(size_t size) {
 `
 )
 
-func buildCgo(ctx *context, pkg *aPackage, files []*ast.File, externs []string, verbose bool) (llfiles, cgoLdflags []string, err error) {
-	cfiles, preambles, cdecls, err := parseCgo_(pkg, files)
+func buildCgo(ctx *context, pkg *aPackage, files []*ast.File, otherFiles, externs []string, verbose bool) (llfiles, cgoLdflags []string, err error) {
+	srcs, preambles, cdecls, err := parseCgo_(pkg, files, otherFiles)
 	if err != nil {
 		return
 	}
@@ -65,16 +136,13 @@ func buildCgo(ctx *context, pkg *aPackage, files []*ast.File, externs []string,
 		}
 	}
 	buildtags.CheckTags(ctx.conf.BuildFlags, tagUsed)
-	cflags := []string{}
-	ldflags := []string{}
-	for _, cdecl := range cdecls {
-		if cdecl.tag == "" || tagUsed[cdecl.tag] {
-			if len(cdecl.cflags) > 0 {
-				cflags = append(cflags, cdecl.cflags...)
-			}
-			if len(cdecl.ldflags) > 0 {
-				ldflags = append(ldflags, cdecl.ldflags...)
-			}
+	flags := cgoFlags{}
+	if len(preambles) > 0 {
+		flags = cgoEnvFlags()
+	}
+	for i := range cdecls {
+		if cdecl := &cdecls[i]; cdecl.tag == "" || tagUsed[cdecl.tag] {
+			flags.add(cdecl)
 		}
 	}
 	incDirs := make(map[string]none)
@@ -82,13 +150,38 @@ func buildCgo(ctx *context, pkg *aPackage, files []*ast.File, externs []string,
 		dir, _ := filepath.Split(preamble.goFile)
 		if _, ok := incDirs[dir]; !ok {
 			incDirs[dir] = none{}
-			cflags = append(cflags, "-I"+dir)
+			flags.cppflags = append(flags.cppflags, "-I"+dir)
 		}
 	}
-	for _, cfile := range cfiles {
-		clFile(ctx, cflags, cfile, pkg.ExportFile, pkg.PkgPath, func(linkFile string) {
+	hdrDir, err := genCgoExportHeader(ctx, pkg, files, preambles)
+	if err != nil {
+		return
+	}
+	if hdrDir != "" {
+		defer os.RemoveAll(hdrDir)
+		flags.cppflags = append(flags.cppflags, "-I"+hdrDir)
+	}
+	cflags := append(slices.Clone(flags.cppflags), flags.cflags...)
+	cxxflags := append(slices.Clone(flags.cppflags), flags.cxxflags...)
+	fflags := append(slices.Clone(flags.cppflags), flags.fflags...)
+	ldflags := flags.ldflags
+	hasCXX, hasFortran := false, false
+	for _, src := range srcs {
+		addFile := func(linkFile string) {
 			llfiles = append(llfiles, linkFile)
-		}, verbose)
+		}
+		switch cgoSrcKindOf(src) {
+		case cgoSrcCXX:
+			hasCXX = true
+			clFile(ctx, cxxflags, src, pkg.ExportFile, pkg.PkgPath, addFile, verbose)
+		case cgoSrcFortran:
+			hasFortran = true
+			if err = fcFile(ctx, fflags, src, pkg.ExportFile, pkg.PkgPath, addFile, verbose); err != nil {
+				return
+			}
+		default:
+			clFile(ctx, cflags, src, pkg.ExportFile, pkg.PkgPath, addFile, verbose)
+		}
 	}
 	re := regexp.MustCompile(`^(_cgo_[^_]+_(C2func|Cfunc|Cmacro)_)(.*)$`)
 	cgoSymbols := make(map[string]string)
@@ -136,9 +229,62 @@ func buildCgo(ctx *context, pkg *aPackage, files []*ast.File, externs []string,
 	for _, ldflag := range ldflags {
 		cgoLdflags = append(cgoLdflags, safesplit.SplitPkgConfigFlags(ldflag)...)
 	}
+	if hasCXX {
+		cgoLdflags = append(cgoLdflags, cxxStdLibFlags(ctx)...)
+	}
+	if hasFortran && !slices.ContainsFunc(cgoLdflags, func(flag string) bool {
+		return strings.Contains(flag, "gfortran")
+	}) {
+		cgoLdflags = append(cgoLdflags, "-lgfortran")
+	}
 	return
 }
 
+// cxxStdLibFlags returns the flags linking the C++ standard library C++
+// sources of cgo packages need: libc++ on Apple systems and libstdc++ on
+// Linux. Other targets are linked with their own runtime libraries.
+func cxxStdLibFlags(ctx *context) []string {
+	if ctx.buildConf.Target != "" {
+		return nil
+	}
+	switch ctx.buildConf.Goos {
+	case "darwin", "ios":
+		return []string{"-lc++"}
+	case "linux":
+		return []string{"-lstdc++"}
+	}
+	return nil
+}
+
+// fcFile compiles the Fortran source file of a cgo package with $FC, or
+// gfortran, as clang doesn't compile Fortran.
+func fcFile(ctx *context, args []string, fFile, expFile, pkgPath string, procFile func(linkFile string), verbose bool) error {
+	fc := env.Getenv("FC")
+	if fc == "" {
+		fc = "gfortran"
+	}
+	objFile := expFile + filepath.Base(fFile) + ".o"
+	args = append(slices.Clone(args), "-o", objFile, "-c", fFile)
+	if ctx.shouldPrintCommands(verbose) {
+		fmt.Fprintf(os.Stderr, "# compiling %s for pkg: %s\n", objFile, pkgPath)
+		fmt.Fprintln(os.Stderr, fc, args)
+	}
+	start := time.Now()
+	cmd := exec.Command(fc, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	flush := ctx.captureOutput(pkgPath, &cmd.Stdout, &cmd.Stderr)
+	err := cmd.Run()
+	flush()
+	ctx.timedEvent(BuildEvent{ImportPath: pkgPath, Action: ActionCompile, File: fFile,
+		Command: append([]string{fc}, args...)}, start, err)
+	if err != nil {
+		return fmt.Errorf("%s %s: %v", fc, fFile, err)
+	}
+	procFile(objFile)
+	return nil
+}
+
 // clangASTNode represents a node in clang's AST
 type clangASTNode struct {
 	Kind  string         `json:"kind"`
@@ -270,36 +416,72 @@ func execCommandVerbose(verbose bool, name string, arg ...string) *exec.Cmd {
 	return exec.Command(name, arg...)
 }
 
-func extractFuncNames(node *clangASTNode, funcNames map[string]bool) {
-	for _, inner := range node.Inner {
-		if inner.Kind == "FunctionDecl" && inner.Name != "" {
-			funcNames[inner.Name] = true
-		}
+// genCgoExportHeader generates _cgo_export.h, through which the C sources of
+// pkg call the functions it exports by //export. It returns the directory of
+// the header, or "" if pkg exports no functions.
+func genCgoExportHeader(ctx *context, pkg *aPackage, files []*ast.File, preambles []cgoPreamble) (dir string, err error) {
+	if len(preambles) == 0 || pkg.LPkg == nil || len(pkg.LPkg.ExportFuncs()) == 0 {
+		return
 	}
-}
-
-func parseCgo_(pkg *aPackage, files []*ast.File) (cfiles []string, preambles []cgoPreamble, cdecls []cgoDecl, err error) {
-	dirs := make(map[string]none)
+	// like cgo, take the preambles of the files with //export only, as the
+	// others may define C functions
+	exporting := make(map[string]bool)
 	for _, file := range files {
-		pos := pkg.Fset.Position(file.Name.NamePos)
-		dir, _ := filepath.Split(pos.Filename)
-		dirs[dir] = none{}
+		if hasCgoExport(file) {
+			exporting[pkg.Fset.Position(file.Package).Filename] = true
+		}
 	}
-	for dir := range dirs {
-		matches, err := filepath.Glob(filepath.Join(dir, "*.c"))
-		if err != nil {
-			continue
+	var b strings.Builder
+	for _, preamble := range preambles {
+		if exporting[preamble.goFile] {
+			b.WriteString(preamble.src)
+			b.WriteString("\n")
 		}
-		for _, match := range matches {
-			if strings.HasSuffix(match, "_test.c") {
-				continue
-			}
-			if fi, err := os.Stat(match); err == nil && !fi.IsDir() {
-				cfiles = append(cfiles, match)
+	}
+	if dir, err = os.MkdirTemp("", "llgo-cgo-export-*"); err != nil {
+		return
+	}
+	f, err := os.Create(filepath.Join(dir, "_cgo_export.h"))
+	if err == nil {
+		err = header.GenCgoExportHeader(ctx.prog, pkg.LPkg, b.String(), f)
+		if e := f.Close(); err == nil {
+			err = e
+		}
+	}
+	if err != nil {
+		os.RemoveAll(dir)
+		return "", fmt.Errorf("failed to generate _cgo_export.h: %v", err)
+	}
+	return
+}
+
+// hasCgoExport reports whether file exports functions to C by //export.
+func hasCgoExport(file *ast.File) bool {
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Doc != nil {
+			for _, c := range fn.Doc.List {
+				if strings.HasPrefix(c.Text, "//export ") {
+					return true
+				}
 			}
 		}
 	}
+	return false
+}
+
+func extractFuncNames(node *clangASTNode, funcNames map[string]bool) {
+	for _, inner := range node.Inner {
+		if inner.Kind == "FunctionDecl" && inner.Name != "" {
+			funcNames[inner.Name] = true
+		}
+	}
+}
 
+// parseCgo_ returns the preambles and #cgo directives of files, and the
+// sources among otherFiles to compile, which go list selected by build
+// constraints. Like go build, it compiles C++, Objective-C, assembly and
+// Fortran sources only in packages using cgo, but C sources in any package.
+func parseCgo_(pkg *aPackage, files []*ast.File, otherFiles []string) (srcs []string, preambles []cgoPreamble, cdecls []cgoDecl, err error) {
 	for _, file := range files {
 		for _, decl := range file.Decls {
 			switch decl := decl.(type) {
@@ -321,6 +503,18 @@ func parseCgo_(pkg *aPackage, files []*ast.File) (cfiles []string, preambles []c
 			}
 		}
 	}
+
+	for _, file := range otherFiles {
+		kind := cgoSrcKindOf(file)
+		if kind == cgoSrcNone || (kind != cgoSrcC && len(preambles) == 0) {
+			continue
+		}
+		name := filepath.Base(file)
+		if strings.HasSuffix(strings.TrimSuffix(name, filepath.Ext(name)), "_test") {
+			continue
+		}
+		srcs = append(srcs, file)
+	}
 	return
 }
 
@@ -359,6 +553,8 @@ func parseCgoPreamble(pos token.Position, text string) (preamble cgoPreamble, de
 // #cgo windows LDFLAGS: -LC:/Python312/libs -lpython312
 // #cgo linux CPPFLAGS: -I/usr/lib/llvm-19/include -D_GNU_SOURCE
 // #cgo CFLAGS: -I/usr/include/python3.12
+// #cgo CXXFLAGS: -std=c++17
+// #cgo FFLAGS: -ffixed-form
 // #cgo LDFLAGS: -L/usr/lib/python3.12/config-3.12-x86_64-linux-gnu -lpython3.12
 func parseCgoDecl(line string) (cgoDecls []cgoDecl, err error) {
 	idx := strings.Index(line, ":")
@@ -402,15 +598,30 @@ func parseCgoDecl(line string) (cgoDecls []cgoDecl, err error) {
 			return
 		}
 		cgoDecls = append(cgoDecls, cgoDecl{
-			tag:     tag,
-			cflags:  safesplit.SplitPkgConfigFlags(string(cflags)),
-			ldflags: safesplit.SplitPkgConfigFlags(string(ldflags)),
+			tag:      tag,
+			cppflags: safesplit.SplitPkgConfigFlags(string(cflags)),
+			ldflags:  safesplit.SplitPkgConfigFlags(string(ldflags)),
+		})
+	case "CPPFLAGS":
+		cgoDecls = append(cgoDecls, cgoDecl{
+			tag:      tag,
+			cppflags: safesplit.SplitPkgConfigFlags(arg),
 		})
-	case "CPPFLAGS", "CFLAGS":
+	case "CFLAGS":
 		cgoDecls = append(cgoDecls, cgoDecl{
 			tag:    tag,
 			cflags: safesplit.SplitPkgConfigFlags(arg),
 		})
+	case "CXXFLAGS":
+		cgoDecls = append(cgoDecls, cgoDecl{
+			tag:      tag,
+			cxxflags: safesplit.SplitPkgConfigFlags(arg),
+		})
+	case "FFLAGS":
+		cgoDecls = append(cgoDecls, cgoDecl{
+			tag:    tag,
+			fflags: safesplit.SplitPkgConfigFlags(arg),
+		})
 	case "LDFLAGS":
 		cgoDecls = append(cgoDecls, cgoDecl{
 			tag:     tag,
@@ -4,9 +4,15 @@
 package build
 
 import (
+	"go/token"
+	"os"
+	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
+
+	"github.com/goplus/llgo/internal/packages"
 )
 
 func TestParseCgoDeclFlags(t *testing.T) {
@@ -21,8 +27,8 @@ func TestParseCgoDeclFlags(t *testing.T) {
 			line: "#cgo linux CPPFLAGS: -I/usr/lib/llvm-19/include -D_GNU_SOURCE",
 			want: []cgoDecl{
 				{
-					tag:    "linux",
-					cflags: []string{"-I/usr/lib/llvm-19/include", "-D_GNU_SOURCE"},
+					tag:      "linux",
+					cppflags: []string{"-I/usr/lib/llvm-19/include", "-D_GNU_SOURCE"},
 				},
 			},
 		},
@@ -35,9 +41,28 @@ func TestParseCgoDeclFlags(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "CXXFLAGS",
+			line: "#cgo darwin CXXFLAGS: -std=c++17 -O2",
+			want: []cgoDecl{
+				{
+					tag:      "darwin",
+					cxxflags: []string{"-std=c++17", "-O2"},
+				},
+			},
+		},
+		{
+			name: "FFLAGS",
+			line: "#cgo FFLAGS: -ffixed-form",
+			want: []cgoDecl{
+				{
+					fflags: []string{"-ffixed-form"},
+				},
+			},
+		},
 		{
 			name:        "unsupported flag returns error",
-			line:        "#cgo CXXFLAGS: -O2",
+			line:        "#cgo OBJCFLAGS: -O2",
 			wantErrText: "unsupported cgo flag type",
 		},
 	}
@@ -63,3 +88,97 @@ func TestParseCgoDeclFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestCgoSrcKindOf(t *testing.T) {
+	tests := map[string]cgoSrcKind{
+		"foo.c":      cgoSrcC,
+		"foo.cc":     cgoSrcCXX,
+		"foo.cpp":    cgoSrcCXX,
+		"foo.cxx":    cgoSrcCXX,
+		"foo.m":      cgoSrcObjC,
+		"foo.s":      cgoSrcAsm,
+		"foo.S":      cgoSrcAsm,
+		"foo.sx":     cgoSrcAsm,
+		"foo.f":      cgoSrcFortran,
+		"foo.F":      cgoSrcFortran,
+		"foo.for":    cgoSrcFortran,
+		"foo.f90":    cgoSrcFortran,
+		"foo.h":      cgoSrcNone,
+		"foo.hpp":    cgoSrcNone,
+		"foo.go":     cgoSrcNone,
+		"foo.syso":   cgoSrcNone,
+		"dir/bar.cc": cgoSrcCXX,
+	}
+	for file, want := range tests {
+		if got := cgoSrcKindOf(file); got != want {
+			t.Errorf("cgoSrcKindOf(%q) = %v, want %v", file, got, want)
+		}
+	}
+}
+
+func TestCgoEnvFlags(t *testing.T) {
+	t.Setenv("CGO_CPPFLAGS", "-DFOO=1")
+	t.Setenv("CGO_CFLAGS", "-O1  -g")
+	t.Setenv("CGO_CXXFLAGS", "-std=c++20")
+	t.Setenv("CGO_FFLAGS", "")
+	t.Setenv("CGO_LDFLAGS", "-lm")
+
+	flags := cgoEnvFlags()
+	flags.add(&cgoDecl{cflags: []string{"-DBAR"}, cxxflags: []string{"-fno-rtti"}, ldflags: []string{"-lz"}})
+	want := cgoFlags{
+		cppflags: []string{"-DFOO=1"},
+		cflags:   []string{"-O1", "-g", "-DBAR"},
+		cxxflags: []string{"-std=c++20", "-fno-rtti"},
+		ldflags:  []string{"-lm", "-lz"},
+	}
+	if !reflect.DeepEqual(flags, want) {
+		t.Fatalf("flags = %#v, want %#v", flags, want)
+	}
+}
+
+func TestParseCgoSources(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":      "module example.com/cgosrc\n\ngo 1.21\n",
+		"a.go":        "package cgosrc\n\n// int twice(int);\nimport \"C\"\n",
+		"x.c":         "int x;\n",
+		"y_linux.c":   "int y;\n",
+		"y_darwin.c":  "int y;\n",
+		"z.cpp":       "//go:build ignore\n\nint z;\n",
+		"w.cc":        "int w;\n",
+		"w.h":         "int w(void);\n",
+		"x_test.c":    "int t;\n",
+		"twice_arm.S": "\n",
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cfg := &packages.Config{
+		Mode: loadSyntax,
+		Dir:  dir,
+		Fset: token.NewFileSet(),
+		Env:  append(os.Environ(), "CGO_ENABLED=1", "GOOS=linux", "GOARCH=amd64", "GOFLAGS="),
+	}
+	pkgs, err := packages.LoadEx(packages.NewDeduper(), nil, cfg, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := pkgs[0]
+	srcs, preambles, _, err := parseCgo_(&aPackage{Package: p}, p.Syntax, p.OtherFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(preambles) != 1 {
+		t.Fatalf("got %d preambles, want 1", len(preambles))
+	}
+	var got []string
+	for _, src := range srcs {
+		got = append(got, filepath.Base(src))
+	}
+	sort.Strings(got)
+	if want := []string{"w.cc", "x.c", "y_linux.c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sources = %v, want %v", got, want)
+	}
+}
@@ -18,16 +18,81 @@ package build
 
 import (
 	"bytes"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"io"
-	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
 )
 
-func cmpTest(dir, pkgPath, llApp string, genExpect bool, runArgs []string) {
+// cmpTestCase is a main package run by "llgo cmptest".
+type cmpTestCase struct {
+	dir     string
+	pkgPath string
+	app     string // the program built by llgo
+
+	err     error // the mismatch found, nil if the case passed
+	elapsed time.Duration
+}
+
+// runCmpTests runs the cases collected by runNative and runInEmulator, at
+// most conf.CmpTestParallel at a time, and reports them in order.
+func runCmpTests(cases []*cmpTestCase, conf *Config, w io.Writer) error {
+	n := conf.CmpTestParallel
+	if n <= 0 {
+		n = runtime.GOMAXPROCS(0)
+	}
+	sem := make(chan struct{}, n)
+	var wg sync.WaitGroup
+	for _, c := range cases {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			start := time.Now()
+			c.err = cmpTest(c.dir, c.pkgPath, c.app, conf.GenExpect, conf.RunArgs)
+			c.elapsed = time.Since(start)
+		}()
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, c := range cases {
+		if c.err != nil {
+			failed++
+			fmt.Fprintf(w, "--- FAIL: %s (%.2fs)\n%v\n", c.pkgPath, c.elapsed.Seconds(), c.err)
+		} else if len(cases) > 1 {
+			fmt.Fprintf(w, "ok  \t%s\t%.2fs\n", c.pkgPath, c.elapsed.Seconds())
+		}
+	}
+	if len(cases) > 1 {
+		fmt.Fprintf(w, "cmptest: %d passed, %d failed\n", len(cases)-failed, failed)
+	}
+	if conf.CmpTestJUnit != "" {
+		if err := writeJUnitFile(conf.CmpTestJUnit, cases); err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("cmptest: %d of %d packages failed", failed, len(cases))
+	}
+	return nil
+}
+
+// cmpTest runs llApp and compares its result with llgo.expect in dir if it
+// exists, or with the result of go run otherwise, after normalizing both by
+// the rules of llgo.cmprules in dir. If genExpect is set, it writes the
+// result to llgo.expect instead.
+func cmpTest(dir, pkgPath, llApp string, genExpect bool, runArgs []string) error {
 	var llgoOut, llgoErr bytes.Buffer
 	var llgoRunErr = runApp(runArgs, dir, &llgoOut, &llgoErr, llApp)
 
@@ -35,60 +100,85 @@ func cmpTest(dir, pkgPath, llApp string, genExpect bool, runArgs []string) {
 	llgoExpectFile := filepath.Join(dir, "llgo.expect")
 	if genExpect {
 		if _, err := os.Stat(llgoExpectFile); !errors.Is(err, os.ErrNotExist) {
-			fatal(fmt.Errorf("llgo.expect file already exists: %s", llgoExpectFile))
-		}
-		if err := os.WriteFile(llgoExpectFile, llgoExpect, 0644); err != nil {
-			fatal(err)
+			return fmt.Errorf("llgo.expect file already exists: %s", llgoExpectFile)
 		}
-		return
+		return os.WriteFile(llgoExpectFile, llgoExpect, 0644)
+	}
+	rules, err := readCmpRules(filepath.Join(dir, cmpRulesFile))
+	if err != nil {
+		return err
 	}
 	if b, err := os.ReadFile(llgoExpectFile); err == nil {
-		checkEqual("llgo.expect", llgoExpect, b)
-		return
+		stdout, stderr, exit, ok := parseExpect(b)
+		if !ok {
+			return checkEqual("llgo.expect", llgoExpect, b)
+		}
+		return errors.Join(
+			checkEqual("output", rules.normalize(llgoOut.Bytes()), rules.normalize(stdout)),
+			checkEqual("stderr", rules.normalize(llgoErr.Bytes()), rules.normalize(stderr)),
+			checkEqual("exit", []byte(exitLine(llgoRunErr)), exit),
+		)
 	} else if !errors.Is(err, os.ErrNotExist) {
-		fatal(err)
+		return err
 	}
 
 	var goOut, goErr bytes.Buffer
 	var goRunErr = runApp(runArgs, dir, &goOut, &goErr, "go", "run", pkgPath)
 
-	checkEqual("output", llgoOut.Bytes(), goOut.Bytes())
-	checkEqual("stderr", llgoErr.Bytes(), goErr.Bytes())
-	checkEqualRunErr(llgoRunErr, goRunErr)
+	return errors.Join(
+		checkEqual("output", rules.normalize(llgoOut.Bytes()), rules.normalize(goOut.Bytes())),
+		checkEqual("stderr", rules.normalize(llgoErr.Bytes()), rules.normalize(goErr.Bytes())),
+		checkEqualRunErr(llgoRunErr, goRunErr),
+	)
 }
 
 func formatExpect(stdout, stderr []byte, runErr error) []byte {
-	var exitCode int
-	if runErr != nil {
-		if ee, ok := runErr.(*exec.ExitError); ok {
-			exitCode = ee.ExitCode()
-		} else { // This should never happen, but just in case.
-			exitCode = 255
-		}
-	}
-	return []byte(fmt.Sprintf("#stdout\n%s\n#stderr\n%s\n#exit %d\n", stdout, stderr, exitCode))
+	return []byte(fmt.Sprintf("#stdout\n%s\n#stderr\n%s\n%s\n", stdout, stderr, exitLine(runErr)))
 }
 
-func checkEqualRunErr(llgoRunErr, goRunErr error) {
-	if llgoRunErr == goRunErr {
-		return
+func exitLine(runErr error) string {
+	return fmt.Sprintf("#exit %d", exitCode(runErr))
+}
+
+func exitCode(runErr error) int {
+	if runErr == nil {
+		return 0
 	}
-	fmt.Fprintln(os.Stderr, "=> Exit:", llgoRunErr)
-	fmt.Fprintln(os.Stderr, "\n=> Expected Exit:", goRunErr)
+	if ee, ok := runErr.(*exec.ExitError); ok {
+		return ee.ExitCode()
+	}
+	return 255 // This should never happen, but just in case.
 }
 
-func checkEqual(prompt string, a, expected []byte) {
-	if bytes.Equal(a, expected) {
+// parseExpect splits the content of llgo.expect written by formatExpect.
+func parseExpect(b []byte) (stdout, stderr, exit []byte, ok bool) {
+	rest, ok := bytes.CutPrefix(b, []byte("#stdout\n"))
+	if !ok {
 		return
 	}
+	i := bytes.LastIndex(rest, []byte("\n#exit "))
+	if i < 0 {
+		return nil, nil, nil, false
+	}
+	rest, exit = rest[:i], bytes.TrimSpace(rest[i+1:])
+	stdout, stderr, ok = bytes.Cut(rest, []byte("\n#stderr\n"))
+	return
+}
 
-	fmt.Fprintln(os.Stderr, "=> Result of", prompt)
-	os.Stderr.Write(a)
-
-	fmt.Fprintln(os.Stderr, "\n=> Expected", prompt)
-	os.Stderr.Write(expected)
+// checkEqualRunErr checks both runs succeeded or both failed: go run exits
+// with 1 whatever the exit code of the program is.
+func checkEqualRunErr(llgoRunErr, goRunErr error) error {
+	if (llgoRunErr == nil) == (goRunErr == nil) {
+		return nil
+	}
+	return fmt.Errorf("=> Exit: %v\n=> Expected Exit: %v", llgoRunErr, goRunErr)
+}
 
-	fatal(errors.New("checkEqual: unexpected " + prompt))
+func checkEqual(prompt string, a, expected []byte) error {
+	if bytes.Equal(a, expected) {
+		return nil
+	}
+	return fmt.Errorf("=> Unexpected %s:\n%s", prompt, unifiedDiff("expected", "llgo", expected, a))
 }
 
 func runApp(runArgs []string, dir string, stdout, stderr io.Writer, app string, args ...string) error {
@@ -106,6 +196,67 @@ func runApp(runArgs []string, dir string, stdout, stderr io.Writer, app string,
 	return cmd.Run()
 }
 
-func fatal(err error) {
-	log.Panicln(err)
+// JUnit XML report of cmptest, as understood by CI systems.
+type (
+	junitTestSuite struct {
+		XMLName   xml.Name        `xml:"testsuite"`
+		Name      string          `xml:"name,attr"`
+		Tests     int             `xml:"tests,attr"`
+		Failures  int             `xml:"failures,attr"`
+		Time      string          `xml:"time,attr"`
+		TestCases []junitTestCase `xml:"testcase"`
+	}
+	junitTestCase struct {
+		Name      string        `xml:"name,attr"`
+		ClassName string        `xml:"classname,attr"`
+		Time      string        `xml:"time,attr"`
+		Failure   *junitFailure `xml:"failure,omitempty"`
+	}
+	junitFailure struct {
+		Message string `xml:"message,attr"`
+		Text    string `xml:",chardata"`
+	}
+)
+
+func writeJUnitFile(file string, cases []*cmpTestCase) (err error) {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	return writeJUnit(f, cases)
+}
+
+func writeJUnit(w io.Writer, cases []*cmpTestCase) error {
+	suite := junitTestSuite{Name: "llgo cmptest", Tests: len(cases)}
+	var total time.Duration
+	for _, c := range cases {
+		total += c.elapsed
+		tc := junitTestCase{
+			Name:      c.pkgPath,
+			ClassName: "cmptest",
+			Time:      fmt.Sprintf("%.3f", c.elapsed.Seconds()),
+		}
+		if c.err != nil {
+			suite.Failures++
+			msg, _, _ := strings.Cut(c.err.Error(), "\n")
+			tc.Failure = &junitFailure{Message: msg, Text: c.err.Error()}
+		}
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+	suite.Time = fmt.Sprintf("%.3f", total.Seconds())
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suite); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
 }
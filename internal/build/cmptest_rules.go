@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// cmpRulesFile holds the rules normalizing the results compared by
+// "llgo cmptest", next to llgo.expect. Each line is a rule:
+//
+//	# comment
+//	replace "regexp" "replacement"   replace what varies between runs, eg. addresses
+//	unordered                        compare the lines regardless of their order
+//	unordered "begin" "end"          same for the lines between lines matching begin and end
+//
+// Arguments are Go string literals, so `raw strings` spare escaping regexps.
+// Replacements apply to stdout and stderr in order, before the unordered
+// rules; the replacement may refer to submatches as in regexp.Expand.
+const cmpRulesFile = "llgo.cmprules"
+
+type cmpRules struct {
+	replaces  []cmpReplace
+	unordered []cmpSection
+}
+
+type cmpReplace struct {
+	re   *regexp.Regexp
+	repl []byte
+}
+
+// cmpSection is a section of lines to sort; a nil begin is the whole output.
+type cmpSection struct {
+	begin, end *regexp.Regexp
+}
+
+// readCmpRules reads the rules of file. A missing file holds no rules.
+func readCmpRules(file string) (*cmpRules, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &cmpRules{}, nil
+		}
+		return nil, err
+	}
+	return parseCmpRules(file, data)
+}
+
+func parseCmpRules(file string, data []byte) (*cmpRules, error) {
+	rules := &cmpRules{}
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for lineno := 1; s.Scan(); lineno++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		verb, rest, _ := strings.Cut(line, " ")
+		args, err := parseRuleArgs(rest)
+		if err == nil {
+			err = rules.add(verb, args)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", file, lineno, err)
+		}
+	}
+	return rules, s.Err()
+}
+
+func (r *cmpRules) add(verb string, args []string) error {
+	switch verb {
+	case "replace":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: replace \"regexp\" \"replacement\"")
+		}
+		re, err := regexp.Compile(args[0])
+		if err != nil {
+			return err
+		}
+		r.replaces = append(r.replaces, cmpReplace{re, []byte(args[1])})
+	case "unordered":
+		var sec cmpSection
+		switch len(args) {
+		case 0:
+		case 2:
+			var err error
+			if sec.begin, err = regexp.Compile(args[0]); err != nil {
+				return err
+			}
+			if sec.end, err = regexp.Compile(args[1]); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("usage: unordered [\"begin\" \"end\"]")
+		}
+		r.unordered = append(r.unordered, sec)
+	default:
+		return fmt.Errorf("unknown rule %q", verb)
+	}
+	return nil
+}
+
+// parseRuleArgs parses the Go string literals separated by spaces in s.
+func parseRuleArgs(s string) (args []string, err error) {
+	for s = strings.TrimSpace(s); s != ""; s = strings.TrimSpace(s) {
+		lit, err := strconv.QuotedPrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("want a string literal: %s", s)
+		}
+		arg, err := strconv.Unquote(lit)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		s = s[len(lit):]
+	}
+	return
+}
+
+// normalize applies the rules to the output b.
+func (r *cmpRules) normalize(b []byte) []byte {
+	for _, rep := range r.replaces {
+		b = rep.re.ReplaceAll(b, rep.repl)
+	}
+	if len(r.unordered) == 0 {
+		return b
+	}
+	text, nl := strings.CutSuffix(string(b), "\n")
+	lines := strings.Split(text, "\n")
+	for _, sec := range r.unordered {
+		sec.sort(lines)
+	}
+	text = strings.Join(lines, "\n")
+	if nl {
+		text += "\n"
+	}
+	return []byte(text)
+}
+
+// sort sorts the lines of the sections of sec in place, keeping the lines
+// matching begin and end where they are.
+func (sec cmpSection) sort(lines []string) {
+	if sec.begin == nil {
+		sort.Strings(lines)
+		return
+	}
+	for i := 0; i < len(lines); i++ {
+		if !sec.begin.MatchString(lines[i]) {
+			continue
+		}
+		j := i + 1
+		for j < len(lines) && !sec.end.MatchString(lines[j]) {
+			j++
+		}
+		sort.Strings(lines[i+1 : j])
+		i = j
+	}
+}
@@ -0,0 +1,129 @@
+//go:build !llgo
+
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCmpRules(t *testing.T) {
+	rules, err := parseCmpRules("llgo.cmprules", []byte(`# addresses and map order vary
+replace `+"`0x[0-9a-f]+`"+` "0xADDR"
+replace "took [0-9.]+m?s" "took T"
+unordered "^map:" "^end"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := rules.normalize([]byte("p=0xc000012345 took 1.5ms\nmap:\nb 2\na 1\nend\nz\ny\n"))
+	want := "p=0xADDR took T\nmap:\na 1\nb 2\nend\nz\ny\n"
+	if string(got) != want {
+		t.Errorf("normalize = %q, want %q", got, want)
+	}
+
+	rules, err = parseCmpRules("llgo.cmprules", []byte("unordered\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := rules.normalize([]byte("b\nc\na")); string(got) != "a\nb\nc" {
+		t.Errorf("normalize unordered = %q", got)
+	}
+
+	for _, bad := range []string{
+		"replace \"x\"\n",
+		"replace x y\n",
+		"replace \"(\" \"\"\n",
+		"unordered \"a\"\n",
+		"sort\n",
+	} {
+		if _, err := parseCmpRules("llgo.cmprules", []byte("\n"+bad)); err == nil || !strings.HasPrefix(err.Error(), "llgo.cmprules:2: ") {
+			t.Errorf("parseCmpRules(%q) = %v", bad, err)
+		}
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	a := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n"
+	b := "1\n2\nX\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\nY\n15"
+	want := `--- expected
++++ llgo
+@@ -1,6 +1,6 @@
+ 1
+ 2
+-3
++X
+ 4
+ 5
+ 6
+@@ -11,5 +11,5 @@
+ 11
+ 12
+ 13
+-14
+-15
++Y
++15
+\ No newline at end of file
+`
+	if got := unifiedDiff("expected", "llgo", []byte(a), []byte(b)); got != want {
+		t.Errorf("unifiedDiff =\n%s\nwant\n%s", got, want)
+	}
+	if got := unifiedDiff("a", "b", []byte(a), []byte(a)); got != "" {
+		t.Errorf("unifiedDiff of equal texts = %q", got)
+	}
+	if got := unifiedDiff("a", "b", nil, []byte("x\n")); got != "--- a\n+++ b\n@@ -0,0 +1 @@\n+x\n" {
+		t.Errorf("unifiedDiff from empty = %q", got)
+	}
+}
+
+func TestParseExpect(t *testing.T) {
+	b := formatExpect([]byte("out\nput"), []byte("err"), nil)
+	stdout, stderr, exit, ok := parseExpect(b)
+	if !ok || string(stdout) != "out\nput" || string(stderr) != "err" || string(exit) != "#exit 0" {
+		t.Errorf("parseExpect(%q) = %q, %q, %q, %v", b, stdout, stderr, exit, ok)
+	}
+	if _, _, _, ok := parseExpect([]byte("garbage")); ok {
+		t.Error("parseExpect accepted garbage")
+	}
+}
+
+func TestWriteJUnit(t *testing.T) {
+	cases := []*cmpTestCase{
+		{pkgPath: "example.com/ok", elapsed: time.Second},
+		{pkgPath: "example.com/bad", elapsed: time.Second / 2, err: errors.New("=> Unexpected output:\n-a\n+b")},
+	}
+	var buf bytes.Buffer
+	if err := writeJUnit(&buf, cases); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		`<testsuite name="llgo cmptest" tests="2" failures="1" time="1.500">`,
+		`<testcase name="example.com/ok" classname="cmptest" time="1.000"></testcase>`,
+		`<failure message="=&gt; Unexpected output:">`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("JUnit report lacks %s:\n%s", want, out)
+		}
+	}
+}
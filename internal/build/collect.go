@@ -89,8 +89,9 @@ func (c *context) collectEnvInputs(m *manifestBuilder) {
 		llgoStdioNobuf,
 		llgoFullRpath,
 	}
+	envVars = append(envVars, cgoEnvVars...)
 	for _, envVar := range envVars {
-		if v := os.Getenv(envVar); v != "" {
+		if v := env.Getenv(envVar); v != "" {
 			m.env.Vars = m.env.Vars.Add(envVar, v)
 		}
 	}
@@ -268,6 +269,12 @@ func detectLLVMVersion(ctx *context) string {
 	if cc == "" {
 		cc = "clang"
 	}
+	return clangVersion(cc)
+}
+
+// clangVersion returns the first line of cc --version, or "" if cc can't be
+// run.
+func clangVersion(cc string) string {
 	versionCmd := exec.Command(cc, "--version")
 	output, err := versionCmd.Output()
 	if err != nil {
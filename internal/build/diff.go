@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines around the changes of a hunk.
+const diffContext = 3
+
+// maxDiffCells bounds the size of the table computing the longest common
+// subsequence of lines; larger changes are shown as a single replacement.
+const maxDiffCells = 1 << 22
+
+// unifiedDiff returns the differences between a and b as a unified diff, or
+// "" if they are equal.
+func unifiedDiff(aName, bName string, a, b []byte) string {
+	if string(a) == string(b) {
+		return ""
+	}
+	x, y := diffLines(a), diffLines(b)
+	ops := diffOps(x, y)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", aName, bName)
+	for i := 0; i < len(ops); {
+		// find the next change and the end of its hunk
+		for i < len(ops) && ops[i].kind == ' ' {
+			i++
+		}
+		if i == len(ops) {
+			break
+		}
+		start := max(i-diffContext, 0)
+		end := i
+		for j := i; j < len(ops); j++ {
+			if ops[j].kind != ' ' {
+				end = j + 1
+			} else if j-end >= 2*diffContext {
+				break
+			}
+		}
+		end = min(end+diffContext, len(ops))
+		writeHunk(&sb, ops[start:end])
+		i = end
+	}
+	return sb.String()
+}
+
+// diffLines splits b into lines, marking a missing final newline as diff does.
+func diffLines(b []byte) []string {
+	if len(b) == 0 {
+		return nil
+	}
+	s, nl := strings.CutSuffix(string(b), "\n")
+	lines := strings.Split(s, "\n")
+	if !nl {
+		lines[len(lines)-1] += "\n\\ No newline at end of file"
+	}
+	return lines
+}
+
+type diffOp struct {
+	kind         byte // ' ', '-' or '+'
+	line         string
+	aLine, bLine int // 1-based line numbers before the op
+}
+
+// diffOps returns the edit script turning x into y.
+func diffOps(x, y []string) []diffOp {
+	// strip the common prefix and suffix
+	pre := 0
+	for pre < len(x) && pre < len(y) && x[pre] == y[pre] {
+		pre++
+	}
+	suf := 0
+	for suf < len(x)-pre && suf < len(y)-pre && x[len(x)-1-suf] == y[len(y)-1-suf] {
+		suf++
+	}
+	mx, my := x[pre:len(x)-suf], y[pre:len(y)-suf]
+
+	ops := make([]diffOp, 0, len(x)+len(y))
+	ai, bi := 1, 1
+	emit := func(kind byte, line string) {
+		ops = append(ops, diffOp{kind, line, ai, bi})
+		if kind != '+' {
+			ai++
+		}
+		if kind != '-' {
+			bi++
+		}
+	}
+	for _, line := range x[:pre] {
+		emit(' ', line)
+	}
+	if len(mx)*len(my) > maxDiffCells {
+		for _, line := range mx {
+			emit('-', line)
+		}
+		for _, line := range my {
+			emit('+', line)
+		}
+	} else {
+		// lcs[i][j] is the length of the longest common subsequence of
+		// mx[i:] and my[j:]
+		lcs := make([][]int32, len(mx)+1)
+		for i := range lcs {
+			lcs[i] = make([]int32, len(my)+1)
+		}
+		for i := len(mx) - 1; i >= 0; i-- {
+			for j := len(my) - 1; j >= 0; j-- {
+				if mx[i] == my[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else {
+					lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+				}
+			}
+		}
+		i, j := 0, 0
+		for i < len(mx) || j < len(my) {
+			switch {
+			case i < len(mx) && j < len(my) && mx[i] == my[j]:
+				emit(' ', mx[i])
+				i++
+				j++
+			case j == len(my) || (i < len(mx) && lcs[i+1][j] >= lcs[i][j+1]):
+				emit('-', mx[i])
+				i++
+			default:
+				emit('+', my[j])
+				j++
+			}
+		}
+	}
+	for _, line := range x[len(x)-suf:] {
+		emit(' ', line)
+	}
+	return ops
+}
+
+func writeHunk(sb *strings.Builder, ops []diffOp) {
+	var na, nb int
+	for _, op := range ops {
+		if op.kind != '+' {
+			na++
+		}
+		if op.kind != '-' {
+			nb++
+		}
+	}
+	fmt.Fprintf(sb, "@@ -%s +%s @@\n", hunkRange(ops[0].aLine, na), hunkRange(ops[0].bLine, nb))
+	for _, op := range ops {
+		sb.WriteByte(op.kind)
+		sb.WriteString(op.line)
+		sb.WriteByte('\n')
+	}
+}
+
+// hunkRange formats the range of a hunk like diff -u.
+func hunkRange(start, n int) string {
+	switch n {
+	case 0:
+		return fmt.Sprintf("%d,0", start-1)
+	case 1:
+		return fmt.Sprint(start)
+	}
+	return fmt.Sprintf("%d,%d", start, n)
+}
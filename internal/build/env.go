@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"slices"
+
+	"github.com/goplus/llgo/internal/crosscompile"
+	"github.com/goplus/llgo/internal/env"
+)
+
+// EnvVar is a variable of the environment llgo builds in, as listed by
+// "llgo env".
+type EnvVar struct {
+	Name  string
+	Value string // the effective value, with defaults applied
+}
+
+// envSettings are the variables "llgo env -w" can set in the config file.
+var envSettings = append([]string{
+	"LLGO_ROOT", llgoDebug, llgoDbgSyms, llgoTrace, llgoOptimize, llgoBuildCache,
+	llgoStdioNobuf, llgoFullRpath, llgoWasmRuntime, llgoWasiThreads,
+	llgoPlan9ASMPkgs, llgoAr, "LLVM_CONFIG",
+}, cgoEnvVars...)
+
+// IsEnvSetting reports whether the variable name can be set by "llgo env -w".
+func IsEnvSetting(name string) bool {
+	return slices.Contains(envSettings, name)
+}
+
+// Env returns the environment conf builds in: the variables llgo takes from
+// the environment or the config file, and the ones it detects.
+func Env(conf *Config) []EnvVar {
+	vars := []EnvVar{
+		{Name: "GOOS", Value: conf.Goos},
+		{Name: "GOARCH", Value: conf.Goarch},
+		{Name: "LLGO_VERSION", Value: env.Version()},
+		{Name: "LLGO_ROOT", Value: env.LLGoROOT()},
+		{Name: env.LLGoEnvVar, Value: env.ConfigFile()},
+		{Name: "LLGO_CACHE", Value: cacheRootFunc()},
+		{Name: "LLGO_COMPILER_HASH", Value: conf.CompilerHash},
+		{Name: "LLGO_CLANG_VERSION", Value: clangVersion("clang")},
+		{Name: "LLGO_LLVM_VERSION", Value: llvmVersion(conf)},
+		{Name: llgoDebug, Value: envBool(isEnvOn(llgoDebug, false))},
+		{Name: llgoDbgSyms, Value: envBool(IsDbgSymsEnabled())},
+		{Name: llgoTrace, Value: envBool(IsTraceEnabled())},
+		{Name: llgoOptimize, Value: envBool(IsOptimizeEnabled())},
+		{Name: llgoBuildCache, Value: envBool(cacheEnabled())},
+		{Name: llgoStdioNobuf, Value: envBool(IsStdioNobuf())},
+		{Name: llgoFullRpath, Value: envBool(IsFullRpathEnabled())},
+		{Name: llgoWasmRuntime, Value: WasmRuntime()},
+		{Name: llgoWasiThreads, Value: envBool(IsWasiThreadsEnabled())},
+		{Name: llgoPlan9ASMPkgs, Value: Plan9ASMPkgs()},
+		{Name: llgoAr, Value: env.Getenv(llgoAr)},
+		{Name: "LLVM_CONFIG", Value: env.Getenv("LLVM_CONFIG")},
+	}
+	for _, name := range cgoEnvVars {
+		vars = append(vars, EnvVar{Name: name, Value: env.Getenv(name)})
+	}
+	return vars
+}
+
+// llvmVersion returns the LLVM version conf builds with, detected from the
+// compiler of its target like the build does, or "" if it can't be.
+func llvmVersion(conf *Config) string {
+	forceEspClang := conf.ForceEspClang || conf.Target != ""
+	export, err := crosscompile.Use(conf.Goos, conf.Goarch, conf.Target, IsWasiThreadsEnabled(), forceEspClang)
+	if err != nil {
+		return ""
+	}
+	return detectLLVMVersion(&context{crossCompile: export})
+}
+
+func envBool(on bool) string {
+	if on {
+		return "1"
+	}
+	return "0"
+}
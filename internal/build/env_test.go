@@ -0,0 +1,48 @@
+//go:build !llgo
+
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"testing"
+
+	"github.com/goplus/llgo/internal/crosscompile"
+)
+
+func TestEnvLLVMVersion(t *testing.T) {
+	conf := NewDefaultConf(ModeBuild)
+	var got string
+	found := false
+	for _, v := range Env(conf) {
+		if v.Name == "LLGO_LLVM_VERSION" {
+			got, found = v.Value, true
+		}
+	}
+	if !found {
+		t.Fatal("Env lacks LLGO_LLVM_VERSION")
+	}
+
+	export, err := crosscompile.Use(conf.Goos, conf.Goarch, "", IsWasiThreadsEnabled(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := &context{crossCompile: export}
+	if want := ctx.getLLVMVersion(); got != want {
+		t.Errorf("LLGO_LLVM_VERSION = %q, want %q as detected by the build", got, want)
+	}
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/goplus/llgo/internal/packages"
+)
+
+// Actions of a BuildEvent. build-output and build-fail are the actions of
+// the BuildEvent of go build -json; the others are specific to llgo.
+const (
+	ActionBuildStart  = "build-start"  // a package starts building
+	ActionCacheHit    = "cache-hit"    // a package is loaded from the build cache
+	ActionCacheMiss   = "cache-miss"   // a package is not in the build cache
+	ActionCompile     = "compile"      // a C (or asm, Fortran) file of a package is compiled
+	ActionLink        = "link"         // a program is linked
+	ActionBuildOutput = "build-output" // compiler or linker output
+	ActionBuildFinish = "build-finish" // a package is built, and linked if it is a program
+	ActionBuildFail   = "build-fail"   // a package failed to build or link
+)
+
+// BuildEvent is an event of the build written as a line of JSON to
+// Config.Events. ImportPath, Action and Output mean what they mean in the
+// BuildEvent of go build -json, so tools reading that stream can read
+// this one.
+type BuildEvent struct {
+	Time        time.Time
+	ImportPath  string       `json:",omitempty"`
+	Action      string       // one of the Action constants
+	Output      string       `json:",omitempty"`
+	Fingerprint string       `json:",omitempty"` // cache key of the package, for cache-hit and cache-miss
+	File        string       `json:",omitempty"` // source file, for compile
+	Command     []string     `json:",omitempty"` // command run, for compile and link
+	Elapsed     float64      `json:",omitempty"` // seconds, for compile, link, build-finish and build-fail
+	Errors      []BuildError `json:",omitempty"`
+}
+
+// BuildError is an error of a package, with its position if known.
+type BuildError struct {
+	Pos string `json:",omitempty"` // "file:line:col" or ""
+	Err string
+}
+
+// event writes ev to Config.Events, if set. It's safe to call concurrently.
+func (c *context) event(ev BuildEvent) {
+	w := c.buildConf.Events
+	if w == nil {
+		return
+	}
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+	c.eventMu.Lock()
+	defer c.eventMu.Unlock()
+	json.NewEncoder(w).Encode(&ev)
+}
+
+// timedEvent writes ev, finished now and started at start. If err is not nil
+// the error goes to the Output of ev.
+func (c *context) timedEvent(ev BuildEvent, start time.Time, err error) {
+	if c.buildConf.Events == nil {
+		return
+	}
+	ev.Elapsed = time.Since(start).Seconds()
+	if err != nil && ev.Output == "" {
+		ev.Output = err.Error() + "\n"
+	}
+	c.event(ev)
+}
+
+// pkgDone writes the build-finish or build-fail event of pkgPath.
+func (c *context) pkgDone(pkgPath string, start time.Time, err error) {
+	action := ActionBuildFinish
+	if err != nil {
+		action = ActionBuildFail
+	}
+	c.timedEvent(BuildEvent{ImportPath: pkgPath, Action: action}, start, err)
+}
+
+// linkLater reports whether pkg, built since start, is a program whose link
+// ends its build, to write its build-finish or build-fail event then.
+func (c *context) linkLater(pkg *packages.Package, start time.Time) bool {
+	if c.buildConf.Events == nil || c.buildConf.Mode == ModeGen ||
+		!needLink(pkg, c.buildConf.Mode) || !pkgExists(c.initial, pkg) {
+		return false
+	}
+	if c.linkStarts == nil {
+		c.linkStarts = make(map[string]time.Time)
+	}
+	c.linkStarts[pkg.PkgPath] = start
+	return true
+}
+
+// linkDone writes the build-finish or build-fail event of the program
+// pkgPath, whose link ended with err.
+func (c *context) linkDone(pkgPath string, err error) {
+	if start, ok := c.linkStarts[pkgPath]; ok {
+		delete(c.linkStarts, pkgPath)
+		c.pkgDone(pkgPath, start, err)
+	}
+}
+
+// unlinkedDone writes the build-fail event of the programs left unlinked by
+// the error err of the build.
+func (c *context) unlinkedDone(err error) {
+	if len(c.linkStarts) == 0 {
+		return
+	}
+	notLinked := errors.New("not linked")
+	if err != nil {
+		notLinked = fmt.Errorf("not linked: %w", err)
+	}
+	for _, pkg := range c.initial {
+		if start, ok := c.linkStarts[pkg.PkgPath]; ok {
+			delete(c.linkStarts, pkg.PkgPath)
+			c.pkgDone(pkg.PkgPath, start, notLinked)
+		}
+	}
+}
+
+// pkgErrors writes the errors of a package that fails to load or type check,
+// as a build-output event of the error text and a build-fail event with the
+// error positions.
+func (c *context) pkgErrors(pkg *packages.Package) {
+	if c.buildConf.Events == nil {
+		return
+	}
+	var out []byte
+	errs := make([]BuildError, 0, len(pkg.Errors))
+	for _, e := range pkg.Errors {
+		out = append(out, e.Error()...)
+		out = append(out, '\n')
+		errs = append(errs, BuildError{Pos: e.Pos, Err: e.Msg})
+	}
+	if len(out) > 0 {
+		c.event(BuildEvent{ImportPath: pkg.PkgPath, Action: ActionBuildOutput, Output: string(out)})
+	}
+	c.event(BuildEvent{ImportPath: pkg.PkgPath, Action: ActionBuildFail, Errors: errs})
+}
+
+// captureOutput redirects the stdout and stderr of a tool run for pkgPath to
+// a buffer when events are written, as the output would mix with them. The
+// returned function writes the output as a build-output event, once the tool
+// ran.
+func (c *context) captureOutput(pkgPath string, stdout, stderr *io.Writer) func() {
+	if c.buildConf.Events == nil {
+		return func() {}
+	}
+	out := new(outputBuffer)
+	*stdout, *stderr = out, out
+	return func() {
+		out.mu.Lock()
+		defer out.mu.Unlock()
+		if out.buf.Len() > 0 {
+			c.event(BuildEvent{ImportPath: pkgPath, Action: ActionBuildOutput, Output: out.buf.String()})
+			out.buf.Reset()
+		}
+	}
+}
+
+// outputBuffer is a buffer tools may write to concurrently.
+type outputBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *outputBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
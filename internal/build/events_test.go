@@ -0,0 +1,154 @@
+//go:build !llgo
+
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	gopackages "golang.org/x/tools/go/packages"
+
+	"github.com/goplus/llgo/internal/packages"
+)
+
+func readEvents(t *testing.T, data []byte) []BuildEvent {
+	t.Helper()
+	var evs []BuildEvent
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var ev BuildEvent
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			t.Fatalf("bad event %q: %v", scanner.Text(), err)
+		}
+		evs = append(evs, ev)
+	}
+	return evs
+}
+
+func TestEvents(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := &context{buildConf: &Config{Events: &buf}}
+
+	start := time.Now().Add(-time.Second)
+	ctx.event(BuildEvent{ImportPath: "example.com/a", Action: ActionBuildStart})
+	ctx.reportCache(&aPackage{Package: &packages.Package{PkgPath: "example.com/a"}, Fingerprint: "abc"}, false)
+	ctx.pkgDone("example.com/a", start, nil)
+	ctx.pkgDone("example.com/b", start, errors.New("boom"))
+	ctx.pkgErrors(&packages.Package{PkgPath: "example.com/c", Errors: []gopackages.Error{
+		{Pos: "c.go:3:5", Msg: "undefined: x"},
+		{Msg: "no Go files"},
+	}})
+
+	evs := readEvents(t, buf.Bytes())
+	var actions []string
+	for _, ev := range evs {
+		actions = append(actions, ev.ImportPath+" "+ev.Action)
+		if ev.Time.IsZero() {
+			t.Errorf("%s %s: no Time", ev.ImportPath, ev.Action)
+		}
+	}
+	expected := []string{
+		"example.com/a build-start",
+		"example.com/a cache-miss",
+		"example.com/a build-finish",
+		"example.com/b build-fail",
+		"example.com/c build-output",
+		"example.com/c build-fail",
+	}
+	if !reflect.DeepEqual(actions, expected) {
+		t.Fatalf("actions = %v, want %v", actions, expected)
+	}
+	if evs[1].Fingerprint != "abc" {
+		t.Errorf("cache-miss Fingerprint = %q, want abc", evs[1].Fingerprint)
+	}
+	if evs[2].Elapsed < 1 || evs[2].Output != "" {
+		t.Errorf("build-finish = %+v, want Elapsed >= 1 and no Output", evs[2])
+	}
+	if evs[3].Output != "boom\n" {
+		t.Errorf("build-fail Output = %q, want boom", evs[3].Output)
+	}
+	if want := "c.go:3:5: undefined: x\n-: no Go files\n"; evs[4].Output != want {
+		t.Errorf("build-output Output = %q, want %q", evs[4].Output, want)
+	}
+	wantErrs := []BuildError{{Pos: "c.go:3:5", Err: "undefined: x"}, {Err: "no Go files"}}
+	if !reflect.DeepEqual(evs[5].Errors, wantErrs) {
+		t.Errorf("build-fail Errors = %+v, want %+v", evs[5].Errors, wantErrs)
+	}
+}
+
+func TestEventsDisabled(t *testing.T) {
+	ctx := &context{buildConf: &Config{}}
+	ctx.event(BuildEvent{Action: ActionBuildStart})
+	ctx.pkgDone("example.com/a", time.Now(), errors.New("boom"))
+	ctx.pkgErrors(&packages.Package{PkgPath: "example.com/a"})
+}
+
+func TestLinkEvents(t *testing.T) {
+	var buf bytes.Buffer
+	prog := &packages.Package{PkgPath: "example.com/cmd/a", Name: "main"}
+	lib := &packages.Package{PkgPath: "example.com/b", Name: "b"}
+	unlinked := &packages.Package{PkgPath: "example.com/cmd/c", Name: "main"}
+	ctx := &context{
+		buildConf: &Config{Events: &buf, Mode: ModeBuild},
+		initial:   []*packages.Package{prog, lib, unlinked},
+	}
+
+	start := time.Now()
+	if ctx.linkLater(lib, start) {
+		t.Error("linkLater of a library = true")
+	}
+	if !ctx.linkLater(prog, start) || !ctx.linkLater(unlinked, start) {
+		t.Fatal("linkLater of a program = false")
+	}
+	flush := ctx.captureOutput(prog.PkgPath, new(io.Writer), new(io.Writer))
+	flush() // no output, no event
+	stdout, stderr := io.Writer(nil), io.Writer(nil)
+	flush = ctx.captureOutput(prog.PkgPath, &stdout, &stderr)
+	io.WriteString(stderr, "ld: undefined symbol: foo\n")
+	flush()
+	ctx.linkDone(prog.PkgPath, errors.New("link failed"))
+	ctx.linkDone(prog.PkgPath, nil) // already done
+	ctx.unlinkedDone(errors.New("link failed"))
+
+	evs := readEvents(t, buf.Bytes())
+	var actions []string
+	for _, ev := range evs {
+		actions = append(actions, ev.ImportPath+" "+ev.Action)
+	}
+	expected := []string{
+		"example.com/cmd/a build-output",
+		"example.com/cmd/a build-fail",
+		"example.com/cmd/c build-fail",
+	}
+	if !reflect.DeepEqual(actions, expected) {
+		t.Fatalf("actions = %v, want %v", actions, expected)
+	}
+	if evs[0].Output != "ld: undefined symbol: foo\n" {
+		t.Errorf("build-output Output = %q", evs[0].Output)
+	}
+	if evs[2].Output != "not linked: link failed\n" {
+		t.Errorf("build-fail Output = %q, want not linked", evs[2].Output)
+	}
+}
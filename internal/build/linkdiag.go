@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/goplus/llgo/xtool/nm/nmindex"
+)
+
+// undefinedSymbol is a symbol a failed link reports undefined.
+type undefinedSymbol struct {
+	name  string   // as reported by the linker
+	refBy []string // the symbols referencing it, when the linker tells
+}
+
+var (
+	// ld.lld, wasm-ld: "ld.lld: error: undefined symbol: foo"
+	// followed by ">>> referenced by file.c\n>>>  obj.o:(main.main)"
+	lldUndefined = regexp.MustCompile(`error: (?:.*: )?undefined symbol: (.+)$`)
+	lldRefBy     = regexp.MustCompile(`^>>>\s+.*:\((.+)\)$`)
+	// ld64: `Undefined symbols for architecture arm64:` followed by
+	// `  "_foo", referenced from:` and `      _main.main in pkg.o`
+	ld64Undefined = regexp.MustCompile(`^\s+"(.+)", referenced from:$`)
+	ld64RefBy     = regexp.MustCompile(`^\s+(\S+) in `)
+	// GNU ld: "obj.o: in function `main.main': ...: undefined reference to `foo'"
+	gnuFunction  = regexp.MustCompile("in function `(.+)':")
+	gnuUndefined = regexp.MustCompile("undefined reference to `(.+)'")
+)
+
+// parseUndefinedSymbols returns the undefined symbols reported by the output
+// of a failed link, in the order they are reported.
+func parseUndefinedSymbols(output []byte) []*undefinedSymbol {
+	var syms []*undefinedSymbol
+	index := make(map[string]*undefinedSymbol)
+	add := func(name string) *undefinedSymbol {
+		if sym, ok := index[name]; ok {
+			return sym
+		}
+		sym := &undefinedSymbol{name: name}
+		index[name] = sym
+		syms = append(syms, sym)
+		return sym
+	}
+	addRef := func(sym *undefinedSymbol, ref string) {
+		for _, r := range sym.refBy {
+			if r == ref {
+				return
+			}
+		}
+		sym.refBy = append(sym.refBy, ref)
+	}
+
+	var cur *undefinedSymbol
+	var gnuFunc string
+	s := bufio.NewScanner(bytes.NewReader(output))
+	s.Buffer(nil, 1<<20)
+	for s.Scan() {
+		line := s.Text()
+		if m := lldUndefined.FindStringSubmatch(line); m != nil {
+			cur = add(m[1])
+		} else if m := ld64Undefined.FindStringSubmatch(line); m != nil {
+			cur = add(m[1])
+		} else if m := gnuUndefined.FindStringSubmatch(line); m != nil {
+			cur = nil
+			sym := add(m[1])
+			if gnuFunc != "" {
+				addRef(sym, gnuFunc)
+			}
+		} else if m := gnuFunction.FindStringSubmatch(line); m != nil {
+			gnuFunc = m[1]
+		} else if cur != nil {
+			if m := lldRefBy.FindStringSubmatch(line); m != nil {
+				addRef(cur, m[1])
+			} else if m := ld64RefBy.FindStringSubmatch(line); m != nil {
+				addRef(cur, m[1])
+			} else if !strings.HasPrefix(line, ">>>") {
+				cur = nil
+			}
+		}
+	}
+	return syms
+}
+
+// demangle returns the name of a symbol as written in the source: it drops
+// the underscore Mach-O prefixes to C names, and the quotes lld puts around
+// names with special characters.
+func demangle(sym, goos string) string {
+	sym = strings.Trim(sym, `"`)
+	if goos == "darwin" || goos == "ios" {
+		sym = strings.TrimPrefix(sym, "_")
+	}
+	return sym
+}
+
+// goSymbolPkg returns the package of the Go symbol name, one of pkgPaths, or
+// "" if name is not a Go symbol of these packages.
+func goSymbolPkg(name string, pkgPaths []string) string {
+	ret := ""
+	for _, pkgPath := range pkgPaths {
+		if len(pkgPath) > len(ret) && strings.HasPrefix(name, pkgPath+".") {
+			ret = pkgPath
+		}
+	}
+	return ret
+}
+
+// diagnoseLink explains the undefined symbols in the output of a failed
+// link: the Go symbols by the package lacking them, the C symbols by the
+// packages referencing them and the libraries of the nm index providing
+// them, see chore/nmindex. It returns "" if there are no undefined symbols.
+func (c *context) diagnoseLink(output []byte) string {
+	syms := parseUndefinedSymbols(output)
+	if len(syms) == 0 {
+		return ""
+	}
+	pkgPaths := make([]string, 0, len(c.pkgs))
+	for pkg := range c.pkgs {
+		pkgPaths = append(pkgPaths, pkg.PkgPath)
+	}
+	idxDir, _ := nmindex.DefaultDir()
+	return formatLinkDiagnostics(syms, c.buildConf.Goos, pkgPaths, idxDir)
+}
+
+func formatLinkDiagnostics(syms []*undefinedSymbol, goos string, pkgPaths []string, idxDir string) string {
+	var b strings.Builder
+	noIndex := false
+	fmt.Fprintf(&b, "%d undefined symbol(s):\n", len(syms))
+	for _, sym := range syms {
+		name := demangle(sym.name, goos)
+		if pkg := goSymbolPkg(name, pkgPaths); pkg != "" {
+			fmt.Fprintf(&b, "  %s: Go symbol of package %s, which llgo builds without it (missing function body, go:linkname or alt package?)\n", name, pkg)
+			continue
+		}
+		fmt.Fprintf(&b, "  %s", name)
+		if refPkgs := referencingPkgs(sym, goos, pkgPaths); len(refPkgs) > 0 {
+			fmt.Fprintf(&b, ": referenced by package %s", strings.Join(refPkgs, ", "))
+		}
+		b.WriteByte('\n')
+		if idxDir == "" {
+			continue
+		}
+		files, err := nmindex.Query(idxDir, sym.name)
+		if err != nil {
+			noIndex = true
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, f := range files {
+			lib := nmindex.LibName(f.ArFile)
+			if lib == "" || seen[lib] {
+				continue
+			}
+			seen[lib] = true
+			fmt.Fprintf(&b, "    provided by %s; add `const LLGoPackage = \"link: -l%s\"` to the package declaring it\n", f.ArFile, lib)
+		}
+	}
+	if noIndex {
+		b.WriteString("build an nm index with `go run ./chore/nmindex mk` for the libraries providing C symbols\n")
+	}
+	return b.String()
+}
+
+// referencingPkgs returns the packages of the Go symbols referencing sym.
+func referencingPkgs(sym *undefinedSymbol, goos string, pkgPaths []string) []string {
+	var pkgs []string
+	for _, ref := range sym.refBy {
+		if pkg := goSymbolPkg(demangle(ref, goos), pkgPaths); pkg != "" && !slices.Contains(pkgs, pkg) {
+			pkgs = append(pkgs, pkg)
+		}
+	}
+	sort.Strings(pkgs)
+	return pkgs
+}
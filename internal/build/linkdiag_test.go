@@ -0,0 +1,117 @@
+//go:build !llgo
+
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseUndefinedSymbols(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   map[string][]string
+	}{
+		{"lld", `ld.lld: error: undefined symbol: zlibVersion
+>>> referenced by zlib.go
+>>>               /tmp/pkg-123.a(zlib.o):(github.com/goplus/lib/c/zlib.Version)
+>>> referenced by main.go
+>>>               /tmp/pkg-456.a(main.o):(main.main)
+
+ld.lld: error: undefined symbol: example.com/foo.missing
+>>> referenced by /tmp/pkg-789.a(foo.o):(example.com/foo.Bar)
+clang: error: linker command failed with exit code 1 (use -v to see invocation)
+`, map[string][]string{
+			"zlibVersion":             {"github.com/goplus/lib/c/zlib.Version", "main.main"},
+			"example.com/foo.missing": {"example.com/foo.Bar"},
+		}},
+		{"ld64", `Undefined symbols for architecture arm64:
+  "_zlibVersion", referenced from:
+      _github.com/goplus/lib/c/zlib.Version in pkg-123.a[2](zlib.o)
+ld: symbol(s) not found for architecture arm64
+`, map[string][]string{
+			"_zlibVersion": {"_github.com/goplus/lib/c/zlib.Version"},
+		}},
+		{"gnu", "/usr/bin/ld: /tmp/pkg-123.a(zlib.o): in function `github.com/goplus/lib/c/zlib.Version':\n" +
+			"zlib.go:(.text+0x5): undefined reference to `zlibVersion'\n",
+			map[string][]string{
+				"zlibVersion": {"github.com/goplus/lib/c/zlib.Version"},
+			}},
+		{"wasm-ld", "wasm-ld: error: /tmp/pkg-123.a(zlib.o): undefined symbol: zlibVersion\n",
+			map[string][]string{"zlibVersion": nil}},
+		{"none", "ld.lld: error: duplicate symbol: foo\n", map[string][]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make(map[string][]string)
+			for _, sym := range parseUndefinedSymbols([]byte(tt.output)) {
+				got[sym.name] = sym.refBy
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseUndefinedSymbols = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDemangle(t *testing.T) {
+	if got := demangle("_zlibVersion", "darwin"); got != "zlibVersion" {
+		t.Errorf("demangle on darwin = %q", got)
+	}
+	if got := demangle("_zlibVersion", "linux"); got != "_zlibVersion" {
+		t.Errorf("demangle on linux = %q", got)
+	}
+	if got := demangle(`"main.(*T).M"`, "linux"); got != "main.(*T).M" {
+		t.Errorf("demangle quoted = %q", got)
+	}
+}
+
+func TestFormatLinkDiagnostics(t *testing.T) {
+	idxDir := t.TempDir()
+	idx := "nm /usr/lib/libz.so.1\nfile \nT zlibVersion\n"
+	if err := os.WriteFile(filepath.Join(idxDir, "z.so.1-x.pub"), []byte(idx), 0644); err != nil {
+		t.Fatal(err)
+	}
+	syms := []*undefinedSymbol{
+		{name: "zlibVersion", refBy: []string{"github.com/goplus/lib/c/zlib.Version"}},
+		{name: "example.com/foo.missing", refBy: []string{"example.com/foo.Bar"}},
+	}
+	pkgPaths := []string{"github.com/goplus/lib/c", "github.com/goplus/lib/c/zlib", "example.com/foo"}
+
+	got := formatLinkDiagnostics(syms, "linux", pkgPaths, idxDir)
+	for _, want := range []string{
+		"2 undefined symbol(s):\n",
+		"  zlibVersion: referenced by package github.com/goplus/lib/c/zlib\n",
+		"    provided by /usr/lib/libz.so.1; add `const LLGoPackage = \"link: -lz\"`",
+		"  example.com/foo.missing: Go symbol of package example.com/foo,",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("diagnostics lack %q:\n%s", want, got)
+		}
+	}
+
+	got = formatLinkDiagnostics(syms[:1], "linux", pkgPaths, filepath.Join(idxDir, "missing"))
+	if !strings.Contains(got, "chore/nmindex mk") {
+		t.Errorf("diagnostics without an index lack the hint:\n%s", got)
+	}
+}
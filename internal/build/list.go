@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"sort"
+
+	"github.com/goplus/llgo/cl"
+	llruntime "github.com/goplus/llgo/runtime"
+)
+
+// PkgInfo tells how llgo builds a package, as listed by "llgo list".
+type PkgInfo struct {
+	Kind        string   // kind of the package by its LLGoPackage constant, see pkgKindNames
+	LLGoPackage string   `json:",omitempty"` // parameter of LLGoPackage, eg. the link spec
+	AltPkg      string   `json:",omitempty"` // path of the alt package patching the package
+	AltAdditive bool     `json:",omitempty"` // AltPkg adds to the package instead of replacing it
+	LinkArgs    []string `json:",omitempty"` // link args the package needs
+	Plan9Asm    string   `json:",omitempty"` // how .s files are handled: translated, alt, notext or unsupported
+	Fingerprint string   `json:",omitempty"` // digest of the build inputs, see the build cache
+	CacheHit    bool     // the package is in the build cache
+}
+
+// ListPackage is a package listed by "llgo list": the go list fields llgo
+// loads, and how llgo builds the package.
+type ListPackage struct {
+	ImportPath string
+	Name       string
+	Dir        string   `json:",omitempty"`
+	Module     string   `json:",omitempty"` // module path
+	GoFiles    []string `json:",omitempty"`
+	Imports    []string `json:",omitempty"`
+	DepOnly    bool     `json:",omitempty"` // a dependency, not matched by the patterns
+	LLGo       *PkgInfo
+}
+
+var pkgKindNames = map[int]string{
+	cl.PkgNormal:     "Normal",
+	cl.PkgLLGo:       "LLGo",
+	cl.PkgPyModule:   "PyModule",
+	cl.PkgNoInit:     "NoInit",
+	cl.PkgDeclOnly:   "DeclOnly",
+	cl.PkgLinkIR:     "LinkIR",
+	cl.PkgLinkExtern: "LinkExtern",
+}
+
+// List loads the packages matched by args like Do, and reports how they
+// would be built without building them. If deps is set, it lists their
+// dependencies too, before the packages depending on them.
+func List(args []string, conf *Config, deps bool) ([]*ListPackage, error) {
+	conf.Mode = ModeList
+	pkgs, err := Do(args, conf)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*ListPackage, 0, len(pkgs))
+	for _, p := range pkgs {
+		lp := &ListPackage{
+			ImportPath: p.PkgPath,
+			Name:       p.Name,
+			Dir:        p.Dir,
+			GoFiles:    p.GoFiles,
+			DepOnly:    p.depOnly,
+			LLGo:       p.Info,
+		}
+		if !deps && lp.DepOnly {
+			continue
+		}
+		if p.Module != nil {
+			lp.Module = p.Module.Path
+		}
+		for path := range p.Imports {
+			lp.Imports = append(lp.Imports, path)
+		}
+		sort.Strings(lp.Imports)
+		ret = append(ret, lp)
+	}
+	return ret, nil
+}
+
+// listPkgs fills in the Info of pkgs.
+func listPkgs(ctx *context, pkgs []*aPackage) error {
+	for _, aPkg := range pkgs {
+		pkg := aPkg.Package
+		aPkg.depOnly = !pkgExists(ctx.initial, pkg)
+		kind, param := cl.PkgKindOf(pkg.Types)
+		info := &PkgInfo{Kind: pkgKindNames[kind], LLGoPackage: param}
+		if ctx.hasAltPkg(pkg.PkgPath) {
+			info.AltPkg = altPkgPathPrefix + pkg.PkgPath
+			info.AltAdditive = llruntime.HasAdditiveAltPkg(pkg.PkgPath)
+		}
+		status, _, err := plan9asmStatusOf(ctx, pkg)
+		if err != nil {
+			return err
+		}
+		info.Plan9Asm = status
+		// packages buildAllPkgs builds are fingerprinted and cached
+		if kind != cl.PkgDeclOnly && (len(pkg.GoFiles) > 0 || !isLinkKind(kind)) {
+			if err := ctx.collectFingerprint(aPkg); err != nil {
+				return err
+			}
+			info.Fingerprint = aPkg.Fingerprint
+			info.CacheHit = ctx.tryLoadFromCache(aPkg)
+		}
+		if info.CacheHit {
+			info.LinkArgs = aPkg.LinkArgs
+		} else if kind == cl.PkgLinkExtern {
+			_, info.LinkArgs, _ = expandLinkSpec(param)
+		}
+		aPkg.Info = info
+	}
+	return nil
+}
+
+func isLinkKind(kind int) bool {
+	return kind == cl.PkgLinkIR || kind == cl.PkgLinkExtern || kind == cl.PkgPyModule
+}
@@ -86,11 +86,15 @@ func genMainModule(ctx *context, rtPkgPath string, pkg *packages.Package, needRu
 	if needAbiInit {
 		abiInit = mainPkg.InitAbiTypesFor("init$abitypes", abiSymbols)
 	}
+	var stubInit llssa.Function
+	if needRuntime {
+		stubInit = mainPkg.InitFuncStubs("init$funcstubs", abiSymbols)
+	}
 
 	mainInit := declareNoArgFunc(mainPkg, pkg.PkgPath+".init")
 	mainMain := declareNoArgFunc(mainPkg, pkg.PkgPath+".main")
 
-	entryFn := defineEntryFunction(ctx, mainPkg, argcVar, argvVar, argvValueType, runtimeStub, mainInit, mainMain, pyInit, rtInit, abiInit)
+	entryFn := defineEntryFunction(ctx, mainPkg, argcVar, argvVar, argvValueType, runtimeStub, mainInit, mainMain, pyInit, rtInit, abiInit, stubInit)
 
 	if needStart(ctx) {
 		defineStart(mainPkg, entryFn, argvValueType)
@@ -104,9 +108,9 @@ func genMainModule(ctx *context, rtPkgPath string, pkg *packages.Package, needRu
 // for WASM targets that don't require _start.
 //
 // The entry stores argc/argv, optionally disables stdio buffering, runs
-// initialization hooks (Python, runtime, package init), and finally calls
-// main.main before returning 0.
-func defineEntryFunction(ctx *context, pkg llssa.Package, argcVar, argvVar llssa.Global, argvType llssa.Type, runtimeStub, mainInit, mainMain llssa.Function, pyInit, rtInit, abiInit llssa.Function) llssa.Function {
+// initialization hooks (Python, runtime, ABI types, reflect stubs, package
+// init), and finally calls main.main before returning 0.
+func defineEntryFunction(ctx *context, pkg llssa.Package, argcVar, argvVar llssa.Global, argvType llssa.Type, runtimeStub, mainInit, mainMain llssa.Function, pyInit, rtInit, abiInit, stubInit llssa.Function) llssa.Function {
 	prog := pkg.Prog
 	entryName := "main"
 	if !needStart(ctx) && isWasmTarget(ctx.buildConf.Goos) {
@@ -134,6 +138,9 @@ func defineEntryFunction(ctx *context, pkg llssa.Package, argcVar, argvVar llssa
 	if abiInit != nil {
 		b.Call(abiInit.Expr)
 	}
+	if stubInit != nil {
+		b.Call(stubInit.Expr)
+	}
 	b.Call(runtimeStub.Expr)
 	b.Call(mainInit.Expr)
 	b.Call(mainMain.Expr)
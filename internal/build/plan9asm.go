@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/goplus/llgo/internal/cabi"
 	"github.com/goplus/llgo/internal/packages"
@@ -24,29 +25,15 @@ import (
 // NOTE: golang.org/x/tools/go/packages.Package does not expose SFiles, so we
 // query `go list -json` here to get the exact filtered set for GOOS/GOARCH.
 func compilePkgSFiles(ctx *context, aPkg *aPackage, pkg *packages.Package, verbose bool) ([]string, error) {
-	sfiles, err := pkgSFiles(ctx, pkg)
+	status, sfiles, err := plan9asmStatusOf(ctx, pkg)
 	if err != nil {
 		return nil, err
 	}
-	if len(sfiles) == 0 {
-		return nil, nil
-	}
-	if !ctx.plan9asmEnabled(pkg.PkgPath) {
-		// Strong policy: selected Plan9 asm must be handled either by
-		// translation or by an explicit runtime alt patch.
-		if !llruntime.HasAltPkg(pkg.PkgPath) {
-			// Some stdlib .s files are placeholders without any TEXT bodies
-			// (e.g. runtime/debug/debug.s). They carry no executable asm and
-			// are safe to ignore.
-			hasText, err := llplan9asm.HasAnyTextAsm(ctx.conf.Overlay, sfiles)
-			if err != nil {
-				return nil, fmt.Errorf("%s: inspect asm files: %w", pkg.PkgPath, err)
-			}
-			if !hasText {
-				return nil, nil
-			}
-			return nil, fmt.Errorf("%s: selected .s files require plan9asm translation; add support or whitelist via runtime/build.go hasAltPkg", pkg.PkgPath)
-		}
+	switch status {
+	case plan9asmTranslated:
+	case plan9asmUnsupported:
+		return nil, fmt.Errorf("%s: selected .s files require plan9asm translation; add support or whitelist via runtime/build.go hasAltPkg", pkg.PkgPath)
+	default:
 		return nil, nil
 	}
 	if pkg.Types == nil || pkg.Types.Scope() == nil {
@@ -122,7 +109,14 @@ func compilePkgSFiles(ctx *context, aPkg *aPackage, pkg *packages.Package, verbo
 			fmt.Fprintf(os.Stderr, "# compiling %s for pkg: %s\n", objPath, pkg.PkgPath)
 			fmt.Fprintln(os.Stderr, "clang", args)
 		}
-		if err := ctx.compiler().Compile(args...); err != nil {
+		start := time.Now()
+		cmd := ctx.compiler()
+		flush := ctx.captureOutput(pkg.PkgPath, &cmd.Stdout, &cmd.Stderr)
+		err = cmd.Compile(args...)
+		flush()
+		ctx.timedEvent(BuildEvent{ImportPath: pkg.PkgPath, Action: ActionCompile, File: sfile,
+			Command: cmd.CompileCommand(args...)}, start, err)
+		if err != nil {
 			os.Remove(objPath)
 			return nil, fmt.Errorf("%s: clang compile asm ll for %s: %w", pkg.PkgPath, sfile, err)
 		}
@@ -139,10 +133,61 @@ type plan9AsmSigCacheKey struct {
 
 var plan9AsmSigCache sync.Map // key: plan9AsmSigCacheKey, value: map[string]struct{}
 
+// How the Plan9 asm files of a package are handled.
+const (
+	plan9asmNone        = ""            // no .s files
+	plan9asmTranslated  = "translated"  // translated to LLVM IR
+	plan9asmAlt         = "alt"         // replaced by the alt package
+	plan9asmNoText      = "notext"      // placeholders without TEXT bodies
+	plan9asmUnsupported = "unsupported" // can't be built
+)
+
+// plan9asmStatusOf tells how the Plan9 asm files of pkg are handled, and
+// returns them.
+func plan9asmStatusOf(ctx *context, pkg *packages.Package) (string, []string, error) {
+	sfiles, err := pkgSFiles(ctx, pkg)
+	if err != nil {
+		return plan9asmNone, nil, err
+	}
+	if len(sfiles) == 0 {
+		return plan9asmNone, nil, nil
+	}
+	if ctx.plan9asmEnabled(pkg.PkgPath) {
+		return plan9asmTranslated, sfiles, nil
+	}
+	// Strong policy: selected Plan9 asm must be handled either by
+	// translation or by an explicit runtime alt patch.
+	if llruntime.HasAltPkg(pkg.PkgPath) {
+		return plan9asmAlt, sfiles, nil
+	}
+	// Some stdlib .s files are placeholders without any TEXT bodies
+	// (e.g. runtime/debug/debug.s). They carry no executable asm and
+	// are safe to ignore.
+	hasText, err := llplan9asm.HasAnyTextAsm(ctx.conf.Overlay, sfiles)
+	if err != nil {
+		return plan9asmNone, nil, fmt.Errorf("%s: inspect asm files: %w", pkg.PkgPath, err)
+	}
+	if !hasText {
+		return plan9asmNoText, sfiles, nil
+	}
+	return plan9asmUnsupported, sfiles, nil
+}
+
 func archSupportsPlan9AsmDefaults(goarch string) bool {
 	return goarch == "arm64" || goarch == "amd64"
 }
 
+// archLacksPlan9Asm reports whether the plan9asm translator doesn't handle
+// the Go assembly of goarch. Packages for such architectures always use their
+// alt packages or pure-Go fallbacks, even when opted in by LLGO_PLAN9ASM_PKGS.
+func archLacksPlan9Asm(goarch string) bool {
+	switch goarch {
+	case "loong64", "ppc64le", "s390x", "mips64", "mips64le":
+		return true
+	}
+	return false
+}
+
 type plan9asmPkgsEnvMode int
 
 const (
@@ -282,6 +327,9 @@ func (ctx *context) plan9asmEnabled(pkgPath string) bool {
 		}
 	})
 
+	if conf := ctx.buildConf; conf != nil && archLacksPlan9Asm(conf.Goarch) {
+		return false
+	}
 	switch ctx.plan9asmMode {
 	case plan9asmEnvAll:
 		return true
@@ -309,7 +357,7 @@ func hasAltPkgForTarget(conf *Config, pkgPath string) bool {
 		return false
 	}
 	// In ABI0/1, allow explicit env opt-in to prefer plan9asm over alt.
-	if conf != nil && conf.AbiMode != cabi.ModeAllFunc && plan9asmEnabledByEnv(pkgPath) {
+	if conf != nil && conf.AbiMode != cabi.ModeAllFunc && !archLacksPlan9Asm(conf.Goarch) && plan9asmEnabledByEnv(pkgPath) {
 		return false
 	}
 	return true
@@ -385,8 +433,9 @@ func pkgSFiles(ctx *context, pkg *packages.Package) ([]string, error) {
 	}
 
 	var lp struct {
-		Dir    string   `json:"Dir"`
-		SFiles []string `json:"SFiles"`
+		Dir      string   `json:"Dir"`
+		SFiles   []string `json:"SFiles"`
+		CgoFiles []string `json:"CgoFiles"`
 	}
 	if err := json.Unmarshal(out, &lp); err != nil {
 		return nil, fmt.Errorf("go list -json %s: parse: %w", pkg.PkgPath, err)
@@ -404,6 +453,13 @@ func pkgSFiles(ctx *context, pkg *packages.Package) ([]string, error) {
 		}
 	}
 
+	// In packages using cgo, assembly files are for the C compiler, which
+	// buildCgo passes them to, except in runtime/cgo bridging both worlds.
+	if len(lp.CgoFiles) > 0 && pkg.PkgPath != "runtime/cgo" {
+		ctx.sfilesCache[pkg.ID] = nil
+		return nil, nil
+	}
+
 	paths := make([]string, 0, len(lp.SFiles))
 	for _, f := range lp.SFiles {
 		if lp.Dir == "" {
@@ -18,3 +18,20 @@ func TestHasAltPkgForTarget_AllowsAdditivePatchWithPlan9Asm(t *testing.T) {
 		t.Fatal("internal/runtime/sys should keep its additive alt package even when plan9asm is enabled")
 	}
 }
+
+func TestHasAltPkgForTarget_ArchWithoutPlan9Asm(t *testing.T) {
+	t.Setenv(llgoPlan9ASMPkgs, "all")
+	for _, goarch := range []string{"loong64", "ppc64le", "s390x", "mips64", "mips64le"} {
+		conf := &Config{Goarch: goarch, AbiMode: cabi.ModeCFunc}
+		if plan9asmEnabledByDefault(conf, "sync/atomic") {
+			t.Fatalf("plan9asm should not be enabled by default on %s", goarch)
+		}
+		if !hasAltPkgForTarget(conf, "sync/atomic") {
+			t.Fatalf("sync/atomic should use its alt package on %s", goarch)
+		}
+		ctx := &context{buildConf: conf}
+		if ctx.plan9asmEnabled("sync/atomic") {
+			t.Fatalf("plan9asm should stay disabled on %s even when opted in", goarch)
+		}
+	}
+}
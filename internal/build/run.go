@@ -92,12 +92,12 @@ func runNative(ctx *context, app, pkgDir, pkgName string, conf *Config, mode Mod
 			}
 		}
 	case ModeCmpTest:
-		cmpTest(pkgDir, pkgName, app, conf.GenExpect, conf.RunArgs)
+		ctx.cmpTests = append(ctx.cmpTests, &cmpTestCase{dir: pkgDir, pkgPath: pkgName, app: app})
 	}
 	return nil
 }
 
-func runInEmulator(emulator string, envMap map[string]string, pkgDir, pkgName string, conf *Config, mode Mode, verbose bool) error {
+func runInEmulator(ctx *context, emulator string, envMap map[string]string, pkgDir, pkgName string, conf *Config, mode Mode, verbose bool) error {
 	// Skip execution if CompileOnly is true
 	if conf.CompileOnly {
 		return nil
@@ -116,7 +116,7 @@ func runInEmulator(emulator string, envMap map[string]string, pkgDir, pkgName st
 	case ModeTest:
 		return runEmuCmd(envMap, emulator, conf.RunArgs, verbose, conf.PrintCommands)
 	case ModeCmpTest:
-		cmpTest(pkgDir, pkgName, envMap["out"], conf.GenExpect, conf.RunArgs)
+		ctx.cmpTests = append(ctx.cmpTests, &cmpTestCase{dir: pkgDir, pkgPath: pkgName, app: envMap["out"]})
 		return nil
 	}
 	return nil
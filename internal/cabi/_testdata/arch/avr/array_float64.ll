@@ -0,0 +1,127 @@
+; ModuleID = '../../wrap/array_float64.c'
+source_filename = "../../wrap/array_float64.c"
+target datalayout = "e-P1-p:16:8-i8:8-i16:8-i32:8-i64:8-f32:8-f64:8-n8-a:8"
+target triple = "avr"
+
+%struct.array1 = type { [1 x double] }
+%struct.array2 = type { [2 x double] }
+%struct.array3 = type { [3 x double] }
+%struct.array4 = type { [4 x double] }
+%struct.array5 = type { [5 x double] }
+%struct.array6 = type { [6 x double] }
+%struct.array7 = type { [7 x double] }
+%struct.array8 = type { [8 x double] }
+%struct.array9 = type { [9 x double] }
+%struct.array10 = type { [10 x double] }
+%struct.array11 = type { [11 x double] }
+%struct.array12 = type { [12 x double] }
+%struct.array13 = type { [13 x double] }
+%struct.array14 = type { [14 x double] }
+%struct.array15 = type { [15 x double] }
+%struct.array16 = type { [16 x double] }
+%struct.array17 = type { [17 x double] }
+%struct.array18 = type { [18 x double] }
+%struct.array19 = type { [19 x double] }
+%struct.array20 = type { [20 x double] }
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.array1 @demo1([1 x double] %0) #0 {
+  ret %struct.array1 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo2(ptr noalias sret(%struct.array2) align 1 %0, [2 x double] %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo3(ptr noalias sret(%struct.array3) align 1 %0, ptr noundef byval(%struct.array3) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo4(ptr noalias sret(%struct.array4) align 1 %0, ptr noundef byval(%struct.array4) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo5(ptr noalias sret(%struct.array5) align 1 %0, ptr noundef byval(%struct.array5) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo6(ptr noalias sret(%struct.array6) align 1 %0, ptr noundef byval(%struct.array6) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo7(ptr noalias sret(%struct.array7) align 1 %0, ptr noundef byval(%struct.array7) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo8(ptr noalias sret(%struct.array8) align 1 %0, ptr noundef byval(%struct.array8) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo9(ptr noalias sret(%struct.array9) align 1 %0, ptr noundef byval(%struct.array9) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo10(ptr noalias sret(%struct.array10) align 1 %0, ptr noundef byval(%struct.array10) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo11(ptr noalias sret(%struct.array11) align 1 %0, ptr noundef byval(%struct.array11) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo12(ptr noalias sret(%struct.array12) align 1 %0, ptr noundef byval(%struct.array12) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo13(ptr noalias sret(%struct.array13) align 1 %0, ptr noundef byval(%struct.array13) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo14(ptr noalias sret(%struct.array14) align 1 %0, ptr noundef byval(%struct.array14) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo15(ptr noalias sret(%struct.array15) align 1 %0, ptr noundef byval(%struct.array15) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo16(ptr noalias sret(%struct.array16) align 1 %0, ptr noundef byval(%struct.array16) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo17(ptr noalias sret(%struct.array17) align 1 %0, ptr noundef byval(%struct.array17) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo18(ptr noalias sret(%struct.array18) align 1 %0, ptr noundef byval(%struct.array18) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo19(ptr noalias sret(%struct.array19) align 1 %0, ptr noundef byval(%struct.array19) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo20(ptr noalias sret(%struct.array20) align 1 %0, ptr noundef byval(%struct.array20) align 1 %1) #0 {
+  ret void
+}
+
+attributes #0 = { noinline nounwind optnone "frame-pointer"="all" "no-trapping-math"="true" "stack-protector-buffer-size"="8" "target-cpu"="atmega328p" }
@@ -0,0 +1,41 @@
+; ModuleID = '../../wrap/basic.c'
+source_filename = "../../wrap/basic.c"
+target datalayout = "e-P1-p:16:8-i8:8-i16:8-i32:8-i64:8-f32:8-f64:8-n8-a:8"
+target triple = "avr"
+
+; Function Attrs: noinline nounwind optnone
+define dso_local i8 @basic_int8(i8 noundef signext %0) #0 {
+  ret i8 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local signext i16 @basic_int16(i16 noundef signext %0) #0 {
+  ret i16 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local i32 @basic_int32(i32 noundef %0) #0 {
+  ret i32 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local i64 @basic_int64(i64 noundef %0) #0 {
+  ret i64 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local float @basic_float32(float noundef %0) #0 {
+  ret float zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local double @basic_float64(double noundef %0) #0 {
+  ret double zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local ptr @basic_pointer(ptr noundef %0) #0 {
+  ret ptr zeroinitializer
+}
+
+attributes #0 = { noinline nounwind optnone "frame-pointer"="all" "no-trapping-math"="true" "stack-protector-buffer-size"="8" "target-cpu"="atmega328p" }
@@ -0,0 +1,59 @@
+; ModuleID = '../../wrap/composite.c'
+source_filename = "../../wrap/composite.c"
+target datalayout = "e-P1-p:16:8-i8:8-i16:8-i32:8-i64:8-f32:8-f64:8-n8-a:8"
+target triple = "avr"
+
+%struct.basearray1 = type { [1 x i32] }
+%struct.array10 = type { %struct.basearray1 }
+%struct.array11 = type { %struct.basearray1, i32 }
+%struct.basepoint1 = type { i32 }
+%struct.point10 = type { %struct.basepoint1 }
+%struct.point11 = type { %struct.basepoint1, i32 }
+%struct.basearray2 = type { [2 x i32] }
+%struct.array20 = type { %struct.basearray2 }
+%struct.array21 = type { %struct.basearray2, i32 }
+%struct.basepoint2 = type { i32, i32 }
+%struct.point20 = type { %struct.basepoint2 }
+%struct.point21 = type { %struct.basepoint2, i32 }
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.array10 @demo_array10(%struct.basearray1 %0) #0 {
+  ret %struct.array10 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.array11 @demo_array11(%struct.basearray1 %0, i32 %1) #0 {
+  ret %struct.array11 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point10 @demo_point10(%struct.basepoint1 %0) #0 {
+  ret %struct.point10 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point11 @demo_point11(%struct.basepoint1 %0, i32 %1) #0 {
+  ret %struct.point11 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.array20 @demo_array20(%struct.basearray2 %0) #0 {
+  ret %struct.array20 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo_array21(ptr noalias sret(%struct.array21) align 1 %0, %struct.basearray2 %1, i32 %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point20 @demo_point20(%struct.basepoint2 %0) #0 {
+  ret %struct.point20 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo_point21(ptr noalias sret(%struct.point21) align 1 %0, %struct.basepoint2 %1, i32 %2) #0 {
+  ret void
+}
+
+attributes #0 = { noinline nounwind optnone "frame-pointer"="all" "no-trapping-math"="true" "stack-protector-buffer-size"="8" "target-cpu"="atmega328p" }
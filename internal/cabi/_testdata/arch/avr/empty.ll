@@ -0,0 +1,28 @@
+; ModuleID = '../../wrap/empty.c'
+source_filename = "../../wrap/empty.c"
+target datalayout = "e-P1-p:16:8-i8:8-i16:8-i32:8-i64:8-f32:8-f64:8-n8-a:8"
+target triple = "avr"
+
+%struct.empty = type {}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.empty @demo0() #0 {
+  ret %struct.empty zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.empty @demo1(i32 noundef %0) #0 {
+  ret %struct.empty zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local i32 @demo2(i32 noundef %0) #0 {
+  ret i32 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local i32 @demo3(i32 noundef %0, i32 noundef %1) #0 {
+  ret i32 zeroinitializer
+}
+
+attributes #0 = { noinline nounwind optnone "frame-pointer"="all" "no-trapping-math"="true" "stack-protector-buffer-size"="8" "target-cpu"="atmega328p" }
@@ -0,0 +1,127 @@
+; ModuleID = '../../wrap/struct_int32.c'
+source_filename = "../../wrap/struct_int32.c"
+target datalayout = "e-P1-p:16:8-i8:8-i16:8-i32:8-i64:8-f32:8-f64:8-n8-a:8"
+target triple = "avr"
+
+%struct.point1 = type { i32 }
+%struct.point2 = type { i32, i32 }
+%struct.point3 = type { i32, i32, i32 }
+%struct.point4 = type { i32, i32, i32, i32 }
+%struct.point5 = type { i32, i32, i32, i32, i32 }
+%struct.point6 = type { i32, i32, i32, i32, i32, i32 }
+%struct.point7 = type { i32, i32, i32, i32, i32, i32, i32 }
+%struct.point8 = type { i32, i32, i32, i32, i32, i32, i32, i32 }
+%struct.point9 = type { i32, i32, i32, i32, i32, i32, i32, i32, i32 }
+%struct.point10 = type { i32, i32, i32, i32, i32, i32, i32, i32, i32, i32 }
+%struct.point11 = type { i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32 }
+%struct.point12 = type { i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32 }
+%struct.point13 = type { i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32 }
+%struct.point14 = type { i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32 }
+%struct.point15 = type { i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32 }
+%struct.point16 = type { i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32 }
+%struct.point17 = type { i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32 }
+%struct.point18 = type { i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32 }
+%struct.point19 = type { i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32 }
+%struct.point20 = type { i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32, i32 }
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point1 @demo1(i32 %0) #0 {
+  ret %struct.point1 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point2 @demo2(i32 %0, i32 %1) #0 {
+  ret %struct.point2 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo3(ptr noalias sret(%struct.point3) align 1 %0, i32 %1, i32 %2, i32 %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo4(ptr noalias sret(%struct.point4) align 1 %0, i32 %1, i32 %2, i32 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo5(ptr noalias sret(%struct.point5) align 1 %0, ptr noundef byval(%struct.point5) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo6(ptr noalias sret(%struct.point6) align 1 %0, ptr noundef byval(%struct.point6) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo7(ptr noalias sret(%struct.point7) align 1 %0, ptr noundef byval(%struct.point7) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo8(ptr noalias sret(%struct.point8) align 1 %0, ptr noundef byval(%struct.point8) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo9(ptr noalias sret(%struct.point9) align 1 %0, ptr noundef byval(%struct.point9) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo10(ptr noalias sret(%struct.point10) align 1 %0, ptr noundef byval(%struct.point10) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo11(ptr noalias sret(%struct.point11) align 1 %0, ptr noundef byval(%struct.point11) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo12(ptr noalias sret(%struct.point12) align 1 %0, ptr noundef byval(%struct.point12) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo13(ptr noalias sret(%struct.point13) align 1 %0, ptr noundef byval(%struct.point13) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo14(ptr noalias sret(%struct.point14) align 1 %0, ptr noundef byval(%struct.point14) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo15(ptr noalias sret(%struct.point15) align 1 %0, ptr noundef byval(%struct.point15) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo16(ptr noalias sret(%struct.point16) align 1 %0, ptr noundef byval(%struct.point16) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo17(ptr noalias sret(%struct.point17) align 1 %0, ptr noundef byval(%struct.point17) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo18(ptr noalias sret(%struct.point18) align 1 %0, ptr noundef byval(%struct.point18) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo19(ptr noalias sret(%struct.point19) align 1 %0, ptr noundef byval(%struct.point19) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo20(ptr noalias sret(%struct.point20) align 1 %0, ptr noundef byval(%struct.point20) align 1 %1) #0 {
+  ret void
+}
+
+attributes #0 = { noinline nounwind optnone "frame-pointer"="all" "no-trapping-math"="true" "stack-protector-buffer-size"="8" "target-cpu"="atmega328p" }
@@ -0,0 +1,127 @@
+; ModuleID = '../../wrap/struct_int8.c'
+source_filename = "../../wrap/struct_int8.c"
+target datalayout = "e-P1-p:16:8-i8:8-i16:8-i32:8-i64:8-f32:8-f64:8-n8-a:8"
+target triple = "avr"
+
+%struct.point1 = type { i8 }
+%struct.point2 = type { i8, i8 }
+%struct.point3 = type { i8, i8, i8 }
+%struct.point4 = type { i8, i8, i8, i8 }
+%struct.point5 = type { i8, i8, i8, i8, i8 }
+%struct.point6 = type { i8, i8, i8, i8, i8, i8 }
+%struct.point7 = type { i8, i8, i8, i8, i8, i8, i8 }
+%struct.point8 = type { i8, i8, i8, i8, i8, i8, i8, i8 }
+%struct.point9 = type { i8, i8, i8, i8, i8, i8, i8, i8, i8 }
+%struct.point10 = type { i8, i8, i8, i8, i8, i8, i8, i8, i8, i8 }
+%struct.point11 = type { i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8 }
+%struct.point12 = type { i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8 }
+%struct.point13 = type { i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8 }
+%struct.point14 = type { i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8 }
+%struct.point15 = type { i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8 }
+%struct.point16 = type { i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8 }
+%struct.point17 = type { i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8 }
+%struct.point18 = type { i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8 }
+%struct.point19 = type { i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8 }
+%struct.point20 = type { i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8, i8 }
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point1 @demo1(i8 %0) #0 {
+  ret %struct.point1 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point2 @demo2(i8 %0, i8 %1) #0 {
+  ret %struct.point2 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point3 @demo3(i8 %0, i8 %1, i8 %2) #0 {
+  ret %struct.point3 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point4 @demo4(i8 %0, i8 %1, i8 %2, i8 %3) #0 {
+  ret %struct.point4 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point5 @demo5(i8 %0, i8 %1, i8 %2, i8 %3, i8 %4) #0 {
+  ret %struct.point5 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point6 @demo6(i8 %0, i8 %1, i8 %2, i8 %3, i8 %4, i8 %5) #0 {
+  ret %struct.point6 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point7 @demo7(i8 %0, i8 %1, i8 %2, i8 %3, i8 %4, i8 %5, i8 %6) #0 {
+  ret %struct.point7 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point8 @demo8(i8 %0, i8 %1, i8 %2, i8 %3, i8 %4, i8 %5, i8 %6, i8 %7) #0 {
+  ret %struct.point8 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo9(ptr noalias sret(%struct.point9) align 1 %0, i8 %1, i8 %2, i8 %3, i8 %4, i8 %5, i8 %6, i8 %7, i8 %8, i8 %9) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo10(ptr noalias sret(%struct.point10) align 1 %0, i8 %1, i8 %2, i8 %3, i8 %4, i8 %5, i8 %6, i8 %7, i8 %8, i8 %9, i8 %10) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo11(ptr noalias sret(%struct.point11) align 1 %0, i8 %1, i8 %2, i8 %3, i8 %4, i8 %5, i8 %6, i8 %7, i8 %8, i8 %9, i8 %10, i8 %11) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo12(ptr noalias sret(%struct.point12) align 1 %0, i8 %1, i8 %2, i8 %3, i8 %4, i8 %5, i8 %6, i8 %7, i8 %8, i8 %9, i8 %10, i8 %11, i8 %12) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo13(ptr noalias sret(%struct.point13) align 1 %0, i8 %1, i8 %2, i8 %3, i8 %4, i8 %5, i8 %6, i8 %7, i8 %8, i8 %9, i8 %10, i8 %11, i8 %12, i8 %13) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo14(ptr noalias sret(%struct.point14) align 1 %0, i8 %1, i8 %2, i8 %3, i8 %4, i8 %5, i8 %6, i8 %7, i8 %8, i8 %9, i8 %10, i8 %11, i8 %12, i8 %13, i8 %14) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo15(ptr noalias sret(%struct.point15) align 1 %0, i8 %1, i8 %2, i8 %3, i8 %4, i8 %5, i8 %6, i8 %7, i8 %8, i8 %9, i8 %10, i8 %11, i8 %12, i8 %13, i8 %14, i8 %15) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo16(ptr noalias sret(%struct.point16) align 1 %0, i8 %1, i8 %2, i8 %3, i8 %4, i8 %5, i8 %6, i8 %7, i8 %8, i8 %9, i8 %10, i8 %11, i8 %12, i8 %13, i8 %14, i8 %15, i8 %16) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo17(ptr noalias sret(%struct.point17) align 1 %0, ptr noundef byval(%struct.point17) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo18(ptr noalias sret(%struct.point18) align 1 %0, ptr noundef byval(%struct.point18) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo19(ptr noalias sret(%struct.point19) align 1 %0, ptr noundef byval(%struct.point19) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo20(ptr noalias sret(%struct.point20) align 1 %0, ptr noundef byval(%struct.point20) align 1 %1) #0 {
+  ret void
+}
+
+attributes #0 = { noinline nounwind optnone "frame-pointer"="all" "no-trapping-math"="true" "stack-protector-buffer-size"="8" "target-cpu"="atmega328p" }
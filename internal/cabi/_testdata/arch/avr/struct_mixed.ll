@@ -0,0 +1,6307 @@
+; ModuleID = '../../wrap/struct_mixed.c'
+source_filename = "../../wrap/struct_mixed.c"
+target datalayout = "e-P1-p:16:8-i8:8-i16:8-i32:8-i64:8-f32:8-f64:8-n8-a:8"
+target triple = "avr"
+
+%struct.point1 = type { i8, i16 }
+%struct.point2 = type { i8, i32 }
+%struct.point3 = type { i8, i64 }
+%struct.point4 = type { i8, float }
+%struct.point5 = type { i8, double }
+%struct.point6 = type { i8, ptr }
+%struct.point7 = type { i16, i8 }
+%struct.point8 = type { i16, i32 }
+%struct.point9 = type { i16, i64 }
+%struct.point10 = type { i16, float }
+%struct.point11 = type { i16, double }
+%struct.point12 = type { i16, ptr }
+%struct.point13 = type { i32, i8 }
+%struct.point14 = type { i32, i16 }
+%struct.point15 = type { i32, i64 }
+%struct.point16 = type { i32, float }
+%struct.point17 = type { i32, double }
+%struct.point18 = type { i32, ptr }
+%struct.point19 = type { i64, i8 }
+%struct.point20 = type { i64, i16 }
+%struct.point21 = type { i64, i32 }
+%struct.point22 = type { i64, float }
+%struct.point23 = type { i64, double }
+%struct.point24 = type { i64, ptr }
+%struct.point25 = type { float, i8 }
+%struct.point26 = type { float, i16 }
+%struct.point27 = type { float, i32 }
+%struct.point28 = type { float, i64 }
+%struct.point29 = type { float, double }
+%struct.point30 = type { float, ptr }
+%struct.point31 = type { double, i8 }
+%struct.point32 = type { double, i16 }
+%struct.point33 = type { double, i32 }
+%struct.point34 = type { double, i64 }
+%struct.point35 = type { double, float }
+%struct.point36 = type { double, ptr }
+%struct.point37 = type { ptr, i8 }
+%struct.point38 = type { ptr, i16 }
+%struct.point39 = type { ptr, i32 }
+%struct.point40 = type { ptr, i64 }
+%struct.point41 = type { ptr, float }
+%struct.point42 = type { ptr, double }
+%struct.point43 = type { i8, i16, i8 }
+%struct.point44 = type { i8, i16, i8, i8 }
+%struct.point45 = type { i8, i16, i8, i8, i8 }
+%struct.point46 = type { i8, i16, i8, i8, i16 }
+%struct.point47 = type { i8, i16, i8, i8, i32 }
+%struct.point48 = type { i8, i16, i8, i8, float }
+%struct.point49 = type { i8, i16, i8, i16 }
+%struct.point50 = type { i8, i16, i8, i16, i8 }
+%struct.point51 = type { i8, i16, i8, i16, i16 }
+%struct.point52 = type { i8, i16, i8, i16, i32 }
+%struct.point53 = type { i8, i16, i8, i16, float }
+%struct.point54 = type { i8, i16, i8, i32 }
+%struct.point55 = type { i8, i16, i8, i32, i8 }
+%struct.point56 = type { i8, i16, i8, i32, i16 }
+%struct.point57 = type { i8, i16, i8, i32, i32 }
+%struct.point58 = type { i8, i16, i8, i32, float }
+%struct.point59 = type { i8, i16, i8, float }
+%struct.point60 = type { i8, i16, i8, float, i8 }
+%struct.point61 = type { i8, i16, i8, float, i16 }
+%struct.point62 = type { i8, i16, i8, float, i32 }
+%struct.point63 = type { i8, i16, i8, float, float }
+%struct.point64 = type { i8, i16, i16 }
+%struct.point65 = type { i8, i16, i16, i8 }
+%struct.point66 = type { i8, i16, i16, i8, i8 }
+%struct.point67 = type { i8, i16, i16, i8, i16 }
+%struct.point68 = type { i8, i16, i16, i8, i32 }
+%struct.point69 = type { i8, i16, i16, i8, float }
+%struct.point70 = type { i8, i16, i16, i16 }
+%struct.point71 = type { i8, i16, i16, i16, i8 }
+%struct.point72 = type { i8, i16, i16, i16, i16 }
+%struct.point73 = type { i8, i16, i16, i16, i32 }
+%struct.point74 = type { i8, i16, i16, i16, float }
+%struct.point75 = type { i8, i16, i16, i32 }
+%struct.point76 = type { i8, i16, i16, i32, i8 }
+%struct.point77 = type { i8, i16, i16, i32, i16 }
+%struct.point78 = type { i8, i16, i16, i32, i32 }
+%struct.point79 = type { i8, i16, i16, i32, float }
+%struct.point80 = type { i8, i16, i16, float }
+%struct.point81 = type { i8, i16, i16, float, i8 }
+%struct.point82 = type { i8, i16, i16, float, i16 }
+%struct.point83 = type { i8, i16, i16, float, i32 }
+%struct.point84 = type { i8, i16, i16, float, float }
+%struct.point85 = type { i8, i16, i32 }
+%struct.point86 = type { i8, i16, i32, i8 }
+%struct.point87 = type { i8, i16, i32, i8, i8 }
+%struct.point88 = type { i8, i16, i32, i8, i16 }
+%struct.point89 = type { i8, i16, i32, i8, i32 }
+%struct.point90 = type { i8, i16, i32, i8, float }
+%struct.point91 = type { i8, i16, i32, i16 }
+%struct.point92 = type { i8, i16, i32, i16, i8 }
+%struct.point93 = type { i8, i16, i32, i16, i16 }
+%struct.point94 = type { i8, i16, i32, i16, i32 }
+%struct.point95 = type { i8, i16, i32, i16, float }
+%struct.point96 = type { i8, i16, i32, i32 }
+%struct.point97 = type { i8, i16, i32, i32, i8 }
+%struct.point98 = type { i8, i16, i32, i32, i16 }
+%struct.point99 = type { i8, i16, i32, i32, i32 }
+%struct.point100 = type { i8, i16, i32, i32, float }
+%struct.point101 = type { i8, i16, i32, float }
+%struct.point102 = type { i8, i16, i32, float, i8 }
+%struct.point103 = type { i8, i16, i32, float, i16 }
+%struct.point104 = type { i8, i16, i32, float, i32 }
+%struct.point105 = type { i8, i16, i32, float, float }
+%struct.point106 = type { i8, i16, float }
+%struct.point107 = type { i8, i16, float, i8 }
+%struct.point108 = type { i8, i16, float, i8, i8 }
+%struct.point109 = type { i8, i16, float, i8, i16 }
+%struct.point110 = type { i8, i16, float, i8, i32 }
+%struct.point111 = type { i8, i16, float, i8, float }
+%struct.point112 = type { i8, i16, float, i16 }
+%struct.point113 = type { i8, i16, float, i16, i8 }
+%struct.point114 = type { i8, i16, float, i16, i16 }
+%struct.point115 = type { i8, i16, float, i16, i32 }
+%struct.point116 = type { i8, i16, float, i16, float }
+%struct.point117 = type { i8, i16, float, i32 }
+%struct.point118 = type { i8, i16, float, i32, i8 }
+%struct.point119 = type { i8, i16, float, i32, i16 }
+%struct.point120 = type { i8, i16, float, i32, i32 }
+%struct.point121 = type { i8, i16, float, i32, float }
+%struct.point122 = type { i8, i16, float, float }
+%struct.point123 = type { i8, i16, float, float, i8 }
+%struct.point124 = type { i8, i16, float, float, i16 }
+%struct.point125 = type { i8, i16, float, float, i32 }
+%struct.point126 = type { i8, i16, float, float, float }
+%struct.point127 = type { i8, i32, i8 }
+%struct.point128 = type { i8, i32, i8, i8 }
+%struct.point129 = type { i8, i32, i8, i8, i8 }
+%struct.point130 = type { i8, i32, i8, i8, i16 }
+%struct.point131 = type { i8, i32, i8, i8, i32 }
+%struct.point132 = type { i8, i32, i8, i8, float }
+%struct.point133 = type { i8, i32, i8, i16 }
+%struct.point134 = type { i8, i32, i8, i16, i8 }
+%struct.point135 = type { i8, i32, i8, i16, i16 }
+%struct.point136 = type { i8, i32, i8, i16, i32 }
+%struct.point137 = type { i8, i32, i8, i16, float }
+%struct.point138 = type { i8, i32, i8, i32 }
+%struct.point139 = type { i8, i32, i8, i32, i8 }
+%struct.point140 = type { i8, i32, i8, i32, i16 }
+%struct.point141 = type { i8, i32, i8, i32, i32 }
+%struct.point142 = type { i8, i32, i8, i32, float }
+%struct.point143 = type { i8, i32, i8, float }
+%struct.point144 = type { i8, i32, i8, float, i8 }
+%struct.point145 = type { i8, i32, i8, float, i16 }
+%struct.point146 = type { i8, i32, i8, float, i32 }
+%struct.point147 = type { i8, i32, i8, float, float }
+%struct.point148 = type { i8, i32, i16 }
+%struct.point149 = type { i8, i32, i16, i8 }
+%struct.point150 = type { i8, i32, i16, i8, i8 }
+%struct.point151 = type { i8, i32, i16, i8, i16 }
+%struct.point152 = type { i8, i32, i16, i8, i32 }
+%struct.point153 = type { i8, i32, i16, i8, float }
+%struct.point154 = type { i8, i32, i16, i16 }
+%struct.point155 = type { i8, i32, i16, i16, i8 }
+%struct.point156 = type { i8, i32, i16, i16, i16 }
+%struct.point157 = type { i8, i32, i16, i16, i32 }
+%struct.point158 = type { i8, i32, i16, i16, float }
+%struct.point159 = type { i8, i32, i16, i32 }
+%struct.point160 = type { i8, i32, i16, i32, i8 }
+%struct.point161 = type { i8, i32, i16, i32, i16 }
+%struct.point162 = type { i8, i32, i16, i32, i32 }
+%struct.point163 = type { i8, i32, i16, i32, float }
+%struct.point164 = type { i8, i32, i16, float }
+%struct.point165 = type { i8, i32, i16, float, i8 }
+%struct.point166 = type { i8, i32, i16, float, i16 }
+%struct.point167 = type { i8, i32, i16, float, i32 }
+%struct.point168 = type { i8, i32, i16, float, float }
+%struct.point169 = type { i8, i32, i32 }
+%struct.point170 = type { i8, i32, i32, i8 }
+%struct.point171 = type { i8, i32, i32, i8, i8 }
+%struct.point172 = type { i8, i32, i32, i8, i16 }
+%struct.point173 = type { i8, i32, i32, i8, i32 }
+%struct.point174 = type { i8, i32, i32, i8, float }
+%struct.point175 = type { i8, i32, i32, i16 }
+%struct.point176 = type { i8, i32, i32, i16, i8 }
+%struct.point177 = type { i8, i32, i32, i16, i16 }
+%struct.point178 = type { i8, i32, i32, i16, i32 }
+%struct.point179 = type { i8, i32, i32, i16, float }
+%struct.point180 = type { i8, i32, i32, i32 }
+%struct.point181 = type { i8, i32, i32, i32, i8 }
+%struct.point182 = type { i8, i32, i32, i32, i16 }
+%struct.point183 = type { i8, i32, i32, i32, i32 }
+%struct.point184 = type { i8, i32, i32, i32, float }
+%struct.point185 = type { i8, i32, i32, float }
+%struct.point186 = type { i8, i32, i32, float, i8 }
+%struct.point187 = type { i8, i32, i32, float, i16 }
+%struct.point188 = type { i8, i32, i32, float, i32 }
+%struct.point189 = type { i8, i32, i32, float, float }
+%struct.point190 = type { i8, i32, float }
+%struct.point191 = type { i8, i32, float, i8 }
+%struct.point192 = type { i8, i32, float, i8, i8 }
+%struct.point193 = type { i8, i32, float, i8, i16 }
+%struct.point194 = type { i8, i32, float, i8, i32 }
+%struct.point195 = type { i8, i32, float, i8, float }
+%struct.point196 = type { i8, i32, float, i16 }
+%struct.point197 = type { i8, i32, float, i16, i8 }
+%struct.point198 = type { i8, i32, float, i16, i16 }
+%struct.point199 = type { i8, i32, float, i16, i32 }
+%struct.point200 = type { i8, i32, float, i16, float }
+%struct.point201 = type { i8, i32, float, i32 }
+%struct.point202 = type { i8, i32, float, i32, i8 }
+%struct.point203 = type { i8, i32, float, i32, i16 }
+%struct.point204 = type { i8, i32, float, i32, i32 }
+%struct.point205 = type { i8, i32, float, i32, float }
+%struct.point206 = type { i8, i32, float, float }
+%struct.point207 = type { i8, i32, float, float, i8 }
+%struct.point208 = type { i8, i32, float, float, i16 }
+%struct.point209 = type { i8, i32, float, float, i32 }
+%struct.point210 = type { i8, i32, float, float, float }
+%struct.point211 = type { i8, float, i8 }
+%struct.point212 = type { i8, float, i8, i8 }
+%struct.point213 = type { i8, float, i8, i8, i8 }
+%struct.point214 = type { i8, float, i8, i8, i16 }
+%struct.point215 = type { i8, float, i8, i8, i32 }
+%struct.point216 = type { i8, float, i8, i8, float }
+%struct.point217 = type { i8, float, i8, i16 }
+%struct.point218 = type { i8, float, i8, i16, i8 }
+%struct.point219 = type { i8, float, i8, i16, i16 }
+%struct.point220 = type { i8, float, i8, i16, i32 }
+%struct.point221 = type { i8, float, i8, i16, float }
+%struct.point222 = type { i8, float, i8, i32 }
+%struct.point223 = type { i8, float, i8, i32, i8 }
+%struct.point224 = type { i8, float, i8, i32, i16 }
+%struct.point225 = type { i8, float, i8, i32, i32 }
+%struct.point226 = type { i8, float, i8, i32, float }
+%struct.point227 = type { i8, float, i8, float }
+%struct.point228 = type { i8, float, i8, float, i8 }
+%struct.point229 = type { i8, float, i8, float, i16 }
+%struct.point230 = type { i8, float, i8, float, i32 }
+%struct.point231 = type { i8, float, i8, float, float }
+%struct.point232 = type { i8, float, i16 }
+%struct.point233 = type { i8, float, i16, i8 }
+%struct.point234 = type { i8, float, i16, i8, i8 }
+%struct.point235 = type { i8, float, i16, i8, i16 }
+%struct.point236 = type { i8, float, i16, i8, i32 }
+%struct.point237 = type { i8, float, i16, i8, float }
+%struct.point238 = type { i8, float, i16, i16 }
+%struct.point239 = type { i8, float, i16, i16, i8 }
+%struct.point240 = type { i8, float, i16, i16, i16 }
+%struct.point241 = type { i8, float, i16, i16, i32 }
+%struct.point242 = type { i8, float, i16, i16, float }
+%struct.point243 = type { i8, float, i16, i32 }
+%struct.point244 = type { i8, float, i16, i32, i8 }
+%struct.point245 = type { i8, float, i16, i32, i16 }
+%struct.point246 = type { i8, float, i16, i32, i32 }
+%struct.point247 = type { i8, float, i16, i32, float }
+%struct.point248 = type { i8, float, i16, float }
+%struct.point249 = type { i8, float, i16, float, i8 }
+%struct.point250 = type { i8, float, i16, float, i16 }
+%struct.point251 = type { i8, float, i16, float, i32 }
+%struct.point252 = type { i8, float, i16, float, float }
+%struct.point253 = type { i8, float, i32 }
+%struct.point254 = type { i8, float, i32, i8 }
+%struct.point255 = type { i8, float, i32, i8, i8 }
+%struct.point256 = type { i8, float, i32, i8, i16 }
+%struct.point257 = type { i8, float, i32, i8, i32 }
+%struct.point258 = type { i8, float, i32, i8, float }
+%struct.point259 = type { i8, float, i32, i16 }
+%struct.point260 = type { i8, float, i32, i16, i8 }
+%struct.point261 = type { i8, float, i32, i16, i16 }
+%struct.point262 = type { i8, float, i32, i16, i32 }
+%struct.point263 = type { i8, float, i32, i16, float }
+%struct.point264 = type { i8, float, i32, i32 }
+%struct.point265 = type { i8, float, i32, i32, i8 }
+%struct.point266 = type { i8, float, i32, i32, i16 }
+%struct.point267 = type { i8, float, i32, i32, i32 }
+%struct.point268 = type { i8, float, i32, i32, float }
+%struct.point269 = type { i8, float, i32, float }
+%struct.point270 = type { i8, float, i32, float, i8 }
+%struct.point271 = type { i8, float, i32, float, i16 }
+%struct.point272 = type { i8, float, i32, float, i32 }
+%struct.point273 = type { i8, float, i32, float, float }
+%struct.point274 = type { i8, float, float }
+%struct.point275 = type { i8, float, float, i8 }
+%struct.point276 = type { i8, float, float, i8, i8 }
+%struct.point277 = type { i8, float, float, i8, i16 }
+%struct.point278 = type { i8, float, float, i8, i32 }
+%struct.point279 = type { i8, float, float, i8, float }
+%struct.point280 = type { i8, float, float, i16 }
+%struct.point281 = type { i8, float, float, i16, i8 }
+%struct.point282 = type { i8, float, float, i16, i16 }
+%struct.point283 = type { i8, float, float, i16, i32 }
+%struct.point284 = type { i8, float, float, i16, float }
+%struct.point285 = type { i8, float, float, i32 }
+%struct.point286 = type { i8, float, float, i32, i8 }
+%struct.point287 = type { i8, float, float, i32, i16 }
+%struct.point288 = type { i8, float, float, i32, i32 }
+%struct.point289 = type { i8, float, float, i32, float }
+%struct.point290 = type { i8, float, float, float }
+%struct.point291 = type { i8, float, float, float, i8 }
+%struct.point292 = type { i8, float, float, float, i16 }
+%struct.point293 = type { i8, float, float, float, i32 }
+%struct.point294 = type { i8, float, float, float, float }
+%struct.point295 = type { i16, i8, i8 }
+%struct.point296 = type { i16, i8, i8, i8 }
+%struct.point297 = type { i16, i8, i8, i8, i8 }
+%struct.point298 = type { i16, i8, i8, i8, i16 }
+%struct.point299 = type { i16, i8, i8, i8, i32 }
+%struct.point300 = type { i16, i8, i8, i8, float }
+%struct.point301 = type { i16, i8, i8, i16 }
+%struct.point302 = type { i16, i8, i8, i16, i8 }
+%struct.point303 = type { i16, i8, i8, i16, i16 }
+%struct.point304 = type { i16, i8, i8, i16, i32 }
+%struct.point305 = type { i16, i8, i8, i16, float }
+%struct.point306 = type { i16, i8, i8, i32 }
+%struct.point307 = type { i16, i8, i8, i32, i8 }
+%struct.point308 = type { i16, i8, i8, i32, i16 }
+%struct.point309 = type { i16, i8, i8, i32, i32 }
+%struct.point310 = type { i16, i8, i8, i32, float }
+%struct.point311 = type { i16, i8, i8, float }
+%struct.point312 = type { i16, i8, i8, float, i8 }
+%struct.point313 = type { i16, i8, i8, float, i16 }
+%struct.point314 = type { i16, i8, i8, float, i32 }
+%struct.point315 = type { i16, i8, i8, float, float }
+%struct.point316 = type { i16, i8, i16 }
+%struct.point317 = type { i16, i8, i16, i8 }
+%struct.point318 = type { i16, i8, i16, i8, i8 }
+%struct.point319 = type { i16, i8, i16, i8, i16 }
+%struct.point320 = type { i16, i8, i16, i8, i32 }
+%struct.point321 = type { i16, i8, i16, i8, float }
+%struct.point322 = type { i16, i8, i16, i16 }
+%struct.point323 = type { i16, i8, i16, i16, i8 }
+%struct.point324 = type { i16, i8, i16, i16, i16 }
+%struct.point325 = type { i16, i8, i16, i16, i32 }
+%struct.point326 = type { i16, i8, i16, i16, float }
+%struct.point327 = type { i16, i8, i16, i32 }
+%struct.point328 = type { i16, i8, i16, i32, i8 }
+%struct.point329 = type { i16, i8, i16, i32, i16 }
+%struct.point330 = type { i16, i8, i16, i32, i32 }
+%struct.point331 = type { i16, i8, i16, i32, float }
+%struct.point332 = type { i16, i8, i16, float }
+%struct.point333 = type { i16, i8, i16, float, i8 }
+%struct.point334 = type { i16, i8, i16, float, i16 }
+%struct.point335 = type { i16, i8, i16, float, i32 }
+%struct.point336 = type { i16, i8, i16, float, float }
+%struct.point337 = type { i16, i8, i32 }
+%struct.point338 = type { i16, i8, i32, i8 }
+%struct.point339 = type { i16, i8, i32, i8, i8 }
+%struct.point340 = type { i16, i8, i32, i8, i16 }
+%struct.point341 = type { i16, i8, i32, i8, i32 }
+%struct.point342 = type { i16, i8, i32, i8, float }
+%struct.point343 = type { i16, i8, i32, i16 }
+%struct.point344 = type { i16, i8, i32, i16, i8 }
+%struct.point345 = type { i16, i8, i32, i16, i16 }
+%struct.point346 = type { i16, i8, i32, i16, i32 }
+%struct.point347 = type { i16, i8, i32, i16, float }
+%struct.point348 = type { i16, i8, i32, i32 }
+%struct.point349 = type { i16, i8, i32, i32, i8 }
+%struct.point350 = type { i16, i8, i32, i32, i16 }
+%struct.point351 = type { i16, i8, i32, i32, i32 }
+%struct.point352 = type { i16, i8, i32, i32, float }
+%struct.point353 = type { i16, i8, i32, float }
+%struct.point354 = type { i16, i8, i32, float, i8 }
+%struct.point355 = type { i16, i8, i32, float, i16 }
+%struct.point356 = type { i16, i8, i32, float, i32 }
+%struct.point357 = type { i16, i8, i32, float, float }
+%struct.point358 = type { i16, i8, float }
+%struct.point359 = type { i16, i8, float, i8 }
+%struct.point360 = type { i16, i8, float, i8, i8 }
+%struct.point361 = type { i16, i8, float, i8, i16 }
+%struct.point362 = type { i16, i8, float, i8, i32 }
+%struct.point363 = type { i16, i8, float, i8, float }
+%struct.point364 = type { i16, i8, float, i16 }
+%struct.point365 = type { i16, i8, float, i16, i8 }
+%struct.point366 = type { i16, i8, float, i16, i16 }
+%struct.point367 = type { i16, i8, float, i16, i32 }
+%struct.point368 = type { i16, i8, float, i16, float }
+%struct.point369 = type { i16, i8, float, i32 }
+%struct.point370 = type { i16, i8, float, i32, i8 }
+%struct.point371 = type { i16, i8, float, i32, i16 }
+%struct.point372 = type { i16, i8, float, i32, i32 }
+%struct.point373 = type { i16, i8, float, i32, float }
+%struct.point374 = type { i16, i8, float, float }
+%struct.point375 = type { i16, i8, float, float, i8 }
+%struct.point376 = type { i16, i8, float, float, i16 }
+%struct.point377 = type { i16, i8, float, float, i32 }
+%struct.point378 = type { i16, i8, float, float, float }
+%struct.point379 = type { i16, i32, i8 }
+%struct.point380 = type { i16, i32, i8, i8 }
+%struct.point381 = type { i16, i32, i8, i8, i8 }
+%struct.point382 = type { i16, i32, i8, i8, i16 }
+%struct.point383 = type { i16, i32, i8, i8, i32 }
+%struct.point384 = type { i16, i32, i8, i8, float }
+%struct.point385 = type { i16, i32, i8, i16 }
+%struct.point386 = type { i16, i32, i8, i16, i8 }
+%struct.point387 = type { i16, i32, i8, i16, i16 }
+%struct.point388 = type { i16, i32, i8, i16, i32 }
+%struct.point389 = type { i16, i32, i8, i16, float }
+%struct.point390 = type { i16, i32, i8, i32 }
+%struct.point391 = type { i16, i32, i8, i32, i8 }
+%struct.point392 = type { i16, i32, i8, i32, i16 }
+%struct.point393 = type { i16, i32, i8, i32, i32 }
+%struct.point394 = type { i16, i32, i8, i32, float }
+%struct.point395 = type { i16, i32, i8, float }
+%struct.point396 = type { i16, i32, i8, float, i8 }
+%struct.point397 = type { i16, i32, i8, float, i16 }
+%struct.point398 = type { i16, i32, i8, float, i32 }
+%struct.point399 = type { i16, i32, i8, float, float }
+%struct.point400 = type { i16, i32, i16 }
+%struct.point401 = type { i16, i32, i16, i8 }
+%struct.point402 = type { i16, i32, i16, i8, i8 }
+%struct.point403 = type { i16, i32, i16, i8, i16 }
+%struct.point404 = type { i16, i32, i16, i8, i32 }
+%struct.point405 = type { i16, i32, i16, i8, float }
+%struct.point406 = type { i16, i32, i16, i16 }
+%struct.point407 = type { i16, i32, i16, i16, i8 }
+%struct.point408 = type { i16, i32, i16, i16, i16 }
+%struct.point409 = type { i16, i32, i16, i16, i32 }
+%struct.point410 = type { i16, i32, i16, i16, float }
+%struct.point411 = type { i16, i32, i16, i32 }
+%struct.point412 = type { i16, i32, i16, i32, i8 }
+%struct.point413 = type { i16, i32, i16, i32, i16 }
+%struct.point414 = type { i16, i32, i16, i32, i32 }
+%struct.point415 = type { i16, i32, i16, i32, float }
+%struct.point416 = type { i16, i32, i16, float }
+%struct.point417 = type { i16, i32, i16, float, i8 }
+%struct.point418 = type { i16, i32, i16, float, i16 }
+%struct.point419 = type { i16, i32, i16, float, i32 }
+%struct.point420 = type { i16, i32, i16, float, float }
+%struct.point421 = type { i16, i32, i32 }
+%struct.point422 = type { i16, i32, i32, i8 }
+%struct.point423 = type { i16, i32, i32, i8, i8 }
+%struct.point424 = type { i16, i32, i32, i8, i16 }
+%struct.point425 = type { i16, i32, i32, i8, i32 }
+%struct.point426 = type { i16, i32, i32, i8, float }
+%struct.point427 = type { i16, i32, i32, i16 }
+%struct.point428 = type { i16, i32, i32, i16, i8 }
+%struct.point429 = type { i16, i32, i32, i16, i16 }
+%struct.point430 = type { i16, i32, i32, i16, i32 }
+%struct.point431 = type { i16, i32, i32, i16, float }
+%struct.point432 = type { i16, i32, i32, i32 }
+%struct.point433 = type { i16, i32, i32, i32, i8 }
+%struct.point434 = type { i16, i32, i32, i32, i16 }
+%struct.point435 = type { i16, i32, i32, i32, i32 }
+%struct.point436 = type { i16, i32, i32, i32, float }
+%struct.point437 = type { i16, i32, i32, float }
+%struct.point438 = type { i16, i32, i32, float, i8 }
+%struct.point439 = type { i16, i32, i32, float, i16 }
+%struct.point440 = type { i16, i32, i32, float, i32 }
+%struct.point441 = type { i16, i32, i32, float, float }
+%struct.point442 = type { i16, i32, float }
+%struct.point443 = type { i16, i32, float, i8 }
+%struct.point444 = type { i16, i32, float, i8, i8 }
+%struct.point445 = type { i16, i32, float, i8, i16 }
+%struct.point446 = type { i16, i32, float, i8, i32 }
+%struct.point447 = type { i16, i32, float, i8, float }
+%struct.point448 = type { i16, i32, float, i16 }
+%struct.point449 = type { i16, i32, float, i16, i8 }
+%struct.point450 = type { i16, i32, float, i16, i16 }
+%struct.point451 = type { i16, i32, float, i16, i32 }
+%struct.point452 = type { i16, i32, float, i16, float }
+%struct.point453 = type { i16, i32, float, i32 }
+%struct.point454 = type { i16, i32, float, i32, i8 }
+%struct.point455 = type { i16, i32, float, i32, i16 }
+%struct.point456 = type { i16, i32, float, i32, i32 }
+%struct.point457 = type { i16, i32, float, i32, float }
+%struct.point458 = type { i16, i32, float, float }
+%struct.point459 = type { i16, i32, float, float, i8 }
+%struct.point460 = type { i16, i32, float, float, i16 }
+%struct.point461 = type { i16, i32, float, float, i32 }
+%struct.point462 = type { i16, i32, float, float, float }
+%struct.point463 = type { i16, float, i8 }
+%struct.point464 = type { i16, float, i8, i8 }
+%struct.point465 = type { i16, float, i8, i8, i8 }
+%struct.point466 = type { i16, float, i8, i8, i16 }
+%struct.point467 = type { i16, float, i8, i8, i32 }
+%struct.point468 = type { i16, float, i8, i8, float }
+%struct.point469 = type { i16, float, i8, i16 }
+%struct.point470 = type { i16, float, i8, i16, i8 }
+%struct.point471 = type { i16, float, i8, i16, i16 }
+%struct.point472 = type { i16, float, i8, i16, i32 }
+%struct.point473 = type { i16, float, i8, i16, float }
+%struct.point474 = type { i16, float, i8, i32 }
+%struct.point475 = type { i16, float, i8, i32, i8 }
+%struct.point476 = type { i16, float, i8, i32, i16 }
+%struct.point477 = type { i16, float, i8, i32, i32 }
+%struct.point478 = type { i16, float, i8, i32, float }
+%struct.point479 = type { i16, float, i8, float }
+%struct.point480 = type { i16, float, i8, float, i8 }
+%struct.point481 = type { i16, float, i8, float, i16 }
+%struct.point482 = type { i16, float, i8, float, i32 }
+%struct.point483 = type { i16, float, i8, float, float }
+%struct.point484 = type { i16, float, i16 }
+%struct.point485 = type { i16, float, i16, i8 }
+%struct.point486 = type { i16, float, i16, i8, i8 }
+%struct.point487 = type { i16, float, i16, i8, i16 }
+%struct.point488 = type { i16, float, i16, i8, i32 }
+%struct.point489 = type { i16, float, i16, i8, float }
+%struct.point490 = type { i16, float, i16, i16 }
+%struct.point491 = type { i16, float, i16, i16, i8 }
+%struct.point492 = type { i16, float, i16, i16, i16 }
+%struct.point493 = type { i16, float, i16, i16, i32 }
+%struct.point494 = type { i16, float, i16, i16, float }
+%struct.point495 = type { i16, float, i16, i32 }
+%struct.point496 = type { i16, float, i16, i32, i8 }
+%struct.point497 = type { i16, float, i16, i32, i16 }
+%struct.point498 = type { i16, float, i16, i32, i32 }
+%struct.point499 = type { i16, float, i16, i32, float }
+%struct.point500 = type { i16, float, i16, float }
+%struct.point501 = type { i16, float, i16, float, i8 }
+%struct.point502 = type { i16, float, i16, float, i16 }
+%struct.point503 = type { i16, float, i16, float, i32 }
+%struct.point504 = type { i16, float, i16, float, float }
+%struct.point505 = type { i16, float, i32 }
+%struct.point506 = type { i16, float, i32, i8 }
+%struct.point507 = type { i16, float, i32, i8, i8 }
+%struct.point508 = type { i16, float, i32, i8, i16 }
+%struct.point509 = type { i16, float, i32, i8, i32 }
+%struct.point510 = type { i16, float, i32, i8, float }
+%struct.point511 = type { i16, float, i32, i16 }
+%struct.point512 = type { i16, float, i32, i16, i8 }
+%struct.point513 = type { i16, float, i32, i16, i16 }
+%struct.point514 = type { i16, float, i32, i16, i32 }
+%struct.point515 = type { i16, float, i32, i16, float }
+%struct.point516 = type { i16, float, i32, i32 }
+%struct.point517 = type { i16, float, i32, i32, i8 }
+%struct.point518 = type { i16, float, i32, i32, i16 }
+%struct.point519 = type { i16, float, i32, i32, i32 }
+%struct.point520 = type { i16, float, i32, i32, float }
+%struct.point521 = type { i16, float, i32, float }
+%struct.point522 = type { i16, float, i32, float, i8 }
+%struct.point523 = type { i16, float, i32, float, i16 }
+%struct.point524 = type { i16, float, i32, float, i32 }
+%struct.point525 = type { i16, float, i32, float, float }
+%struct.point526 = type { i16, float, float }
+%struct.point527 = type { i16, float, float, i8 }
+%struct.point528 = type { i16, float, float, i8, i8 }
+%struct.point529 = type { i16, float, float, i8, i16 }
+%struct.point530 = type { i16, float, float, i8, i32 }
+%struct.point531 = type { i16, float, float, i8, float }
+%struct.point532 = type { i16, float, float, i16 }
+%struct.point533 = type { i16, float, float, i16, i8 }
+%struct.point534 = type { i16, float, float, i16, i16 }
+%struct.point535 = type { i16, float, float, i16, i32 }
+%struct.point536 = type { i16, float, float, i16, float }
+%struct.point537 = type { i16, float, float, i32 }
+%struct.point538 = type { i16, float, float, i32, i8 }
+%struct.point539 = type { i16, float, float, i32, i16 }
+%struct.point540 = type { i16, float, float, i32, i32 }
+%struct.point541 = type { i16, float, float, i32, float }
+%struct.point542 = type { i16, float, float, float }
+%struct.point543 = type { i16, float, float, float, i8 }
+%struct.point544 = type { i16, float, float, float, i16 }
+%struct.point545 = type { i16, float, float, float, i32 }
+%struct.point546 = type { i16, float, float, float, float }
+%struct.point547 = type { i32, i8, i8 }
+%struct.point548 = type { i32, i8, i8, i8 }
+%struct.point549 = type { i32, i8, i8, i8, i8 }
+%struct.point550 = type { i32, i8, i8, i8, i16 }
+%struct.point551 = type { i32, i8, i8, i8, i32 }
+%struct.point552 = type { i32, i8, i8, i8, float }
+%struct.point553 = type { i32, i8, i8, i16 }
+%struct.point554 = type { i32, i8, i8, i16, i8 }
+%struct.point555 = type { i32, i8, i8, i16, i16 }
+%struct.point556 = type { i32, i8, i8, i16, i32 }
+%struct.point557 = type { i32, i8, i8, i16, float }
+%struct.point558 = type { i32, i8, i8, i32 }
+%struct.point559 = type { i32, i8, i8, i32, i8 }
+%struct.point560 = type { i32, i8, i8, i32, i16 }
+%struct.point561 = type { i32, i8, i8, i32, i32 }
+%struct.point562 = type { i32, i8, i8, i32, float }
+%struct.point563 = type { i32, i8, i8, float }
+%struct.point564 = type { i32, i8, i8, float, i8 }
+%struct.point565 = type { i32, i8, i8, float, i16 }
+%struct.point566 = type { i32, i8, i8, float, i32 }
+%struct.point567 = type { i32, i8, i8, float, float }
+%struct.point568 = type { i32, i8, i16 }
+%struct.point569 = type { i32, i8, i16, i8 }
+%struct.point570 = type { i32, i8, i16, i8, i8 }
+%struct.point571 = type { i32, i8, i16, i8, i16 }
+%struct.point572 = type { i32, i8, i16, i8, i32 }
+%struct.point573 = type { i32, i8, i16, i8, float }
+%struct.point574 = type { i32, i8, i16, i16 }
+%struct.point575 = type { i32, i8, i16, i16, i8 }
+%struct.point576 = type { i32, i8, i16, i16, i16 }
+%struct.point577 = type { i32, i8, i16, i16, i32 }
+%struct.point578 = type { i32, i8, i16, i16, float }
+%struct.point579 = type { i32, i8, i16, i32 }
+%struct.point580 = type { i32, i8, i16, i32, i8 }
+%struct.point581 = type { i32, i8, i16, i32, i16 }
+%struct.point582 = type { i32, i8, i16, i32, i32 }
+%struct.point583 = type { i32, i8, i16, i32, float }
+%struct.point584 = type { i32, i8, i16, float }
+%struct.point585 = type { i32, i8, i16, float, i8 }
+%struct.point586 = type { i32, i8, i16, float, i16 }
+%struct.point587 = type { i32, i8, i16, float, i32 }
+%struct.point588 = type { i32, i8, i16, float, float }
+%struct.point589 = type { i32, i8, i32 }
+%struct.point590 = type { i32, i8, i32, i8 }
+%struct.point591 = type { i32, i8, i32, i8, i8 }
+%struct.point592 = type { i32, i8, i32, i8, i16 }
+%struct.point593 = type { i32, i8, i32, i8, i32 }
+%struct.point594 = type { i32, i8, i32, i8, float }
+%struct.point595 = type { i32, i8, i32, i16 }
+%struct.point596 = type { i32, i8, i32, i16, i8 }
+%struct.point597 = type { i32, i8, i32, i16, i16 }
+%struct.point598 = type { i32, i8, i32, i16, i32 }
+%struct.point599 = type { i32, i8, i32, i16, float }
+%struct.point600 = type { i32, i8, i32, i32 }
+%struct.point601 = type { i32, i8, i32, i32, i8 }
+%struct.point602 = type { i32, i8, i32, i32, i16 }
+%struct.point603 = type { i32, i8, i32, i32, i32 }
+%struct.point604 = type { i32, i8, i32, i32, float }
+%struct.point605 = type { i32, i8, i32, float }
+%struct.point606 = type { i32, i8, i32, float, i8 }
+%struct.point607 = type { i32, i8, i32, float, i16 }
+%struct.point608 = type { i32, i8, i32, float, i32 }
+%struct.point609 = type { i32, i8, i32, float, float }
+%struct.point610 = type { i32, i8, float }
+%struct.point611 = type { i32, i8, float, i8 }
+%struct.point612 = type { i32, i8, float, i8, i8 }
+%struct.point613 = type { i32, i8, float, i8, i16 }
+%struct.point614 = type { i32, i8, float, i8, i32 }
+%struct.point615 = type { i32, i8, float, i8, float }
+%struct.point616 = type { i32, i8, float, i16 }
+%struct.point617 = type { i32, i8, float, i16, i8 }
+%struct.point618 = type { i32, i8, float, i16, i16 }
+%struct.point619 = type { i32, i8, float, i16, i32 }
+%struct.point620 = type { i32, i8, float, i16, float }
+%struct.point621 = type { i32, i8, float, i32 }
+%struct.point622 = type { i32, i8, float, i32, i8 }
+%struct.point623 = type { i32, i8, float, i32, i16 }
+%struct.point624 = type { i32, i8, float, i32, i32 }
+%struct.point625 = type { i32, i8, float, i32, float }
+%struct.point626 = type { i32, i8, float, float }
+%struct.point627 = type { i32, i8, float, float, i8 }
+%struct.point628 = type { i32, i8, float, float, i16 }
+%struct.point629 = type { i32, i8, float, float, i32 }
+%struct.point630 = type { i32, i8, float, float, float }
+%struct.point631 = type { i32, i16, i8 }
+%struct.point632 = type { i32, i16, i8, i8 }
+%struct.point633 = type { i32, i16, i8, i8, i8 }
+%struct.point634 = type { i32, i16, i8, i8, i16 }
+%struct.point635 = type { i32, i16, i8, i8, i32 }
+%struct.point636 = type { i32, i16, i8, i8, float }
+%struct.point637 = type { i32, i16, i8, i16 }
+%struct.point638 = type { i32, i16, i8, i16, i8 }
+%struct.point639 = type { i32, i16, i8, i16, i16 }
+%struct.point640 = type { i32, i16, i8, i16, i32 }
+%struct.point641 = type { i32, i16, i8, i16, float }
+%struct.point642 = type { i32, i16, i8, i32 }
+%struct.point643 = type { i32, i16, i8, i32, i8 }
+%struct.point644 = type { i32, i16, i8, i32, i16 }
+%struct.point645 = type { i32, i16, i8, i32, i32 }
+%struct.point646 = type { i32, i16, i8, i32, float }
+%struct.point647 = type { i32, i16, i8, float }
+%struct.point648 = type { i32, i16, i8, float, i8 }
+%struct.point649 = type { i32, i16, i8, float, i16 }
+%struct.point650 = type { i32, i16, i8, float, i32 }
+%struct.point651 = type { i32, i16, i8, float, float }
+%struct.point652 = type { i32, i16, i16 }
+%struct.point653 = type { i32, i16, i16, i8 }
+%struct.point654 = type { i32, i16, i16, i8, i8 }
+%struct.point655 = type { i32, i16, i16, i8, i16 }
+%struct.point656 = type { i32, i16, i16, i8, i32 }
+%struct.point657 = type { i32, i16, i16, i8, float }
+%struct.point658 = type { i32, i16, i16, i16 }
+%struct.point659 = type { i32, i16, i16, i16, i8 }
+%struct.point660 = type { i32, i16, i16, i16, i16 }
+%struct.point661 = type { i32, i16, i16, i16, i32 }
+%struct.point662 = type { i32, i16, i16, i16, float }
+%struct.point663 = type { i32, i16, i16, i32 }
+%struct.point664 = type { i32, i16, i16, i32, i8 }
+%struct.point665 = type { i32, i16, i16, i32, i16 }
+%struct.point666 = type { i32, i16, i16, i32, i32 }
+%struct.point667 = type { i32, i16, i16, i32, float }
+%struct.point668 = type { i32, i16, i16, float }
+%struct.point669 = type { i32, i16, i16, float, i8 }
+%struct.point670 = type { i32, i16, i16, float, i16 }
+%struct.point671 = type { i32, i16, i16, float, i32 }
+%struct.point672 = type { i32, i16, i16, float, float }
+%struct.point673 = type { i32, i16, i32 }
+%struct.point674 = type { i32, i16, i32, i8 }
+%struct.point675 = type { i32, i16, i32, i8, i8 }
+%struct.point676 = type { i32, i16, i32, i8, i16 }
+%struct.point677 = type { i32, i16, i32, i8, i32 }
+%struct.point678 = type { i32, i16, i32, i8, float }
+%struct.point679 = type { i32, i16, i32, i16 }
+%struct.point680 = type { i32, i16, i32, i16, i8 }
+%struct.point681 = type { i32, i16, i32, i16, i16 }
+%struct.point682 = type { i32, i16, i32, i16, i32 }
+%struct.point683 = type { i32, i16, i32, i16, float }
+%struct.point684 = type { i32, i16, i32, i32 }
+%struct.point685 = type { i32, i16, i32, i32, i8 }
+%struct.point686 = type { i32, i16, i32, i32, i16 }
+%struct.point687 = type { i32, i16, i32, i32, i32 }
+%struct.point688 = type { i32, i16, i32, i32, float }
+%struct.point689 = type { i32, i16, i32, float }
+%struct.point690 = type { i32, i16, i32, float, i8 }
+%struct.point691 = type { i32, i16, i32, float, i16 }
+%struct.point692 = type { i32, i16, i32, float, i32 }
+%struct.point693 = type { i32, i16, i32, float, float }
+%struct.point694 = type { i32, i16, float }
+%struct.point695 = type { i32, i16, float, i8 }
+%struct.point696 = type { i32, i16, float, i8, i8 }
+%struct.point697 = type { i32, i16, float, i8, i16 }
+%struct.point698 = type { i32, i16, float, i8, i32 }
+%struct.point699 = type { i32, i16, float, i8, float }
+%struct.point700 = type { i32, i16, float, i16 }
+%struct.point701 = type { i32, i16, float, i16, i8 }
+%struct.point702 = type { i32, i16, float, i16, i16 }
+%struct.point703 = type { i32, i16, float, i16, i32 }
+%struct.point704 = type { i32, i16, float, i16, float }
+%struct.point705 = type { i32, i16, float, i32 }
+%struct.point706 = type { i32, i16, float, i32, i8 }
+%struct.point707 = type { i32, i16, float, i32, i16 }
+%struct.point708 = type { i32, i16, float, i32, i32 }
+%struct.point709 = type { i32, i16, float, i32, float }
+%struct.point710 = type { i32, i16, float, float }
+%struct.point711 = type { i32, i16, float, float, i8 }
+%struct.point712 = type { i32, i16, float, float, i16 }
+%struct.point713 = type { i32, i16, float, float, i32 }
+%struct.point714 = type { i32, i16, float, float, float }
+%struct.point715 = type { i32, float, i8 }
+%struct.point716 = type { i32, float, i8, i8 }
+%struct.point717 = type { i32, float, i8, i8, i8 }
+%struct.point718 = type { i32, float, i8, i8, i16 }
+%struct.point719 = type { i32, float, i8, i8, i32 }
+%struct.point720 = type { i32, float, i8, i8, float }
+%struct.point721 = type { i32, float, i8, i16 }
+%struct.point722 = type { i32, float, i8, i16, i8 }
+%struct.point723 = type { i32, float, i8, i16, i16 }
+%struct.point724 = type { i32, float, i8, i16, i32 }
+%struct.point725 = type { i32, float, i8, i16, float }
+%struct.point726 = type { i32, float, i8, i32 }
+%struct.point727 = type { i32, float, i8, i32, i8 }
+%struct.point728 = type { i32, float, i8, i32, i16 }
+%struct.point729 = type { i32, float, i8, i32, i32 }
+%struct.point730 = type { i32, float, i8, i32, float }
+%struct.point731 = type { i32, float, i8, float }
+%struct.point732 = type { i32, float, i8, float, i8 }
+%struct.point733 = type { i32, float, i8, float, i16 }
+%struct.point734 = type { i32, float, i8, float, i32 }
+%struct.point735 = type { i32, float, i8, float, float }
+%struct.point736 = type { i32, float, i16 }
+%struct.point737 = type { i32, float, i16, i8 }
+%struct.point738 = type { i32, float, i16, i8, i8 }
+%struct.point739 = type { i32, float, i16, i8, i16 }
+%struct.point740 = type { i32, float, i16, i8, i32 }
+%struct.point741 = type { i32, float, i16, i8, float }
+%struct.point742 = type { i32, float, i16, i16 }
+%struct.point743 = type { i32, float, i16, i16, i8 }
+%struct.point744 = type { i32, float, i16, i16, i16 }
+%struct.point745 = type { i32, float, i16, i16, i32 }
+%struct.point746 = type { i32, float, i16, i16, float }
+%struct.point747 = type { i32, float, i16, i32 }
+%struct.point748 = type { i32, float, i16, i32, i8 }
+%struct.point749 = type { i32, float, i16, i32, i16 }
+%struct.point750 = type { i32, float, i16, i32, i32 }
+%struct.point751 = type { i32, float, i16, i32, float }
+%struct.point752 = type { i32, float, i16, float }
+%struct.point753 = type { i32, float, i16, float, i8 }
+%struct.point754 = type { i32, float, i16, float, i16 }
+%struct.point755 = type { i32, float, i16, float, i32 }
+%struct.point756 = type { i32, float, i16, float, float }
+%struct.point757 = type { i32, float, i32 }
+%struct.point758 = type { i32, float, i32, i8 }
+%struct.point759 = type { i32, float, i32, i8, i8 }
+%struct.point760 = type { i32, float, i32, i8, i16 }
+%struct.point761 = type { i32, float, i32, i8, i32 }
+%struct.point762 = type { i32, float, i32, i8, float }
+%struct.point763 = type { i32, float, i32, i16 }
+%struct.point764 = type { i32, float, i32, i16, i8 }
+%struct.point765 = type { i32, float, i32, i16, i16 }
+%struct.point766 = type { i32, float, i32, i16, i32 }
+%struct.point767 = type { i32, float, i32, i16, float }
+%struct.point768 = type { i32, float, i32, i32 }
+%struct.point769 = type { i32, float, i32, i32, i8 }
+%struct.point770 = type { i32, float, i32, i32, i16 }
+%struct.point771 = type { i32, float, i32, i32, i32 }
+%struct.point772 = type { i32, float, i32, i32, float }
+%struct.point773 = type { i32, float, i32, float }
+%struct.point774 = type { i32, float, i32, float, i8 }
+%struct.point775 = type { i32, float, i32, float, i16 }
+%struct.point776 = type { i32, float, i32, float, i32 }
+%struct.point777 = type { i32, float, i32, float, float }
+%struct.point778 = type { i32, float, float }
+%struct.point779 = type { i32, float, float, i8 }
+%struct.point780 = type { i32, float, float, i8, i8 }
+%struct.point781 = type { i32, float, float, i8, i16 }
+%struct.point782 = type { i32, float, float, i8, i32 }
+%struct.point783 = type { i32, float, float, i8, float }
+%struct.point784 = type { i32, float, float, i16 }
+%struct.point785 = type { i32, float, float, i16, i8 }
+%struct.point786 = type { i32, float, float, i16, i16 }
+%struct.point787 = type { i32, float, float, i16, i32 }
+%struct.point788 = type { i32, float, float, i16, float }
+%struct.point789 = type { i32, float, float, i32 }
+%struct.point790 = type { i32, float, float, i32, i8 }
+%struct.point791 = type { i32, float, float, i32, i16 }
+%struct.point792 = type { i32, float, float, i32, i32 }
+%struct.point793 = type { i32, float, float, i32, float }
+%struct.point794 = type { i32, float, float, float }
+%struct.point795 = type { i32, float, float, float, i8 }
+%struct.point796 = type { i32, float, float, float, i16 }
+%struct.point797 = type { i32, float, float, float, i32 }
+%struct.point798 = type { i32, float, float, float, float }
+%struct.point799 = type { float, i8, i8 }
+%struct.point800 = type { float, i8, i8, i8 }
+%struct.point801 = type { float, i8, i8, i8, i8 }
+%struct.point802 = type { float, i8, i8, i8, i16 }
+%struct.point803 = type { float, i8, i8, i8, i32 }
+%struct.point804 = type { float, i8, i8, i8, float }
+%struct.point805 = type { float, i8, i8, i16 }
+%struct.point806 = type { float, i8, i8, i16, i8 }
+%struct.point807 = type { float, i8, i8, i16, i16 }
+%struct.point808 = type { float, i8, i8, i16, i32 }
+%struct.point809 = type { float, i8, i8, i16, float }
+%struct.point810 = type { float, i8, i8, i32 }
+%struct.point811 = type { float, i8, i8, i32, i8 }
+%struct.point812 = type { float, i8, i8, i32, i16 }
+%struct.point813 = type { float, i8, i8, i32, i32 }
+%struct.point814 = type { float, i8, i8, i32, float }
+%struct.point815 = type { float, i8, i8, float }
+%struct.point816 = type { float, i8, i8, float, i8 }
+%struct.point817 = type { float, i8, i8, float, i16 }
+%struct.point818 = type { float, i8, i8, float, i32 }
+%struct.point819 = type { float, i8, i8, float, float }
+%struct.point820 = type { float, i8, i16 }
+%struct.point821 = type { float, i8, i16, i8 }
+%struct.point822 = type { float, i8, i16, i8, i8 }
+%struct.point823 = type { float, i8, i16, i8, i16 }
+%struct.point824 = type { float, i8, i16, i8, i32 }
+%struct.point825 = type { float, i8, i16, i8, float }
+%struct.point826 = type { float, i8, i16, i16 }
+%struct.point827 = type { float, i8, i16, i16, i8 }
+%struct.point828 = type { float, i8, i16, i16, i16 }
+%struct.point829 = type { float, i8, i16, i16, i32 }
+%struct.point830 = type { float, i8, i16, i16, float }
+%struct.point831 = type { float, i8, i16, i32 }
+%struct.point832 = type { float, i8, i16, i32, i8 }
+%struct.point833 = type { float, i8, i16, i32, i16 }
+%struct.point834 = type { float, i8, i16, i32, i32 }
+%struct.point835 = type { float, i8, i16, i32, float }
+%struct.point836 = type { float, i8, i16, float }
+%struct.point837 = type { float, i8, i16, float, i8 }
+%struct.point838 = type { float, i8, i16, float, i16 }
+%struct.point839 = type { float, i8, i16, float, i32 }
+%struct.point840 = type { float, i8, i16, float, float }
+%struct.point841 = type { float, i8, i32 }
+%struct.point842 = type { float, i8, i32, i8 }
+%struct.point843 = type { float, i8, i32, i8, i8 }
+%struct.point844 = type { float, i8, i32, i8, i16 }
+%struct.point845 = type { float, i8, i32, i8, i32 }
+%struct.point846 = type { float, i8, i32, i8, float }
+%struct.point847 = type { float, i8, i32, i16 }
+%struct.point848 = type { float, i8, i32, i16, i8 }
+%struct.point849 = type { float, i8, i32, i16, i16 }
+%struct.point850 = type { float, i8, i32, i16, i32 }
+%struct.point851 = type { float, i8, i32, i16, float }
+%struct.point852 = type { float, i8, i32, i32 }
+%struct.point853 = type { float, i8, i32, i32, i8 }
+%struct.point854 = type { float, i8, i32, i32, i16 }
+%struct.point855 = type { float, i8, i32, i32, i32 }
+%struct.point856 = type { float, i8, i32, i32, float }
+%struct.point857 = type { float, i8, i32, float }
+%struct.point858 = type { float, i8, i32, float, i8 }
+%struct.point859 = type { float, i8, i32, float, i16 }
+%struct.point860 = type { float, i8, i32, float, i32 }
+%struct.point861 = type { float, i8, i32, float, float }
+%struct.point862 = type { float, i8, float }
+%struct.point863 = type { float, i8, float, i8 }
+%struct.point864 = type { float, i8, float, i8, i8 }
+%struct.point865 = type { float, i8, float, i8, i16 }
+%struct.point866 = type { float, i8, float, i8, i32 }
+%struct.point867 = type { float, i8, float, i8, float }
+%struct.point868 = type { float, i8, float, i16 }
+%struct.point869 = type { float, i8, float, i16, i8 }
+%struct.point870 = type { float, i8, float, i16, i16 }
+%struct.point871 = type { float, i8, float, i16, i32 }
+%struct.point872 = type { float, i8, float, i16, float }
+%struct.point873 = type { float, i8, float, i32 }
+%struct.point874 = type { float, i8, float, i32, i8 }
+%struct.point875 = type { float, i8, float, i32, i16 }
+%struct.point876 = type { float, i8, float, i32, i32 }
+%struct.point877 = type { float, i8, float, i32, float }
+%struct.point878 = type { float, i8, float, float }
+%struct.point879 = type { float, i8, float, float, i8 }
+%struct.point880 = type { float, i8, float, float, i16 }
+%struct.point881 = type { float, i8, float, float, i32 }
+%struct.point882 = type { float, i8, float, float, float }
+%struct.point883 = type { float, i16, i8 }
+%struct.point884 = type { float, i16, i8, i8 }
+%struct.point885 = type { float, i16, i8, i8, i8 }
+%struct.point886 = type { float, i16, i8, i8, i16 }
+%struct.point887 = type { float, i16, i8, i8, i32 }
+%struct.point888 = type { float, i16, i8, i8, float }
+%struct.point889 = type { float, i16, i8, i16 }
+%struct.point890 = type { float, i16, i8, i16, i8 }
+%struct.point891 = type { float, i16, i8, i16, i16 }
+%struct.point892 = type { float, i16, i8, i16, i32 }
+%struct.point893 = type { float, i16, i8, i16, float }
+%struct.point894 = type { float, i16, i8, i32 }
+%struct.point895 = type { float, i16, i8, i32, i8 }
+%struct.point896 = type { float, i16, i8, i32, i16 }
+%struct.point897 = type { float, i16, i8, i32, i32 }
+%struct.point898 = type { float, i16, i8, i32, float }
+%struct.point899 = type { float, i16, i8, float }
+%struct.point900 = type { float, i16, i8, float, i8 }
+%struct.point901 = type { float, i16, i8, float, i16 }
+%struct.point902 = type { float, i16, i8, float, i32 }
+%struct.point903 = type { float, i16, i8, float, float }
+%struct.point904 = type { float, i16, i16 }
+%struct.point905 = type { float, i16, i16, i8 }
+%struct.point906 = type { float, i16, i16, i8, i8 }
+%struct.point907 = type { float, i16, i16, i8, i16 }
+%struct.point908 = type { float, i16, i16, i8, i32 }
+%struct.point909 = type { float, i16, i16, i8, float }
+%struct.point910 = type { float, i16, i16, i16 }
+%struct.point911 = type { float, i16, i16, i16, i8 }
+%struct.point912 = type { float, i16, i16, i16, i16 }
+%struct.point913 = type { float, i16, i16, i16, i32 }
+%struct.point914 = type { float, i16, i16, i16, float }
+%struct.point915 = type { float, i16, i16, i32 }
+%struct.point916 = type { float, i16, i16, i32, i8 }
+%struct.point917 = type { float, i16, i16, i32, i16 }
+%struct.point918 = type { float, i16, i16, i32, i32 }
+%struct.point919 = type { float, i16, i16, i32, float }
+%struct.point920 = type { float, i16, i16, float }
+%struct.point921 = type { float, i16, i16, float, i8 }
+%struct.point922 = type { float, i16, i16, float, i16 }
+%struct.point923 = type { float, i16, i16, float, i32 }
+%struct.point924 = type { float, i16, i16, float, float }
+%struct.point925 = type { float, i16, i32 }
+%struct.point926 = type { float, i16, i32, i8 }
+%struct.point927 = type { float, i16, i32, i8, i8 }
+%struct.point928 = type { float, i16, i32, i8, i16 }
+%struct.point929 = type { float, i16, i32, i8, i32 }
+%struct.point930 = type { float, i16, i32, i8, float }
+%struct.point931 = type { float, i16, i32, i16 }
+%struct.point932 = type { float, i16, i32, i16, i8 }
+%struct.point933 = type { float, i16, i32, i16, i16 }
+%struct.point934 = type { float, i16, i32, i16, i32 }
+%struct.point935 = type { float, i16, i32, i16, float }
+%struct.point936 = type { float, i16, i32, i32 }
+%struct.point937 = type { float, i16, i32, i32, i8 }
+%struct.point938 = type { float, i16, i32, i32, i16 }
+%struct.point939 = type { float, i16, i32, i32, i32 }
+%struct.point940 = type { float, i16, i32, i32, float }
+%struct.point941 = type { float, i16, i32, float }
+%struct.point942 = type { float, i16, i32, float, i8 }
+%struct.point943 = type { float, i16, i32, float, i16 }
+%struct.point944 = type { float, i16, i32, float, i32 }
+%struct.point945 = type { float, i16, i32, float, float }
+%struct.point946 = type { float, i16, float }
+%struct.point947 = type { float, i16, float, i8 }
+%struct.point948 = type { float, i16, float, i8, i8 }
+%struct.point949 = type { float, i16, float, i8, i16 }
+%struct.point950 = type { float, i16, float, i8, i32 }
+%struct.point951 = type { float, i16, float, i8, float }
+%struct.point952 = type { float, i16, float, i16 }
+%struct.point953 = type { float, i16, float, i16, i8 }
+%struct.point954 = type { float, i16, float, i16, i16 }
+%struct.point955 = type { float, i16, float, i16, i32 }
+%struct.point956 = type { float, i16, float, i16, float }
+%struct.point957 = type { float, i16, float, i32 }
+%struct.point958 = type { float, i16, float, i32, i8 }
+%struct.point959 = type { float, i16, float, i32, i16 }
+%struct.point960 = type { float, i16, float, i32, i32 }
+%struct.point961 = type { float, i16, float, i32, float }
+%struct.point962 = type { float, i16, float, float }
+%struct.point963 = type { float, i16, float, float, i8 }
+%struct.point964 = type { float, i16, float, float, i16 }
+%struct.point965 = type { float, i16, float, float, i32 }
+%struct.point966 = type { float, i16, float, float, float }
+%struct.point967 = type { float, i32, i8 }
+%struct.point968 = type { float, i32, i8, i8 }
+%struct.point969 = type { float, i32, i8, i8, i8 }
+%struct.point970 = type { float, i32, i8, i8, i16 }
+%struct.point971 = type { float, i32, i8, i8, i32 }
+%struct.point972 = type { float, i32, i8, i8, float }
+%struct.point973 = type { float, i32, i8, i16 }
+%struct.point974 = type { float, i32, i8, i16, i8 }
+%struct.point975 = type { float, i32, i8, i16, i16 }
+%struct.point976 = type { float, i32, i8, i16, i32 }
+%struct.point977 = type { float, i32, i8, i16, float }
+%struct.point978 = type { float, i32, i8, i32 }
+%struct.point979 = type { float, i32, i8, i32, i8 }
+%struct.point980 = type { float, i32, i8, i32, i16 }
+%struct.point981 = type { float, i32, i8, i32, i32 }
+%struct.point982 = type { float, i32, i8, i32, float }
+%struct.point983 = type { float, i32, i8, float }
+%struct.point984 = type { float, i32, i8, float, i8 }
+%struct.point985 = type { float, i32, i8, float, i16 }
+%struct.point986 = type { float, i32, i8, float, i32 }
+%struct.point987 = type { float, i32, i8, float, float }
+%struct.point988 = type { float, i32, i16 }
+%struct.point989 = type { float, i32, i16, i8 }
+%struct.point990 = type { float, i32, i16, i8, i8 }
+%struct.point991 = type { float, i32, i16, i8, i16 }
+%struct.point992 = type { float, i32, i16, i8, i32 }
+%struct.point993 = type { float, i32, i16, i8, float }
+%struct.point994 = type { float, i32, i16, i16 }
+%struct.point995 = type { float, i32, i16, i16, i8 }
+%struct.point996 = type { float, i32, i16, i16, i16 }
+%struct.point997 = type { float, i32, i16, i16, i32 }
+%struct.point998 = type { float, i32, i16, i16, float }
+%struct.point999 = type { float, i32, i16, i32 }
+%struct.point1000 = type { float, i32, i16, i32, i8 }
+%struct.point1001 = type { float, i32, i16, i32, i16 }
+%struct.point1002 = type { float, i32, i16, i32, i32 }
+%struct.point1003 = type { float, i32, i16, i32, float }
+%struct.point1004 = type { float, i32, i16, float }
+%struct.point1005 = type { float, i32, i16, float, i8 }
+%struct.point1006 = type { float, i32, i16, float, i16 }
+%struct.point1007 = type { float, i32, i16, float, i32 }
+%struct.point1008 = type { float, i32, i16, float, float }
+%struct.point1009 = type { float, i32, i32 }
+%struct.point1010 = type { float, i32, i32, i8 }
+%struct.point1011 = type { float, i32, i32, i8, i8 }
+%struct.point1012 = type { float, i32, i32, i8, i16 }
+%struct.point1013 = type { float, i32, i32, i8, i32 }
+%struct.point1014 = type { float, i32, i32, i8, float }
+%struct.point1015 = type { float, i32, i32, i16 }
+%struct.point1016 = type { float, i32, i32, i16, i8 }
+%struct.point1017 = type { float, i32, i32, i16, i16 }
+%struct.point1018 = type { float, i32, i32, i16, i32 }
+%struct.point1019 = type { float, i32, i32, i16, float }
+%struct.point1020 = type { float, i32, i32, i32 }
+%struct.point1021 = type { float, i32, i32, i32, i8 }
+%struct.point1022 = type { float, i32, i32, i32, i16 }
+%struct.point1023 = type { float, i32, i32, i32, i32 }
+%struct.point1024 = type { float, i32, i32, i32, float }
+%struct.point1025 = type { float, i32, i32, float }
+%struct.point1026 = type { float, i32, i32, float, i8 }
+%struct.point1027 = type { float, i32, i32, float, i16 }
+%struct.point1028 = type { float, i32, i32, float, i32 }
+%struct.point1029 = type { float, i32, i32, float, float }
+%struct.point1030 = type { float, i32, float }
+%struct.point1031 = type { float, i32, float, i8 }
+%struct.point1032 = type { float, i32, float, i8, i8 }
+%struct.point1033 = type { float, i32, float, i8, i16 }
+%struct.point1034 = type { float, i32, float, i8, i32 }
+%struct.point1035 = type { float, i32, float, i8, float }
+%struct.point1036 = type { float, i32, float, i16 }
+%struct.point1037 = type { float, i32, float, i16, i8 }
+%struct.point1038 = type { float, i32, float, i16, i16 }
+%struct.point1039 = type { float, i32, float, i16, i32 }
+%struct.point1040 = type { float, i32, float, i16, float }
+%struct.point1041 = type { float, i32, float, i32 }
+%struct.point1042 = type { float, i32, float, i32, i8 }
+%struct.point1043 = type { float, i32, float, i32, i16 }
+%struct.point1044 = type { float, i32, float, i32, i32 }
+%struct.point1045 = type { float, i32, float, i32, float }
+%struct.point1046 = type { float, i32, float, float }
+%struct.point1047 = type { float, i32, float, float, i8 }
+%struct.point1048 = type { float, i32, float, float, i16 }
+%struct.point1049 = type { float, i32, float, float, i32 }
+%struct.point1050 = type { float, i32, float, float, float }
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point1 @demo1(i8 %0, i16 %1) #0 {
+  ret %struct.point1 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point2 @demo2(i8 %0, i32 %1) #0 {
+  ret %struct.point2 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo3(ptr noalias sret(%struct.point3) align 1 %0, i8 %1, i64 %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point4 @demo4(i8 %0, float %1) #0 {
+  ret %struct.point4 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo5(ptr noalias sret(%struct.point5) align 1 %0, i8 %1, double %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point6 @demo6(i8 %0, ptr %1) #0 {
+  ret %struct.point6 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point7 @demo7(i16 %0, i8 %1) #0 {
+  ret %struct.point7 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point8 @demo8(i16 %0, i32 %1) #0 {
+  ret %struct.point8 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo9(ptr noalias sret(%struct.point9) align 1 %0, i16 %1, i64 %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point10 @demo10(i16 %0, float %1) #0 {
+  ret %struct.point10 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo11(ptr noalias sret(%struct.point11) align 1 %0, i16 %1, double %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point12 @demo12(i16 %0, ptr %1) #0 {
+  ret %struct.point12 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point13 @demo13(i32 %0, i8 %1) #0 {
+  ret %struct.point13 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point14 @demo14(i32 %0, i16 %1) #0 {
+  ret %struct.point14 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo15(ptr noalias sret(%struct.point15) align 1 %0, i32 %1, i64 %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point16 @demo16(i32 %0, float %1) #0 {
+  ret %struct.point16 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo17(ptr noalias sret(%struct.point17) align 1 %0, i32 %1, double %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point18 @demo18(i32 %0, ptr %1) #0 {
+  ret %struct.point18 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo19(ptr noalias sret(%struct.point19) align 1 %0, i64 %1, i8 %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo20(ptr noalias sret(%struct.point20) align 1 %0, i64 %1, i16 %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo21(ptr noalias sret(%struct.point21) align 1 %0, i64 %1, i32 %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo22(ptr noalias sret(%struct.point22) align 1 %0, i64 %1, float %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo23(ptr noalias sret(%struct.point23) align 1 %0, i64 %1, double %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo24(ptr noalias sret(%struct.point24) align 1 %0, i64 %1, ptr %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point25 @demo25(float %0, i8 %1) #0 {
+  ret %struct.point25 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point26 @demo26(float %0, i16 %1) #0 {
+  ret %struct.point26 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point27 @demo27(float %0, i32 %1) #0 {
+  ret %struct.point27 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo28(ptr noalias sret(%struct.point28) align 1 %0, float %1, i64 %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo29(ptr noalias sret(%struct.point29) align 1 %0, float %1, double %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point30 @demo30(float %0, ptr %1) #0 {
+  ret %struct.point30 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo31(ptr noalias sret(%struct.point31) align 1 %0, double %1, i8 %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo32(ptr noalias sret(%struct.point32) align 1 %0, double %1, i16 %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo33(ptr noalias sret(%struct.point33) align 1 %0, double %1, i32 %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo34(ptr noalias sret(%struct.point34) align 1 %0, double %1, i64 %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo35(ptr noalias sret(%struct.point35) align 1 %0, double %1, float %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo36(ptr noalias sret(%struct.point36) align 1 %0, double %1, ptr %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point37 @demo37(ptr %0, i8 %1) #0 {
+  ret %struct.point37 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point38 @demo38(ptr %0, i16 %1) #0 {
+  ret %struct.point38 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point39 @demo39(ptr %0, i32 %1) #0 {
+  ret %struct.point39 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo40(ptr noalias sret(%struct.point40) align 1 %0, ptr %1, i64 %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point41 @demo41(ptr %0, float %1) #0 {
+  ret %struct.point41 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo42(ptr noalias sret(%struct.point42) align 1 %0, ptr %1, double %2) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point43 @demo43(i8 %0, i16 %1, i8 %2) #0 {
+  ret %struct.point43 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point44 @demo44(i8 %0, i16 %1, i8 %2, i8 %3) #0 {
+  ret %struct.point44 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point45 @demo45(i8 %0, i16 %1, i8 %2, i8 %3, i8 %4) #0 {
+  ret %struct.point45 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point46 @demo46(i8 %0, i16 %1, i8 %2, i8 %3, i16 %4) #0 {
+  ret %struct.point46 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo47(ptr noalias sret(%struct.point47) align 1 %0, i8 %1, i16 %2, i8 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo48(ptr noalias sret(%struct.point48) align 1 %0, i8 %1, i16 %2, i8 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point49 @demo49(i8 %0, i16 %1, i8 %2, i16 %3) #0 {
+  ret %struct.point49 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point50 @demo50(i8 %0, i16 %1, i8 %2, i16 %3, i8 %4) #0 {
+  ret %struct.point50 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point51 @demo51(i8 %0, i16 %1, i8 %2, i16 %3, i16 %4) #0 {
+  ret %struct.point51 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo52(ptr noalias sret(%struct.point52) align 1 %0, i8 %1, i16 %2, i8 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo53(ptr noalias sret(%struct.point53) align 1 %0, i8 %1, i16 %2, i8 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point54 @demo54(i8 %0, i16 %1, i8 %2, i32 %3) #0 {
+  ret %struct.point54 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo55(ptr noalias sret(%struct.point55) align 1 %0, i8 %1, i16 %2, i8 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo56(ptr noalias sret(%struct.point56) align 1 %0, i8 %1, i16 %2, i8 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo57(ptr noalias sret(%struct.point57) align 1 %0, i8 %1, i16 %2, i8 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo58(ptr noalias sret(%struct.point58) align 1 %0, i8 %1, i16 %2, i8 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point59 @demo59(i8 %0, i16 %1, i8 %2, float %3) #0 {
+  ret %struct.point59 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo60(ptr noalias sret(%struct.point60) align 1 %0, i8 %1, i16 %2, i8 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo61(ptr noalias sret(%struct.point61) align 1 %0, i8 %1, i16 %2, i8 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo62(ptr noalias sret(%struct.point62) align 1 %0, i8 %1, i16 %2, i8 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo63(ptr noalias sret(%struct.point63) align 1 %0, i8 %1, i16 %2, i8 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point64 @demo64(i8 %0, i16 %1, i16 %2) #0 {
+  ret %struct.point64 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point65 @demo65(i8 %0, i16 %1, i16 %2, i8 %3) #0 {
+  ret %struct.point65 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point66 @demo66(i8 %0, i16 %1, i16 %2, i8 %3, i8 %4) #0 {
+  ret %struct.point66 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point67 @demo67(i8 %0, i16 %1, i16 %2, i8 %3, i16 %4) #0 {
+  ret %struct.point67 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo68(ptr noalias sret(%struct.point68) align 1 %0, i8 %1, i16 %2, i16 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo69(ptr noalias sret(%struct.point69) align 1 %0, i8 %1, i16 %2, i16 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point70 @demo70(i8 %0, i16 %1, i16 %2, i16 %3) #0 {
+  ret %struct.point70 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point71 @demo71(i8 %0, i16 %1, i16 %2, i16 %3, i8 %4) #0 {
+  ret %struct.point71 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo72(ptr noalias sret(%struct.point72) align 1 %0, i8 %1, i16 %2, i16 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo73(ptr noalias sret(%struct.point73) align 1 %0, i8 %1, i16 %2, i16 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo74(ptr noalias sret(%struct.point74) align 1 %0, i8 %1, i16 %2, i16 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo75(ptr noalias sret(%struct.point75) align 1 %0, i8 %1, i16 %2, i16 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo76(ptr noalias sret(%struct.point76) align 1 %0, i8 %1, i16 %2, i16 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo77(ptr noalias sret(%struct.point77) align 1 %0, i8 %1, i16 %2, i16 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo78(ptr noalias sret(%struct.point78) align 1 %0, i8 %1, i16 %2, i16 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo79(ptr noalias sret(%struct.point79) align 1 %0, i8 %1, i16 %2, i16 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo80(ptr noalias sret(%struct.point80) align 1 %0, i8 %1, i16 %2, i16 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo81(ptr noalias sret(%struct.point81) align 1 %0, i8 %1, i16 %2, i16 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo82(ptr noalias sret(%struct.point82) align 1 %0, i8 %1, i16 %2, i16 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo83(ptr noalias sret(%struct.point83) align 1 %0, i8 %1, i16 %2, i16 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo84(ptr noalias sret(%struct.point84) align 1 %0, i8 %1, i16 %2, i16 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point85 @demo85(i8 %0, i16 %1, i32 %2) #0 {
+  ret %struct.point85 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point86 @demo86(i8 %0, i16 %1, i32 %2, i8 %3) #0 {
+  ret %struct.point86 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo87(ptr noalias sret(%struct.point87) align 1 %0, i8 %1, i16 %2, i32 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo88(ptr noalias sret(%struct.point88) align 1 %0, i8 %1, i16 %2, i32 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo89(ptr noalias sret(%struct.point89) align 1 %0, i8 %1, i16 %2, i32 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo90(ptr noalias sret(%struct.point90) align 1 %0, i8 %1, i16 %2, i32 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo91(ptr noalias sret(%struct.point91) align 1 %0, i8 %1, i16 %2, i32 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo92(ptr noalias sret(%struct.point92) align 1 %0, i8 %1, i16 %2, i32 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo93(ptr noalias sret(%struct.point93) align 1 %0, i8 %1, i16 %2, i32 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo94(ptr noalias sret(%struct.point94) align 1 %0, i8 %1, i16 %2, i32 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo95(ptr noalias sret(%struct.point95) align 1 %0, i8 %1, i16 %2, i32 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo96(ptr noalias sret(%struct.point96) align 1 %0, i8 %1, i16 %2, i32 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo97(ptr noalias sret(%struct.point97) align 1 %0, i8 %1, i16 %2, i32 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo98(ptr noalias sret(%struct.point98) align 1 %0, i8 %1, i16 %2, i32 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo99(ptr noalias sret(%struct.point99) align 1 %0, i8 %1, i16 %2, i32 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo100(ptr noalias sret(%struct.point100) align 1 %0, i8 %1, i16 %2, i32 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo101(ptr noalias sret(%struct.point101) align 1 %0, i8 %1, i16 %2, i32 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo102(ptr noalias sret(%struct.point102) align 1 %0, i8 %1, i16 %2, i32 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo103(ptr noalias sret(%struct.point103) align 1 %0, i8 %1, i16 %2, i32 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo104(ptr noalias sret(%struct.point104) align 1 %0, i8 %1, i16 %2, i32 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo105(ptr noalias sret(%struct.point105) align 1 %0, i8 %1, i16 %2, i32 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point106 @demo106(i8 %0, i16 %1, float %2) #0 {
+  ret %struct.point106 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point107 @demo107(i8 %0, i16 %1, float %2, i8 %3) #0 {
+  ret %struct.point107 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo108(ptr noalias sret(%struct.point108) align 1 %0, i8 %1, i16 %2, float %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo109(ptr noalias sret(%struct.point109) align 1 %0, i8 %1, i16 %2, float %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo110(ptr noalias sret(%struct.point110) align 1 %0, i8 %1, i16 %2, float %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo111(ptr noalias sret(%struct.point111) align 1 %0, i8 %1, i16 %2, float %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo112(ptr noalias sret(%struct.point112) align 1 %0, i8 %1, i16 %2, float %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo113(ptr noalias sret(%struct.point113) align 1 %0, i8 %1, i16 %2, float %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo114(ptr noalias sret(%struct.point114) align 1 %0, i8 %1, i16 %2, float %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo115(ptr noalias sret(%struct.point115) align 1 %0, i8 %1, i16 %2, float %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo116(ptr noalias sret(%struct.point116) align 1 %0, i8 %1, i16 %2, float %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo117(ptr noalias sret(%struct.point117) align 1 %0, i8 %1, i16 %2, float %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo118(ptr noalias sret(%struct.point118) align 1 %0, i8 %1, i16 %2, float %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo119(ptr noalias sret(%struct.point119) align 1 %0, i8 %1, i16 %2, float %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo120(ptr noalias sret(%struct.point120) align 1 %0, i8 %1, i16 %2, float %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo121(ptr noalias sret(%struct.point121) align 1 %0, i8 %1, i16 %2, float %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo122(ptr noalias sret(%struct.point122) align 1 %0, i8 %1, i16 %2, float %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo123(ptr noalias sret(%struct.point123) align 1 %0, i8 %1, i16 %2, float %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo124(ptr noalias sret(%struct.point124) align 1 %0, i8 %1, i16 %2, float %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo125(ptr noalias sret(%struct.point125) align 1 %0, i8 %1, i16 %2, float %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo126(ptr noalias sret(%struct.point126) align 1 %0, i8 %1, i16 %2, float %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point127 @demo127(i8 %0, i32 %1, i8 %2) #0 {
+  ret %struct.point127 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point128 @demo128(i8 %0, i32 %1, i8 %2, i8 %3) #0 {
+  ret %struct.point128 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point129 @demo129(i8 %0, i32 %1, i8 %2, i8 %3, i8 %4) #0 {
+  ret %struct.point129 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo130(ptr noalias sret(%struct.point130) align 1 %0, i8 %1, i32 %2, i8 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo131(ptr noalias sret(%struct.point131) align 1 %0, i8 %1, i32 %2, i8 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo132(ptr noalias sret(%struct.point132) align 1 %0, i8 %1, i32 %2, i8 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point133 @demo133(i8 %0, i32 %1, i8 %2, i16 %3) #0 {
+  ret %struct.point133 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo134(ptr noalias sret(%struct.point134) align 1 %0, i8 %1, i32 %2, i8 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo135(ptr noalias sret(%struct.point135) align 1 %0, i8 %1, i32 %2, i8 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo136(ptr noalias sret(%struct.point136) align 1 %0, i8 %1, i32 %2, i8 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo137(ptr noalias sret(%struct.point137) align 1 %0, i8 %1, i32 %2, i8 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo138(ptr noalias sret(%struct.point138) align 1 %0, i8 %1, i32 %2, i8 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo139(ptr noalias sret(%struct.point139) align 1 %0, i8 %1, i32 %2, i8 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo140(ptr noalias sret(%struct.point140) align 1 %0, i8 %1, i32 %2, i8 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo141(ptr noalias sret(%struct.point141) align 1 %0, i8 %1, i32 %2, i8 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo142(ptr noalias sret(%struct.point142) align 1 %0, i8 %1, i32 %2, i8 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo143(ptr noalias sret(%struct.point143) align 1 %0, i8 %1, i32 %2, i8 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo144(ptr noalias sret(%struct.point144) align 1 %0, i8 %1, i32 %2, i8 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo145(ptr noalias sret(%struct.point145) align 1 %0, i8 %1, i32 %2, i8 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo146(ptr noalias sret(%struct.point146) align 1 %0, i8 %1, i32 %2, i8 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo147(ptr noalias sret(%struct.point147) align 1 %0, i8 %1, i32 %2, i8 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point148 @demo148(i8 %0, i32 %1, i16 %2) #0 {
+  ret %struct.point148 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point149 @demo149(i8 %0, i32 %1, i16 %2, i8 %3) #0 {
+  ret %struct.point149 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo150(ptr noalias sret(%struct.point150) align 1 %0, i8 %1, i32 %2, i16 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo151(ptr noalias sret(%struct.point151) align 1 %0, i8 %1, i32 %2, i16 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo152(ptr noalias sret(%struct.point152) align 1 %0, i8 %1, i32 %2, i16 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo153(ptr noalias sret(%struct.point153) align 1 %0, i8 %1, i32 %2, i16 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo154(ptr noalias sret(%struct.point154) align 1 %0, i8 %1, i32 %2, i16 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo155(ptr noalias sret(%struct.point155) align 1 %0, i8 %1, i32 %2, i16 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo156(ptr noalias sret(%struct.point156) align 1 %0, i8 %1, i32 %2, i16 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo157(ptr noalias sret(%struct.point157) align 1 %0, i8 %1, i32 %2, i16 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo158(ptr noalias sret(%struct.point158) align 1 %0, i8 %1, i32 %2, i16 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo159(ptr noalias sret(%struct.point159) align 1 %0, i8 %1, i32 %2, i16 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo160(ptr noalias sret(%struct.point160) align 1 %0, i8 %1, i32 %2, i16 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo161(ptr noalias sret(%struct.point161) align 1 %0, i8 %1, i32 %2, i16 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo162(ptr noalias sret(%struct.point162) align 1 %0, i8 %1, i32 %2, i16 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo163(ptr noalias sret(%struct.point163) align 1 %0, i8 %1, i32 %2, i16 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo164(ptr noalias sret(%struct.point164) align 1 %0, i8 %1, i32 %2, i16 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo165(ptr noalias sret(%struct.point165) align 1 %0, i8 %1, i32 %2, i16 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo166(ptr noalias sret(%struct.point166) align 1 %0, i8 %1, i32 %2, i16 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo167(ptr noalias sret(%struct.point167) align 1 %0, i8 %1, i32 %2, i16 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo168(ptr noalias sret(%struct.point168) align 1 %0, i8 %1, i32 %2, i16 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo169(ptr noalias sret(%struct.point169) align 1 %0, i8 %1, i32 %2, i32 %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo170(ptr noalias sret(%struct.point170) align 1 %0, i8 %1, i32 %2, i32 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo171(ptr noalias sret(%struct.point171) align 1 %0, i8 %1, i32 %2, i32 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo172(ptr noalias sret(%struct.point172) align 1 %0, i8 %1, i32 %2, i32 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo173(ptr noalias sret(%struct.point173) align 1 %0, i8 %1, i32 %2, i32 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo174(ptr noalias sret(%struct.point174) align 1 %0, i8 %1, i32 %2, i32 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo175(ptr noalias sret(%struct.point175) align 1 %0, i8 %1, i32 %2, i32 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo176(ptr noalias sret(%struct.point176) align 1 %0, i8 %1, i32 %2, i32 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo177(ptr noalias sret(%struct.point177) align 1 %0, i8 %1, i32 %2, i32 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo178(ptr noalias sret(%struct.point178) align 1 %0, i8 %1, i32 %2, i32 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo179(ptr noalias sret(%struct.point179) align 1 %0, i8 %1, i32 %2, i32 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo180(ptr noalias sret(%struct.point180) align 1 %0, i8 %1, i32 %2, i32 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo181(ptr noalias sret(%struct.point181) align 1 %0, i8 %1, i32 %2, i32 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo182(ptr noalias sret(%struct.point182) align 1 %0, i8 %1, i32 %2, i32 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo183(ptr noalias sret(%struct.point183) align 1 %0, ptr noundef byval(%struct.point183) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo184(ptr noalias sret(%struct.point184) align 1 %0, ptr noundef byval(%struct.point184) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo185(ptr noalias sret(%struct.point185) align 1 %0, i8 %1, i32 %2, i32 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo186(ptr noalias sret(%struct.point186) align 1 %0, i8 %1, i32 %2, i32 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo187(ptr noalias sret(%struct.point187) align 1 %0, i8 %1, i32 %2, i32 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo188(ptr noalias sret(%struct.point188) align 1 %0, ptr noundef byval(%struct.point188) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo189(ptr noalias sret(%struct.point189) align 1 %0, ptr noundef byval(%struct.point189) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo190(ptr noalias sret(%struct.point190) align 1 %0, i8 %1, i32 %2, float %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo191(ptr noalias sret(%struct.point191) align 1 %0, i8 %1, i32 %2, float %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo192(ptr noalias sret(%struct.point192) align 1 %0, i8 %1, i32 %2, float %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo193(ptr noalias sret(%struct.point193) align 1 %0, i8 %1, i32 %2, float %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo194(ptr noalias sret(%struct.point194) align 1 %0, i8 %1, i32 %2, float %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo195(ptr noalias sret(%struct.point195) align 1 %0, i8 %1, i32 %2, float %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo196(ptr noalias sret(%struct.point196) align 1 %0, i8 %1, i32 %2, float %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo197(ptr noalias sret(%struct.point197) align 1 %0, i8 %1, i32 %2, float %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo198(ptr noalias sret(%struct.point198) align 1 %0, i8 %1, i32 %2, float %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo199(ptr noalias sret(%struct.point199) align 1 %0, i8 %1, i32 %2, float %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo200(ptr noalias sret(%struct.point200) align 1 %0, i8 %1, i32 %2, float %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo201(ptr noalias sret(%struct.point201) align 1 %0, i8 %1, i32 %2, float %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo202(ptr noalias sret(%struct.point202) align 1 %0, i8 %1, i32 %2, float %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo203(ptr noalias sret(%struct.point203) align 1 %0, i8 %1, i32 %2, float %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo204(ptr noalias sret(%struct.point204) align 1 %0, ptr noundef byval(%struct.point204) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo205(ptr noalias sret(%struct.point205) align 1 %0, ptr noundef byval(%struct.point205) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo206(ptr noalias sret(%struct.point206) align 1 %0, i8 %1, i32 %2, float %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo207(ptr noalias sret(%struct.point207) align 1 %0, i8 %1, i32 %2, float %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo208(ptr noalias sret(%struct.point208) align 1 %0, i8 %1, i32 %2, float %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo209(ptr noalias sret(%struct.point209) align 1 %0, ptr noundef byval(%struct.point209) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo210(ptr noalias sret(%struct.point210) align 1 %0, ptr noundef byval(%struct.point210) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point211 @demo211(i8 %0, float %1, i8 %2) #0 {
+  ret %struct.point211 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point212 @demo212(i8 %0, float %1, i8 %2, i8 %3) #0 {
+  ret %struct.point212 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point213 @demo213(i8 %0, float %1, i8 %2, i8 %3, i8 %4) #0 {
+  ret %struct.point213 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo214(ptr noalias sret(%struct.point214) align 1 %0, i8 %1, float %2, i8 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo215(ptr noalias sret(%struct.point215) align 1 %0, i8 %1, float %2, i8 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo216(ptr noalias sret(%struct.point216) align 1 %0, i8 %1, float %2, i8 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point217 @demo217(i8 %0, float %1, i8 %2, i16 %3) #0 {
+  ret %struct.point217 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo218(ptr noalias sret(%struct.point218) align 1 %0, i8 %1, float %2, i8 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo219(ptr noalias sret(%struct.point219) align 1 %0, i8 %1, float %2, i8 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo220(ptr noalias sret(%struct.point220) align 1 %0, i8 %1, float %2, i8 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo221(ptr noalias sret(%struct.point221) align 1 %0, i8 %1, float %2, i8 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo222(ptr noalias sret(%struct.point222) align 1 %0, i8 %1, float %2, i8 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo223(ptr noalias sret(%struct.point223) align 1 %0, i8 %1, float %2, i8 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo224(ptr noalias sret(%struct.point224) align 1 %0, i8 %1, float %2, i8 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo225(ptr noalias sret(%struct.point225) align 1 %0, i8 %1, float %2, i8 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo226(ptr noalias sret(%struct.point226) align 1 %0, i8 %1, float %2, i8 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo227(ptr noalias sret(%struct.point227) align 1 %0, i8 %1, float %2, i8 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo228(ptr noalias sret(%struct.point228) align 1 %0, i8 %1, float %2, i8 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo229(ptr noalias sret(%struct.point229) align 1 %0, i8 %1, float %2, i8 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo230(ptr noalias sret(%struct.point230) align 1 %0, i8 %1, float %2, i8 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo231(ptr noalias sret(%struct.point231) align 1 %0, i8 %1, float %2, i8 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point232 @demo232(i8 %0, float %1, i16 %2) #0 {
+  ret %struct.point232 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point233 @demo233(i8 %0, float %1, i16 %2, i8 %3) #0 {
+  ret %struct.point233 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo234(ptr noalias sret(%struct.point234) align 1 %0, i8 %1, float %2, i16 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo235(ptr noalias sret(%struct.point235) align 1 %0, i8 %1, float %2, i16 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo236(ptr noalias sret(%struct.point236) align 1 %0, i8 %1, float %2, i16 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo237(ptr noalias sret(%struct.point237) align 1 %0, i8 %1, float %2, i16 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo238(ptr noalias sret(%struct.point238) align 1 %0, i8 %1, float %2, i16 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo239(ptr noalias sret(%struct.point239) align 1 %0, i8 %1, float %2, i16 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo240(ptr noalias sret(%struct.point240) align 1 %0, i8 %1, float %2, i16 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo241(ptr noalias sret(%struct.point241) align 1 %0, i8 %1, float %2, i16 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo242(ptr noalias sret(%struct.point242) align 1 %0, i8 %1, float %2, i16 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo243(ptr noalias sret(%struct.point243) align 1 %0, i8 %1, float %2, i16 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo244(ptr noalias sret(%struct.point244) align 1 %0, i8 %1, float %2, i16 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo245(ptr noalias sret(%struct.point245) align 1 %0, i8 %1, float %2, i16 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo246(ptr noalias sret(%struct.point246) align 1 %0, i8 %1, float %2, i16 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo247(ptr noalias sret(%struct.point247) align 1 %0, i8 %1, float %2, i16 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo248(ptr noalias sret(%struct.point248) align 1 %0, i8 %1, float %2, i16 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo249(ptr noalias sret(%struct.point249) align 1 %0, i8 %1, float %2, i16 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo250(ptr noalias sret(%struct.point250) align 1 %0, i8 %1, float %2, i16 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo251(ptr noalias sret(%struct.point251) align 1 %0, i8 %1, float %2, i16 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo252(ptr noalias sret(%struct.point252) align 1 %0, i8 %1, float %2, i16 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo253(ptr noalias sret(%struct.point253) align 1 %0, i8 %1, float %2, i32 %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo254(ptr noalias sret(%struct.point254) align 1 %0, i8 %1, float %2, i32 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo255(ptr noalias sret(%struct.point255) align 1 %0, i8 %1, float %2, i32 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo256(ptr noalias sret(%struct.point256) align 1 %0, i8 %1, float %2, i32 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo257(ptr noalias sret(%struct.point257) align 1 %0, i8 %1, float %2, i32 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo258(ptr noalias sret(%struct.point258) align 1 %0, i8 %1, float %2, i32 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo259(ptr noalias sret(%struct.point259) align 1 %0, i8 %1, float %2, i32 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo260(ptr noalias sret(%struct.point260) align 1 %0, i8 %1, float %2, i32 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo261(ptr noalias sret(%struct.point261) align 1 %0, i8 %1, float %2, i32 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo262(ptr noalias sret(%struct.point262) align 1 %0, i8 %1, float %2, i32 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo263(ptr noalias sret(%struct.point263) align 1 %0, i8 %1, float %2, i32 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo264(ptr noalias sret(%struct.point264) align 1 %0, i8 %1, float %2, i32 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo265(ptr noalias sret(%struct.point265) align 1 %0, i8 %1, float %2, i32 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo266(ptr noalias sret(%struct.point266) align 1 %0, i8 %1, float %2, i32 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo267(ptr noalias sret(%struct.point267) align 1 %0, ptr noundef byval(%struct.point267) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo268(ptr noalias sret(%struct.point268) align 1 %0, ptr noundef byval(%struct.point268) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo269(ptr noalias sret(%struct.point269) align 1 %0, i8 %1, float %2, i32 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo270(ptr noalias sret(%struct.point270) align 1 %0, i8 %1, float %2, i32 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo271(ptr noalias sret(%struct.point271) align 1 %0, i8 %1, float %2, i32 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo272(ptr noalias sret(%struct.point272) align 1 %0, ptr noundef byval(%struct.point272) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo273(ptr noalias sret(%struct.point273) align 1 %0, ptr noundef byval(%struct.point273) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo274(ptr noalias sret(%struct.point274) align 1 %0, i8 %1, float %2, float %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo275(ptr noalias sret(%struct.point275) align 1 %0, i8 %1, float %2, float %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo276(ptr noalias sret(%struct.point276) align 1 %0, i8 %1, float %2, float %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo277(ptr noalias sret(%struct.point277) align 1 %0, i8 %1, float %2, float %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo278(ptr noalias sret(%struct.point278) align 1 %0, i8 %1, float %2, float %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo279(ptr noalias sret(%struct.point279) align 1 %0, i8 %1, float %2, float %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo280(ptr noalias sret(%struct.point280) align 1 %0, i8 %1, float %2, float %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo281(ptr noalias sret(%struct.point281) align 1 %0, i8 %1, float %2, float %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo282(ptr noalias sret(%struct.point282) align 1 %0, i8 %1, float %2, float %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo283(ptr noalias sret(%struct.point283) align 1 %0, i8 %1, float %2, float %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo284(ptr noalias sret(%struct.point284) align 1 %0, i8 %1, float %2, float %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo285(ptr noalias sret(%struct.point285) align 1 %0, i8 %1, float %2, float %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo286(ptr noalias sret(%struct.point286) align 1 %0, i8 %1, float %2, float %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo287(ptr noalias sret(%struct.point287) align 1 %0, i8 %1, float %2, float %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo288(ptr noalias sret(%struct.point288) align 1 %0, ptr noundef byval(%struct.point288) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo289(ptr noalias sret(%struct.point289) align 1 %0, ptr noundef byval(%struct.point289) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo290(ptr noalias sret(%struct.point290) align 1 %0, i8 %1, float %2, float %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo291(ptr noalias sret(%struct.point291) align 1 %0, i8 %1, float %2, float %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo292(ptr noalias sret(%struct.point292) align 1 %0, i8 %1, float %2, float %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo293(ptr noalias sret(%struct.point293) align 1 %0, ptr noundef byval(%struct.point293) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo294(ptr noalias sret(%struct.point294) align 1 %0, ptr noundef byval(%struct.point294) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point295 @demo295(i16 %0, i8 %1, i8 %2) #0 {
+  ret %struct.point295 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point296 @demo296(i16 %0, i8 %1, i8 %2, i8 %3) #0 {
+  ret %struct.point296 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point297 @demo297(i16 %0, i8 %1, i8 %2, i8 %3, i8 %4) #0 {
+  ret %struct.point297 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point298 @demo298(i16 %0, i8 %1, i8 %2, i8 %3, i16 %4) #0 {
+  ret %struct.point298 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo299(ptr noalias sret(%struct.point299) align 1 %0, i16 %1, i8 %2, i8 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo300(ptr noalias sret(%struct.point300) align 1 %0, i16 %1, i8 %2, i8 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point301 @demo301(i16 %0, i8 %1, i8 %2, i16 %3) #0 {
+  ret %struct.point301 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point302 @demo302(i16 %0, i8 %1, i8 %2, i16 %3, i8 %4) #0 {
+  ret %struct.point302 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point303 @demo303(i16 %0, i8 %1, i8 %2, i16 %3, i16 %4) #0 {
+  ret %struct.point303 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo304(ptr noalias sret(%struct.point304) align 1 %0, i16 %1, i8 %2, i8 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo305(ptr noalias sret(%struct.point305) align 1 %0, i16 %1, i8 %2, i8 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point306 @demo306(i16 %0, i8 %1, i8 %2, i32 %3) #0 {
+  ret %struct.point306 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo307(ptr noalias sret(%struct.point307) align 1 %0, i16 %1, i8 %2, i8 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo308(ptr noalias sret(%struct.point308) align 1 %0, i16 %1, i8 %2, i8 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo309(ptr noalias sret(%struct.point309) align 1 %0, i16 %1, i8 %2, i8 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo310(ptr noalias sret(%struct.point310) align 1 %0, i16 %1, i8 %2, i8 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point311 @demo311(i16 %0, i8 %1, i8 %2, float %3) #0 {
+  ret %struct.point311 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo312(ptr noalias sret(%struct.point312) align 1 %0, i16 %1, i8 %2, i8 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo313(ptr noalias sret(%struct.point313) align 1 %0, i16 %1, i8 %2, i8 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo314(ptr noalias sret(%struct.point314) align 1 %0, i16 %1, i8 %2, i8 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo315(ptr noalias sret(%struct.point315) align 1 %0, i16 %1, i8 %2, i8 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point316 @demo316(i16 %0, i8 %1, i16 %2) #0 {
+  ret %struct.point316 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point317 @demo317(i16 %0, i8 %1, i16 %2, i8 %3) #0 {
+  ret %struct.point317 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point318 @demo318(i16 %0, i8 %1, i16 %2, i8 %3, i8 %4) #0 {
+  ret %struct.point318 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point319 @demo319(i16 %0, i8 %1, i16 %2, i8 %3, i16 %4) #0 {
+  ret %struct.point319 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo320(ptr noalias sret(%struct.point320) align 1 %0, i16 %1, i8 %2, i16 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo321(ptr noalias sret(%struct.point321) align 1 %0, i16 %1, i8 %2, i16 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point322 @demo322(i16 %0, i8 %1, i16 %2, i16 %3) #0 {
+  ret %struct.point322 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point323 @demo323(i16 %0, i8 %1, i16 %2, i16 %3, i8 %4) #0 {
+  ret %struct.point323 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo324(ptr noalias sret(%struct.point324) align 1 %0, i16 %1, i8 %2, i16 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo325(ptr noalias sret(%struct.point325) align 1 %0, i16 %1, i8 %2, i16 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo326(ptr noalias sret(%struct.point326) align 1 %0, i16 %1, i8 %2, i16 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo327(ptr noalias sret(%struct.point327) align 1 %0, i16 %1, i8 %2, i16 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo328(ptr noalias sret(%struct.point328) align 1 %0, i16 %1, i8 %2, i16 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo329(ptr noalias sret(%struct.point329) align 1 %0, i16 %1, i8 %2, i16 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo330(ptr noalias sret(%struct.point330) align 1 %0, i16 %1, i8 %2, i16 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo331(ptr noalias sret(%struct.point331) align 1 %0, i16 %1, i8 %2, i16 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo332(ptr noalias sret(%struct.point332) align 1 %0, i16 %1, i8 %2, i16 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo333(ptr noalias sret(%struct.point333) align 1 %0, i16 %1, i8 %2, i16 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo334(ptr noalias sret(%struct.point334) align 1 %0, i16 %1, i8 %2, i16 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo335(ptr noalias sret(%struct.point335) align 1 %0, i16 %1, i8 %2, i16 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo336(ptr noalias sret(%struct.point336) align 1 %0, i16 %1, i8 %2, i16 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point337 @demo337(i16 %0, i8 %1, i32 %2) #0 {
+  ret %struct.point337 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point338 @demo338(i16 %0, i8 %1, i32 %2, i8 %3) #0 {
+  ret %struct.point338 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo339(ptr noalias sret(%struct.point339) align 1 %0, i16 %1, i8 %2, i32 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo340(ptr noalias sret(%struct.point340) align 1 %0, i16 %1, i8 %2, i32 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo341(ptr noalias sret(%struct.point341) align 1 %0, i16 %1, i8 %2, i32 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo342(ptr noalias sret(%struct.point342) align 1 %0, i16 %1, i8 %2, i32 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo343(ptr noalias sret(%struct.point343) align 1 %0, i16 %1, i8 %2, i32 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo344(ptr noalias sret(%struct.point344) align 1 %0, i16 %1, i8 %2, i32 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo345(ptr noalias sret(%struct.point345) align 1 %0, i16 %1, i8 %2, i32 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo346(ptr noalias sret(%struct.point346) align 1 %0, i16 %1, i8 %2, i32 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo347(ptr noalias sret(%struct.point347) align 1 %0, i16 %1, i8 %2, i32 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo348(ptr noalias sret(%struct.point348) align 1 %0, i16 %1, i8 %2, i32 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo349(ptr noalias sret(%struct.point349) align 1 %0, i16 %1, i8 %2, i32 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo350(ptr noalias sret(%struct.point350) align 1 %0, i16 %1, i8 %2, i32 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo351(ptr noalias sret(%struct.point351) align 1 %0, i16 %1, i8 %2, i32 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo352(ptr noalias sret(%struct.point352) align 1 %0, i16 %1, i8 %2, i32 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo353(ptr noalias sret(%struct.point353) align 1 %0, i16 %1, i8 %2, i32 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo354(ptr noalias sret(%struct.point354) align 1 %0, i16 %1, i8 %2, i32 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo355(ptr noalias sret(%struct.point355) align 1 %0, i16 %1, i8 %2, i32 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo356(ptr noalias sret(%struct.point356) align 1 %0, i16 %1, i8 %2, i32 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo357(ptr noalias sret(%struct.point357) align 1 %0, i16 %1, i8 %2, i32 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point358 @demo358(i16 %0, i8 %1, float %2) #0 {
+  ret %struct.point358 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point359 @demo359(i16 %0, i8 %1, float %2, i8 %3) #0 {
+  ret %struct.point359 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo360(ptr noalias sret(%struct.point360) align 1 %0, i16 %1, i8 %2, float %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo361(ptr noalias sret(%struct.point361) align 1 %0, i16 %1, i8 %2, float %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo362(ptr noalias sret(%struct.point362) align 1 %0, i16 %1, i8 %2, float %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo363(ptr noalias sret(%struct.point363) align 1 %0, i16 %1, i8 %2, float %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo364(ptr noalias sret(%struct.point364) align 1 %0, i16 %1, i8 %2, float %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo365(ptr noalias sret(%struct.point365) align 1 %0, i16 %1, i8 %2, float %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo366(ptr noalias sret(%struct.point366) align 1 %0, i16 %1, i8 %2, float %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo367(ptr noalias sret(%struct.point367) align 1 %0, i16 %1, i8 %2, float %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo368(ptr noalias sret(%struct.point368) align 1 %0, i16 %1, i8 %2, float %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo369(ptr noalias sret(%struct.point369) align 1 %0, i16 %1, i8 %2, float %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo370(ptr noalias sret(%struct.point370) align 1 %0, i16 %1, i8 %2, float %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo371(ptr noalias sret(%struct.point371) align 1 %0, i16 %1, i8 %2, float %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo372(ptr noalias sret(%struct.point372) align 1 %0, i16 %1, i8 %2, float %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo373(ptr noalias sret(%struct.point373) align 1 %0, i16 %1, i8 %2, float %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo374(ptr noalias sret(%struct.point374) align 1 %0, i16 %1, i8 %2, float %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo375(ptr noalias sret(%struct.point375) align 1 %0, i16 %1, i8 %2, float %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo376(ptr noalias sret(%struct.point376) align 1 %0, i16 %1, i8 %2, float %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo377(ptr noalias sret(%struct.point377) align 1 %0, i16 %1, i8 %2, float %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo378(ptr noalias sret(%struct.point378) align 1 %0, i16 %1, i8 %2, float %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point379 @demo379(i16 %0, i32 %1, i8 %2) #0 {
+  ret %struct.point379 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point380 @demo380(i16 %0, i32 %1, i8 %2, i8 %3) #0 {
+  ret %struct.point380 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo381(ptr noalias sret(%struct.point381) align 1 %0, i16 %1, i32 %2, i8 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo382(ptr noalias sret(%struct.point382) align 1 %0, i16 %1, i32 %2, i8 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo383(ptr noalias sret(%struct.point383) align 1 %0, i16 %1, i32 %2, i8 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo384(ptr noalias sret(%struct.point384) align 1 %0, i16 %1, i32 %2, i8 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo385(ptr noalias sret(%struct.point385) align 1 %0, i16 %1, i32 %2, i8 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo386(ptr noalias sret(%struct.point386) align 1 %0, i16 %1, i32 %2, i8 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo387(ptr noalias sret(%struct.point387) align 1 %0, i16 %1, i32 %2, i8 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo388(ptr noalias sret(%struct.point388) align 1 %0, i16 %1, i32 %2, i8 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo389(ptr noalias sret(%struct.point389) align 1 %0, i16 %1, i32 %2, i8 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo390(ptr noalias sret(%struct.point390) align 1 %0, i16 %1, i32 %2, i8 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo391(ptr noalias sret(%struct.point391) align 1 %0, i16 %1, i32 %2, i8 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo392(ptr noalias sret(%struct.point392) align 1 %0, i16 %1, i32 %2, i8 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo393(ptr noalias sret(%struct.point393) align 1 %0, i16 %1, i32 %2, i8 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo394(ptr noalias sret(%struct.point394) align 1 %0, i16 %1, i32 %2, i8 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo395(ptr noalias sret(%struct.point395) align 1 %0, i16 %1, i32 %2, i8 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo396(ptr noalias sret(%struct.point396) align 1 %0, i16 %1, i32 %2, i8 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo397(ptr noalias sret(%struct.point397) align 1 %0, i16 %1, i32 %2, i8 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo398(ptr noalias sret(%struct.point398) align 1 %0, i16 %1, i32 %2, i8 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo399(ptr noalias sret(%struct.point399) align 1 %0, i16 %1, i32 %2, i8 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point400 @demo400(i16 %0, i32 %1, i16 %2) #0 {
+  ret %struct.point400 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo401(ptr noalias sret(%struct.point401) align 1 %0, i16 %1, i32 %2, i16 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo402(ptr noalias sret(%struct.point402) align 1 %0, i16 %1, i32 %2, i16 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo403(ptr noalias sret(%struct.point403) align 1 %0, i16 %1, i32 %2, i16 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo404(ptr noalias sret(%struct.point404) align 1 %0, i16 %1, i32 %2, i16 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo405(ptr noalias sret(%struct.point405) align 1 %0, i16 %1, i32 %2, i16 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo406(ptr noalias sret(%struct.point406) align 1 %0, i16 %1, i32 %2, i16 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo407(ptr noalias sret(%struct.point407) align 1 %0, i16 %1, i32 %2, i16 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo408(ptr noalias sret(%struct.point408) align 1 %0, i16 %1, i32 %2, i16 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo409(ptr noalias sret(%struct.point409) align 1 %0, i16 %1, i32 %2, i16 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo410(ptr noalias sret(%struct.point410) align 1 %0, i16 %1, i32 %2, i16 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo411(ptr noalias sret(%struct.point411) align 1 %0, i16 %1, i32 %2, i16 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo412(ptr noalias sret(%struct.point412) align 1 %0, i16 %1, i32 %2, i16 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo413(ptr noalias sret(%struct.point413) align 1 %0, i16 %1, i32 %2, i16 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo414(ptr noalias sret(%struct.point414) align 1 %0, i16 %1, i32 %2, i16 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo415(ptr noalias sret(%struct.point415) align 1 %0, i16 %1, i32 %2, i16 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo416(ptr noalias sret(%struct.point416) align 1 %0, i16 %1, i32 %2, i16 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo417(ptr noalias sret(%struct.point417) align 1 %0, i16 %1, i32 %2, i16 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo418(ptr noalias sret(%struct.point418) align 1 %0, i16 %1, i32 %2, i16 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo419(ptr noalias sret(%struct.point419) align 1 %0, i16 %1, i32 %2, i16 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo420(ptr noalias sret(%struct.point420) align 1 %0, i16 %1, i32 %2, i16 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo421(ptr noalias sret(%struct.point421) align 1 %0, i16 %1, i32 %2, i32 %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo422(ptr noalias sret(%struct.point422) align 1 %0, i16 %1, i32 %2, i32 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo423(ptr noalias sret(%struct.point423) align 1 %0, i16 %1, i32 %2, i32 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo424(ptr noalias sret(%struct.point424) align 1 %0, i16 %1, i32 %2, i32 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo425(ptr noalias sret(%struct.point425) align 1 %0, i16 %1, i32 %2, i32 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo426(ptr noalias sret(%struct.point426) align 1 %0, i16 %1, i32 %2, i32 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo427(ptr noalias sret(%struct.point427) align 1 %0, i16 %1, i32 %2, i32 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo428(ptr noalias sret(%struct.point428) align 1 %0, i16 %1, i32 %2, i32 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo429(ptr noalias sret(%struct.point429) align 1 %0, i16 %1, i32 %2, i32 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo430(ptr noalias sret(%struct.point430) align 1 %0, i16 %1, i32 %2, i32 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo431(ptr noalias sret(%struct.point431) align 1 %0, i16 %1, i32 %2, i32 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo432(ptr noalias sret(%struct.point432) align 1 %0, i16 %1, i32 %2, i32 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo433(ptr noalias sret(%struct.point433) align 1 %0, i16 %1, i32 %2, i32 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo434(ptr noalias sret(%struct.point434) align 1 %0, i16 %1, i32 %2, i32 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo435(ptr noalias sret(%struct.point435) align 1 %0, ptr noundef byval(%struct.point435) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo436(ptr noalias sret(%struct.point436) align 1 %0, ptr noundef byval(%struct.point436) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo437(ptr noalias sret(%struct.point437) align 1 %0, i16 %1, i32 %2, i32 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo438(ptr noalias sret(%struct.point438) align 1 %0, i16 %1, i32 %2, i32 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo439(ptr noalias sret(%struct.point439) align 1 %0, i16 %1, i32 %2, i32 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo440(ptr noalias sret(%struct.point440) align 1 %0, ptr noundef byval(%struct.point440) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo441(ptr noalias sret(%struct.point441) align 1 %0, ptr noundef byval(%struct.point441) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo442(ptr noalias sret(%struct.point442) align 1 %0, i16 %1, i32 %2, float %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo443(ptr noalias sret(%struct.point443) align 1 %0, i16 %1, i32 %2, float %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo444(ptr noalias sret(%struct.point444) align 1 %0, i16 %1, i32 %2, float %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo445(ptr noalias sret(%struct.point445) align 1 %0, i16 %1, i32 %2, float %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo446(ptr noalias sret(%struct.point446) align 1 %0, i16 %1, i32 %2, float %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo447(ptr noalias sret(%struct.point447) align 1 %0, i16 %1, i32 %2, float %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo448(ptr noalias sret(%struct.point448) align 1 %0, i16 %1, i32 %2, float %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo449(ptr noalias sret(%struct.point449) align 1 %0, i16 %1, i32 %2, float %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo450(ptr noalias sret(%struct.point450) align 1 %0, i16 %1, i32 %2, float %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo451(ptr noalias sret(%struct.point451) align 1 %0, i16 %1, i32 %2, float %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo452(ptr noalias sret(%struct.point452) align 1 %0, i16 %1, i32 %2, float %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo453(ptr noalias sret(%struct.point453) align 1 %0, i16 %1, i32 %2, float %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo454(ptr noalias sret(%struct.point454) align 1 %0, i16 %1, i32 %2, float %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo455(ptr noalias sret(%struct.point455) align 1 %0, i16 %1, i32 %2, float %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo456(ptr noalias sret(%struct.point456) align 1 %0, ptr noundef byval(%struct.point456) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo457(ptr noalias sret(%struct.point457) align 1 %0, ptr noundef byval(%struct.point457) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo458(ptr noalias sret(%struct.point458) align 1 %0, i16 %1, i32 %2, float %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo459(ptr noalias sret(%struct.point459) align 1 %0, i16 %1, i32 %2, float %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo460(ptr noalias sret(%struct.point460) align 1 %0, i16 %1, i32 %2, float %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo461(ptr noalias sret(%struct.point461) align 1 %0, ptr noundef byval(%struct.point461) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo462(ptr noalias sret(%struct.point462) align 1 %0, ptr noundef byval(%struct.point462) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point463 @demo463(i16 %0, float %1, i8 %2) #0 {
+  ret %struct.point463 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point464 @demo464(i16 %0, float %1, i8 %2, i8 %3) #0 {
+  ret %struct.point464 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo465(ptr noalias sret(%struct.point465) align 1 %0, i16 %1, float %2, i8 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo466(ptr noalias sret(%struct.point466) align 1 %0, i16 %1, float %2, i8 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo467(ptr noalias sret(%struct.point467) align 1 %0, i16 %1, float %2, i8 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo468(ptr noalias sret(%struct.point468) align 1 %0, i16 %1, float %2, i8 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo469(ptr noalias sret(%struct.point469) align 1 %0, i16 %1, float %2, i8 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo470(ptr noalias sret(%struct.point470) align 1 %0, i16 %1, float %2, i8 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo471(ptr noalias sret(%struct.point471) align 1 %0, i16 %1, float %2, i8 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo472(ptr noalias sret(%struct.point472) align 1 %0, i16 %1, float %2, i8 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo473(ptr noalias sret(%struct.point473) align 1 %0, i16 %1, float %2, i8 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo474(ptr noalias sret(%struct.point474) align 1 %0, i16 %1, float %2, i8 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo475(ptr noalias sret(%struct.point475) align 1 %0, i16 %1, float %2, i8 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo476(ptr noalias sret(%struct.point476) align 1 %0, i16 %1, float %2, i8 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo477(ptr noalias sret(%struct.point477) align 1 %0, i16 %1, float %2, i8 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo478(ptr noalias sret(%struct.point478) align 1 %0, i16 %1, float %2, i8 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo479(ptr noalias sret(%struct.point479) align 1 %0, i16 %1, float %2, i8 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo480(ptr noalias sret(%struct.point480) align 1 %0, i16 %1, float %2, i8 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo481(ptr noalias sret(%struct.point481) align 1 %0, i16 %1, float %2, i8 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo482(ptr noalias sret(%struct.point482) align 1 %0, i16 %1, float %2, i8 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo483(ptr noalias sret(%struct.point483) align 1 %0, i16 %1, float %2, i8 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point484 @demo484(i16 %0, float %1, i16 %2) #0 {
+  ret %struct.point484 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo485(ptr noalias sret(%struct.point485) align 1 %0, i16 %1, float %2, i16 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo486(ptr noalias sret(%struct.point486) align 1 %0, i16 %1, float %2, i16 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo487(ptr noalias sret(%struct.point487) align 1 %0, i16 %1, float %2, i16 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo488(ptr noalias sret(%struct.point488) align 1 %0, i16 %1, float %2, i16 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo489(ptr noalias sret(%struct.point489) align 1 %0, i16 %1, float %2, i16 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo490(ptr noalias sret(%struct.point490) align 1 %0, i16 %1, float %2, i16 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo491(ptr noalias sret(%struct.point491) align 1 %0, i16 %1, float %2, i16 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo492(ptr noalias sret(%struct.point492) align 1 %0, i16 %1, float %2, i16 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo493(ptr noalias sret(%struct.point493) align 1 %0, i16 %1, float %2, i16 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo494(ptr noalias sret(%struct.point494) align 1 %0, i16 %1, float %2, i16 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo495(ptr noalias sret(%struct.point495) align 1 %0, i16 %1, float %2, i16 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo496(ptr noalias sret(%struct.point496) align 1 %0, i16 %1, float %2, i16 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo497(ptr noalias sret(%struct.point497) align 1 %0, i16 %1, float %2, i16 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo498(ptr noalias sret(%struct.point498) align 1 %0, i16 %1, float %2, i16 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo499(ptr noalias sret(%struct.point499) align 1 %0, i16 %1, float %2, i16 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo500(ptr noalias sret(%struct.point500) align 1 %0, i16 %1, float %2, i16 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo501(ptr noalias sret(%struct.point501) align 1 %0, i16 %1, float %2, i16 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo502(ptr noalias sret(%struct.point502) align 1 %0, i16 %1, float %2, i16 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo503(ptr noalias sret(%struct.point503) align 1 %0, i16 %1, float %2, i16 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo504(ptr noalias sret(%struct.point504) align 1 %0, i16 %1, float %2, i16 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo505(ptr noalias sret(%struct.point505) align 1 %0, i16 %1, float %2, i32 %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo506(ptr noalias sret(%struct.point506) align 1 %0, i16 %1, float %2, i32 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo507(ptr noalias sret(%struct.point507) align 1 %0, i16 %1, float %2, i32 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo508(ptr noalias sret(%struct.point508) align 1 %0, i16 %1, float %2, i32 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo509(ptr noalias sret(%struct.point509) align 1 %0, i16 %1, float %2, i32 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo510(ptr noalias sret(%struct.point510) align 1 %0, i16 %1, float %2, i32 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo511(ptr noalias sret(%struct.point511) align 1 %0, i16 %1, float %2, i32 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo512(ptr noalias sret(%struct.point512) align 1 %0, i16 %1, float %2, i32 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo513(ptr noalias sret(%struct.point513) align 1 %0, i16 %1, float %2, i32 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo514(ptr noalias sret(%struct.point514) align 1 %0, i16 %1, float %2, i32 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo515(ptr noalias sret(%struct.point515) align 1 %0, i16 %1, float %2, i32 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo516(ptr noalias sret(%struct.point516) align 1 %0, i16 %1, float %2, i32 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo517(ptr noalias sret(%struct.point517) align 1 %0, i16 %1, float %2, i32 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo518(ptr noalias sret(%struct.point518) align 1 %0, i16 %1, float %2, i32 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo519(ptr noalias sret(%struct.point519) align 1 %0, ptr noundef byval(%struct.point519) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo520(ptr noalias sret(%struct.point520) align 1 %0, ptr noundef byval(%struct.point520) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo521(ptr noalias sret(%struct.point521) align 1 %0, i16 %1, float %2, i32 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo522(ptr noalias sret(%struct.point522) align 1 %0, i16 %1, float %2, i32 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo523(ptr noalias sret(%struct.point523) align 1 %0, i16 %1, float %2, i32 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo524(ptr noalias sret(%struct.point524) align 1 %0, ptr noundef byval(%struct.point524) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo525(ptr noalias sret(%struct.point525) align 1 %0, ptr noundef byval(%struct.point525) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo526(ptr noalias sret(%struct.point526) align 1 %0, i16 %1, float %2, float %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo527(ptr noalias sret(%struct.point527) align 1 %0, i16 %1, float %2, float %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo528(ptr noalias sret(%struct.point528) align 1 %0, i16 %1, float %2, float %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo529(ptr noalias sret(%struct.point529) align 1 %0, i16 %1, float %2, float %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo530(ptr noalias sret(%struct.point530) align 1 %0, i16 %1, float %2, float %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo531(ptr noalias sret(%struct.point531) align 1 %0, i16 %1, float %2, float %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo532(ptr noalias sret(%struct.point532) align 1 %0, i16 %1, float %2, float %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo533(ptr noalias sret(%struct.point533) align 1 %0, i16 %1, float %2, float %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo534(ptr noalias sret(%struct.point534) align 1 %0, i16 %1, float %2, float %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo535(ptr noalias sret(%struct.point535) align 1 %0, i16 %1, float %2, float %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo536(ptr noalias sret(%struct.point536) align 1 %0, i16 %1, float %2, float %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo537(ptr noalias sret(%struct.point537) align 1 %0, i16 %1, float %2, float %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo538(ptr noalias sret(%struct.point538) align 1 %0, i16 %1, float %2, float %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo539(ptr noalias sret(%struct.point539) align 1 %0, i16 %1, float %2, float %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo540(ptr noalias sret(%struct.point540) align 1 %0, ptr noundef byval(%struct.point540) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo541(ptr noalias sret(%struct.point541) align 1 %0, ptr noundef byval(%struct.point541) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo542(ptr noalias sret(%struct.point542) align 1 %0, i16 %1, float %2, float %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo543(ptr noalias sret(%struct.point543) align 1 %0, i16 %1, float %2, float %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo544(ptr noalias sret(%struct.point544) align 1 %0, i16 %1, float %2, float %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo545(ptr noalias sret(%struct.point545) align 1 %0, ptr noundef byval(%struct.point545) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo546(ptr noalias sret(%struct.point546) align 1 %0, ptr noundef byval(%struct.point546) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point547 @demo547(i32 %0, i8 %1, i8 %2) #0 {
+  ret %struct.point547 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point548 @demo548(i32 %0, i8 %1, i8 %2, i8 %3) #0 {
+  ret %struct.point548 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point549 @demo549(i32 %0, i8 %1, i8 %2, i8 %3, i8 %4) #0 {
+  ret %struct.point549 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo550(ptr noalias sret(%struct.point550) align 1 %0, i32 %1, i8 %2, i8 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo551(ptr noalias sret(%struct.point551) align 1 %0, i32 %1, i8 %2, i8 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo552(ptr noalias sret(%struct.point552) align 1 %0, i32 %1, i8 %2, i8 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point553 @demo553(i32 %0, i8 %1, i8 %2, i16 %3) #0 {
+  ret %struct.point553 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo554(ptr noalias sret(%struct.point554) align 1 %0, i32 %1, i8 %2, i8 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo555(ptr noalias sret(%struct.point555) align 1 %0, i32 %1, i8 %2, i8 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo556(ptr noalias sret(%struct.point556) align 1 %0, i32 %1, i8 %2, i8 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo557(ptr noalias sret(%struct.point557) align 1 %0, i32 %1, i8 %2, i8 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo558(ptr noalias sret(%struct.point558) align 1 %0, i32 %1, i8 %2, i8 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo559(ptr noalias sret(%struct.point559) align 1 %0, i32 %1, i8 %2, i8 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo560(ptr noalias sret(%struct.point560) align 1 %0, i32 %1, i8 %2, i8 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo561(ptr noalias sret(%struct.point561) align 1 %0, i32 %1, i8 %2, i8 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo562(ptr noalias sret(%struct.point562) align 1 %0, i32 %1, i8 %2, i8 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo563(ptr noalias sret(%struct.point563) align 1 %0, i32 %1, i8 %2, i8 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo564(ptr noalias sret(%struct.point564) align 1 %0, i32 %1, i8 %2, i8 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo565(ptr noalias sret(%struct.point565) align 1 %0, i32 %1, i8 %2, i8 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo566(ptr noalias sret(%struct.point566) align 1 %0, i32 %1, i8 %2, i8 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo567(ptr noalias sret(%struct.point567) align 1 %0, i32 %1, i8 %2, i8 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point568 @demo568(i32 %0, i8 %1, i16 %2) #0 {
+  ret %struct.point568 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point569 @demo569(i32 %0, i8 %1, i16 %2, i8 %3) #0 {
+  ret %struct.point569 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo570(ptr noalias sret(%struct.point570) align 1 %0, i32 %1, i8 %2, i16 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo571(ptr noalias sret(%struct.point571) align 1 %0, i32 %1, i8 %2, i16 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo572(ptr noalias sret(%struct.point572) align 1 %0, i32 %1, i8 %2, i16 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo573(ptr noalias sret(%struct.point573) align 1 %0, i32 %1, i8 %2, i16 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo574(ptr noalias sret(%struct.point574) align 1 %0, i32 %1, i8 %2, i16 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo575(ptr noalias sret(%struct.point575) align 1 %0, i32 %1, i8 %2, i16 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo576(ptr noalias sret(%struct.point576) align 1 %0, i32 %1, i8 %2, i16 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo577(ptr noalias sret(%struct.point577) align 1 %0, i32 %1, i8 %2, i16 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo578(ptr noalias sret(%struct.point578) align 1 %0, i32 %1, i8 %2, i16 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo579(ptr noalias sret(%struct.point579) align 1 %0, i32 %1, i8 %2, i16 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo580(ptr noalias sret(%struct.point580) align 1 %0, i32 %1, i8 %2, i16 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo581(ptr noalias sret(%struct.point581) align 1 %0, i32 %1, i8 %2, i16 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo582(ptr noalias sret(%struct.point582) align 1 %0, i32 %1, i8 %2, i16 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo583(ptr noalias sret(%struct.point583) align 1 %0, i32 %1, i8 %2, i16 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo584(ptr noalias sret(%struct.point584) align 1 %0, i32 %1, i8 %2, i16 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo585(ptr noalias sret(%struct.point585) align 1 %0, i32 %1, i8 %2, i16 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo586(ptr noalias sret(%struct.point586) align 1 %0, i32 %1, i8 %2, i16 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo587(ptr noalias sret(%struct.point587) align 1 %0, i32 %1, i8 %2, i16 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo588(ptr noalias sret(%struct.point588) align 1 %0, i32 %1, i8 %2, i16 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo589(ptr noalias sret(%struct.point589) align 1 %0, i32 %1, i8 %2, i32 %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo590(ptr noalias sret(%struct.point590) align 1 %0, i32 %1, i8 %2, i32 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo591(ptr noalias sret(%struct.point591) align 1 %0, i32 %1, i8 %2, i32 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo592(ptr noalias sret(%struct.point592) align 1 %0, i32 %1, i8 %2, i32 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo593(ptr noalias sret(%struct.point593) align 1 %0, i32 %1, i8 %2, i32 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo594(ptr noalias sret(%struct.point594) align 1 %0, i32 %1, i8 %2, i32 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo595(ptr noalias sret(%struct.point595) align 1 %0, i32 %1, i8 %2, i32 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo596(ptr noalias sret(%struct.point596) align 1 %0, i32 %1, i8 %2, i32 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo597(ptr noalias sret(%struct.point597) align 1 %0, i32 %1, i8 %2, i32 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo598(ptr noalias sret(%struct.point598) align 1 %0, i32 %1, i8 %2, i32 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo599(ptr noalias sret(%struct.point599) align 1 %0, i32 %1, i8 %2, i32 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo600(ptr noalias sret(%struct.point600) align 1 %0, i32 %1, i8 %2, i32 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo601(ptr noalias sret(%struct.point601) align 1 %0, i32 %1, i8 %2, i32 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo602(ptr noalias sret(%struct.point602) align 1 %0, i32 %1, i8 %2, i32 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo603(ptr noalias sret(%struct.point603) align 1 %0, ptr noundef byval(%struct.point603) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo604(ptr noalias sret(%struct.point604) align 1 %0, ptr noundef byval(%struct.point604) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo605(ptr noalias sret(%struct.point605) align 1 %0, i32 %1, i8 %2, i32 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo606(ptr noalias sret(%struct.point606) align 1 %0, i32 %1, i8 %2, i32 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo607(ptr noalias sret(%struct.point607) align 1 %0, i32 %1, i8 %2, i32 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo608(ptr noalias sret(%struct.point608) align 1 %0, ptr noundef byval(%struct.point608) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo609(ptr noalias sret(%struct.point609) align 1 %0, ptr noundef byval(%struct.point609) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo610(ptr noalias sret(%struct.point610) align 1 %0, i32 %1, i8 %2, float %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo611(ptr noalias sret(%struct.point611) align 1 %0, i32 %1, i8 %2, float %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo612(ptr noalias sret(%struct.point612) align 1 %0, i32 %1, i8 %2, float %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo613(ptr noalias sret(%struct.point613) align 1 %0, i32 %1, i8 %2, float %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo614(ptr noalias sret(%struct.point614) align 1 %0, i32 %1, i8 %2, float %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo615(ptr noalias sret(%struct.point615) align 1 %0, i32 %1, i8 %2, float %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo616(ptr noalias sret(%struct.point616) align 1 %0, i32 %1, i8 %2, float %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo617(ptr noalias sret(%struct.point617) align 1 %0, i32 %1, i8 %2, float %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo618(ptr noalias sret(%struct.point618) align 1 %0, i32 %1, i8 %2, float %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo619(ptr noalias sret(%struct.point619) align 1 %0, i32 %1, i8 %2, float %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo620(ptr noalias sret(%struct.point620) align 1 %0, i32 %1, i8 %2, float %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo621(ptr noalias sret(%struct.point621) align 1 %0, i32 %1, i8 %2, float %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo622(ptr noalias sret(%struct.point622) align 1 %0, i32 %1, i8 %2, float %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo623(ptr noalias sret(%struct.point623) align 1 %0, i32 %1, i8 %2, float %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo624(ptr noalias sret(%struct.point624) align 1 %0, ptr noundef byval(%struct.point624) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo625(ptr noalias sret(%struct.point625) align 1 %0, ptr noundef byval(%struct.point625) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo626(ptr noalias sret(%struct.point626) align 1 %0, i32 %1, i8 %2, float %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo627(ptr noalias sret(%struct.point627) align 1 %0, i32 %1, i8 %2, float %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo628(ptr noalias sret(%struct.point628) align 1 %0, i32 %1, i8 %2, float %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo629(ptr noalias sret(%struct.point629) align 1 %0, ptr noundef byval(%struct.point629) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo630(ptr noalias sret(%struct.point630) align 1 %0, ptr noundef byval(%struct.point630) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point631 @demo631(i32 %0, i16 %1, i8 %2) #0 {
+  ret %struct.point631 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point632 @demo632(i32 %0, i16 %1, i8 %2, i8 %3) #0 {
+  ret %struct.point632 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo633(ptr noalias sret(%struct.point633) align 1 %0, i32 %1, i16 %2, i8 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo634(ptr noalias sret(%struct.point634) align 1 %0, i32 %1, i16 %2, i8 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo635(ptr noalias sret(%struct.point635) align 1 %0, i32 %1, i16 %2, i8 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo636(ptr noalias sret(%struct.point636) align 1 %0, i32 %1, i16 %2, i8 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo637(ptr noalias sret(%struct.point637) align 1 %0, i32 %1, i16 %2, i8 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo638(ptr noalias sret(%struct.point638) align 1 %0, i32 %1, i16 %2, i8 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo639(ptr noalias sret(%struct.point639) align 1 %0, i32 %1, i16 %2, i8 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo640(ptr noalias sret(%struct.point640) align 1 %0, i32 %1, i16 %2, i8 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo641(ptr noalias sret(%struct.point641) align 1 %0, i32 %1, i16 %2, i8 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo642(ptr noalias sret(%struct.point642) align 1 %0, i32 %1, i16 %2, i8 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo643(ptr noalias sret(%struct.point643) align 1 %0, i32 %1, i16 %2, i8 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo644(ptr noalias sret(%struct.point644) align 1 %0, i32 %1, i16 %2, i8 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo645(ptr noalias sret(%struct.point645) align 1 %0, i32 %1, i16 %2, i8 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo646(ptr noalias sret(%struct.point646) align 1 %0, i32 %1, i16 %2, i8 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo647(ptr noalias sret(%struct.point647) align 1 %0, i32 %1, i16 %2, i8 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo648(ptr noalias sret(%struct.point648) align 1 %0, i32 %1, i16 %2, i8 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo649(ptr noalias sret(%struct.point649) align 1 %0, i32 %1, i16 %2, i8 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo650(ptr noalias sret(%struct.point650) align 1 %0, i32 %1, i16 %2, i8 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo651(ptr noalias sret(%struct.point651) align 1 %0, i32 %1, i16 %2, i8 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point652 @demo652(i32 %0, i16 %1, i16 %2) #0 {
+  ret %struct.point652 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo653(ptr noalias sret(%struct.point653) align 1 %0, i32 %1, i16 %2, i16 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo654(ptr noalias sret(%struct.point654) align 1 %0, i32 %1, i16 %2, i16 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo655(ptr noalias sret(%struct.point655) align 1 %0, i32 %1, i16 %2, i16 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo656(ptr noalias sret(%struct.point656) align 1 %0, i32 %1, i16 %2, i16 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo657(ptr noalias sret(%struct.point657) align 1 %0, i32 %1, i16 %2, i16 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo658(ptr noalias sret(%struct.point658) align 1 %0, i32 %1, i16 %2, i16 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo659(ptr noalias sret(%struct.point659) align 1 %0, i32 %1, i16 %2, i16 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo660(ptr noalias sret(%struct.point660) align 1 %0, i32 %1, i16 %2, i16 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo661(ptr noalias sret(%struct.point661) align 1 %0, i32 %1, i16 %2, i16 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo662(ptr noalias sret(%struct.point662) align 1 %0, i32 %1, i16 %2, i16 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo663(ptr noalias sret(%struct.point663) align 1 %0, i32 %1, i16 %2, i16 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo664(ptr noalias sret(%struct.point664) align 1 %0, i32 %1, i16 %2, i16 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo665(ptr noalias sret(%struct.point665) align 1 %0, i32 %1, i16 %2, i16 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo666(ptr noalias sret(%struct.point666) align 1 %0, i32 %1, i16 %2, i16 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo667(ptr noalias sret(%struct.point667) align 1 %0, i32 %1, i16 %2, i16 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo668(ptr noalias sret(%struct.point668) align 1 %0, i32 %1, i16 %2, i16 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo669(ptr noalias sret(%struct.point669) align 1 %0, i32 %1, i16 %2, i16 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo670(ptr noalias sret(%struct.point670) align 1 %0, i32 %1, i16 %2, i16 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo671(ptr noalias sret(%struct.point671) align 1 %0, i32 %1, i16 %2, i16 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo672(ptr noalias sret(%struct.point672) align 1 %0, i32 %1, i16 %2, i16 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo673(ptr noalias sret(%struct.point673) align 1 %0, i32 %1, i16 %2, i32 %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo674(ptr noalias sret(%struct.point674) align 1 %0, i32 %1, i16 %2, i32 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo675(ptr noalias sret(%struct.point675) align 1 %0, i32 %1, i16 %2, i32 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo676(ptr noalias sret(%struct.point676) align 1 %0, i32 %1, i16 %2, i32 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo677(ptr noalias sret(%struct.point677) align 1 %0, i32 %1, i16 %2, i32 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo678(ptr noalias sret(%struct.point678) align 1 %0, i32 %1, i16 %2, i32 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo679(ptr noalias sret(%struct.point679) align 1 %0, i32 %1, i16 %2, i32 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo680(ptr noalias sret(%struct.point680) align 1 %0, i32 %1, i16 %2, i32 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo681(ptr noalias sret(%struct.point681) align 1 %0, i32 %1, i16 %2, i32 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo682(ptr noalias sret(%struct.point682) align 1 %0, i32 %1, i16 %2, i32 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo683(ptr noalias sret(%struct.point683) align 1 %0, i32 %1, i16 %2, i32 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo684(ptr noalias sret(%struct.point684) align 1 %0, i32 %1, i16 %2, i32 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo685(ptr noalias sret(%struct.point685) align 1 %0, i32 %1, i16 %2, i32 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo686(ptr noalias sret(%struct.point686) align 1 %0, i32 %1, i16 %2, i32 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo687(ptr noalias sret(%struct.point687) align 1 %0, ptr noundef byval(%struct.point687) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo688(ptr noalias sret(%struct.point688) align 1 %0, ptr noundef byval(%struct.point688) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo689(ptr noalias sret(%struct.point689) align 1 %0, i32 %1, i16 %2, i32 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo690(ptr noalias sret(%struct.point690) align 1 %0, i32 %1, i16 %2, i32 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo691(ptr noalias sret(%struct.point691) align 1 %0, i32 %1, i16 %2, i32 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo692(ptr noalias sret(%struct.point692) align 1 %0, ptr noundef byval(%struct.point692) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo693(ptr noalias sret(%struct.point693) align 1 %0, ptr noundef byval(%struct.point693) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo694(ptr noalias sret(%struct.point694) align 1 %0, i32 %1, i16 %2, float %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo695(ptr noalias sret(%struct.point695) align 1 %0, i32 %1, i16 %2, float %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo696(ptr noalias sret(%struct.point696) align 1 %0, i32 %1, i16 %2, float %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo697(ptr noalias sret(%struct.point697) align 1 %0, i32 %1, i16 %2, float %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo698(ptr noalias sret(%struct.point698) align 1 %0, i32 %1, i16 %2, float %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo699(ptr noalias sret(%struct.point699) align 1 %0, i32 %1, i16 %2, float %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo700(ptr noalias sret(%struct.point700) align 1 %0, i32 %1, i16 %2, float %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo701(ptr noalias sret(%struct.point701) align 1 %0, i32 %1, i16 %2, float %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo702(ptr noalias sret(%struct.point702) align 1 %0, i32 %1, i16 %2, float %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo703(ptr noalias sret(%struct.point703) align 1 %0, i32 %1, i16 %2, float %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo704(ptr noalias sret(%struct.point704) align 1 %0, i32 %1, i16 %2, float %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo705(ptr noalias sret(%struct.point705) align 1 %0, i32 %1, i16 %2, float %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo706(ptr noalias sret(%struct.point706) align 1 %0, i32 %1, i16 %2, float %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo707(ptr noalias sret(%struct.point707) align 1 %0, i32 %1, i16 %2, float %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo708(ptr noalias sret(%struct.point708) align 1 %0, ptr noundef byval(%struct.point708) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo709(ptr noalias sret(%struct.point709) align 1 %0, ptr noundef byval(%struct.point709) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo710(ptr noalias sret(%struct.point710) align 1 %0, i32 %1, i16 %2, float %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo711(ptr noalias sret(%struct.point711) align 1 %0, i32 %1, i16 %2, float %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo712(ptr noalias sret(%struct.point712) align 1 %0, i32 %1, i16 %2, float %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo713(ptr noalias sret(%struct.point713) align 1 %0, ptr noundef byval(%struct.point713) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo714(ptr noalias sret(%struct.point714) align 1 %0, ptr noundef byval(%struct.point714) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo715(ptr noalias sret(%struct.point715) align 1 %0, i32 %1, float %2, i8 %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo716(ptr noalias sret(%struct.point716) align 1 %0, i32 %1, float %2, i8 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo717(ptr noalias sret(%struct.point717) align 1 %0, i32 %1, float %2, i8 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo718(ptr noalias sret(%struct.point718) align 1 %0, i32 %1, float %2, i8 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo719(ptr noalias sret(%struct.point719) align 1 %0, i32 %1, float %2, i8 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo720(ptr noalias sret(%struct.point720) align 1 %0, i32 %1, float %2, i8 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo721(ptr noalias sret(%struct.point721) align 1 %0, i32 %1, float %2, i8 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo722(ptr noalias sret(%struct.point722) align 1 %0, i32 %1, float %2, i8 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo723(ptr noalias sret(%struct.point723) align 1 %0, i32 %1, float %2, i8 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo724(ptr noalias sret(%struct.point724) align 1 %0, i32 %1, float %2, i8 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo725(ptr noalias sret(%struct.point725) align 1 %0, i32 %1, float %2, i8 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo726(ptr noalias sret(%struct.point726) align 1 %0, i32 %1, float %2, i8 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo727(ptr noalias sret(%struct.point727) align 1 %0, i32 %1, float %2, i8 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo728(ptr noalias sret(%struct.point728) align 1 %0, i32 %1, float %2, i8 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo729(ptr noalias sret(%struct.point729) align 1 %0, ptr noundef byval(%struct.point729) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo730(ptr noalias sret(%struct.point730) align 1 %0, ptr noundef byval(%struct.point730) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo731(ptr noalias sret(%struct.point731) align 1 %0, i32 %1, float %2, i8 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo732(ptr noalias sret(%struct.point732) align 1 %0, i32 %1, float %2, i8 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo733(ptr noalias sret(%struct.point733) align 1 %0, i32 %1, float %2, i8 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo734(ptr noalias sret(%struct.point734) align 1 %0, ptr noundef byval(%struct.point734) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo735(ptr noalias sret(%struct.point735) align 1 %0, ptr noundef byval(%struct.point735) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo736(ptr noalias sret(%struct.point736) align 1 %0, i32 %1, float %2, i16 %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo737(ptr noalias sret(%struct.point737) align 1 %0, i32 %1, float %2, i16 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo738(ptr noalias sret(%struct.point738) align 1 %0, i32 %1, float %2, i16 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo739(ptr noalias sret(%struct.point739) align 1 %0, i32 %1, float %2, i16 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo740(ptr noalias sret(%struct.point740) align 1 %0, i32 %1, float %2, i16 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo741(ptr noalias sret(%struct.point741) align 1 %0, i32 %1, float %2, i16 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo742(ptr noalias sret(%struct.point742) align 1 %0, i32 %1, float %2, i16 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo743(ptr noalias sret(%struct.point743) align 1 %0, i32 %1, float %2, i16 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo744(ptr noalias sret(%struct.point744) align 1 %0, i32 %1, float %2, i16 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo745(ptr noalias sret(%struct.point745) align 1 %0, i32 %1, float %2, i16 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo746(ptr noalias sret(%struct.point746) align 1 %0, i32 %1, float %2, i16 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo747(ptr noalias sret(%struct.point747) align 1 %0, i32 %1, float %2, i16 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo748(ptr noalias sret(%struct.point748) align 1 %0, i32 %1, float %2, i16 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo749(ptr noalias sret(%struct.point749) align 1 %0, i32 %1, float %2, i16 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo750(ptr noalias sret(%struct.point750) align 1 %0, ptr noundef byval(%struct.point750) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo751(ptr noalias sret(%struct.point751) align 1 %0, ptr noundef byval(%struct.point751) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo752(ptr noalias sret(%struct.point752) align 1 %0, i32 %1, float %2, i16 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo753(ptr noalias sret(%struct.point753) align 1 %0, i32 %1, float %2, i16 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo754(ptr noalias sret(%struct.point754) align 1 %0, i32 %1, float %2, i16 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo755(ptr noalias sret(%struct.point755) align 1 %0, ptr noundef byval(%struct.point755) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo756(ptr noalias sret(%struct.point756) align 1 %0, ptr noundef byval(%struct.point756) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo757(ptr noalias sret(%struct.point757) align 1 %0, i32 %1, float %2, i32 %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo758(ptr noalias sret(%struct.point758) align 1 %0, i32 %1, float %2, i32 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo759(ptr noalias sret(%struct.point759) align 1 %0, i32 %1, float %2, i32 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo760(ptr noalias sret(%struct.point760) align 1 %0, i32 %1, float %2, i32 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo761(ptr noalias sret(%struct.point761) align 1 %0, ptr noundef byval(%struct.point761) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo762(ptr noalias sret(%struct.point762) align 1 %0, ptr noundef byval(%struct.point762) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo763(ptr noalias sret(%struct.point763) align 1 %0, i32 %1, float %2, i32 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo764(ptr noalias sret(%struct.point764) align 1 %0, i32 %1, float %2, i32 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo765(ptr noalias sret(%struct.point765) align 1 %0, i32 %1, float %2, i32 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo766(ptr noalias sret(%struct.point766) align 1 %0, ptr noundef byval(%struct.point766) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo767(ptr noalias sret(%struct.point767) align 1 %0, ptr noundef byval(%struct.point767) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo768(ptr noalias sret(%struct.point768) align 1 %0, i32 %1, float %2, i32 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo769(ptr noalias sret(%struct.point769) align 1 %0, ptr noundef byval(%struct.point769) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo770(ptr noalias sret(%struct.point770) align 1 %0, ptr noundef byval(%struct.point770) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo771(ptr noalias sret(%struct.point771) align 1 %0, ptr noundef byval(%struct.point771) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo772(ptr noalias sret(%struct.point772) align 1 %0, ptr noundef byval(%struct.point772) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo773(ptr noalias sret(%struct.point773) align 1 %0, i32 %1, float %2, i32 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo774(ptr noalias sret(%struct.point774) align 1 %0, ptr noundef byval(%struct.point774) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo775(ptr noalias sret(%struct.point775) align 1 %0, ptr noundef byval(%struct.point775) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo776(ptr noalias sret(%struct.point776) align 1 %0, ptr noundef byval(%struct.point776) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo777(ptr noalias sret(%struct.point777) align 1 %0, ptr noundef byval(%struct.point777) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo778(ptr noalias sret(%struct.point778) align 1 %0, i32 %1, float %2, float %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo779(ptr noalias sret(%struct.point779) align 1 %0, i32 %1, float %2, float %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo780(ptr noalias sret(%struct.point780) align 1 %0, i32 %1, float %2, float %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo781(ptr noalias sret(%struct.point781) align 1 %0, i32 %1, float %2, float %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo782(ptr noalias sret(%struct.point782) align 1 %0, ptr noundef byval(%struct.point782) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo783(ptr noalias sret(%struct.point783) align 1 %0, ptr noundef byval(%struct.point783) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo784(ptr noalias sret(%struct.point784) align 1 %0, i32 %1, float %2, float %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo785(ptr noalias sret(%struct.point785) align 1 %0, i32 %1, float %2, float %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo786(ptr noalias sret(%struct.point786) align 1 %0, i32 %1, float %2, float %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo787(ptr noalias sret(%struct.point787) align 1 %0, ptr noundef byval(%struct.point787) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo788(ptr noalias sret(%struct.point788) align 1 %0, ptr noundef byval(%struct.point788) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo789(ptr noalias sret(%struct.point789) align 1 %0, i32 %1, float %2, float %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo790(ptr noalias sret(%struct.point790) align 1 %0, ptr noundef byval(%struct.point790) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo791(ptr noalias sret(%struct.point791) align 1 %0, ptr noundef byval(%struct.point791) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo792(ptr noalias sret(%struct.point792) align 1 %0, ptr noundef byval(%struct.point792) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo793(ptr noalias sret(%struct.point793) align 1 %0, ptr noundef byval(%struct.point793) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo794(ptr noalias sret(%struct.point794) align 1 %0, i32 %1, float %2, float %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo795(ptr noalias sret(%struct.point795) align 1 %0, ptr noundef byval(%struct.point795) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo796(ptr noalias sret(%struct.point796) align 1 %0, ptr noundef byval(%struct.point796) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo797(ptr noalias sret(%struct.point797) align 1 %0, ptr noundef byval(%struct.point797) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo798(ptr noalias sret(%struct.point798) align 1 %0, ptr noundef byval(%struct.point798) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point799 @demo799(float %0, i8 %1, i8 %2) #0 {
+  ret %struct.point799 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point800 @demo800(float %0, i8 %1, i8 %2, i8 %3) #0 {
+  ret %struct.point800 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point801 @demo801(float %0, i8 %1, i8 %2, i8 %3, i8 %4) #0 {
+  ret %struct.point801 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo802(ptr noalias sret(%struct.point802) align 1 %0, float %1, i8 %2, i8 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo803(ptr noalias sret(%struct.point803) align 1 %0, float %1, i8 %2, i8 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo804(ptr noalias sret(%struct.point804) align 1 %0, float %1, i8 %2, i8 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point805 @demo805(float %0, i8 %1, i8 %2, i16 %3) #0 {
+  ret %struct.point805 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo806(ptr noalias sret(%struct.point806) align 1 %0, float %1, i8 %2, i8 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo807(ptr noalias sret(%struct.point807) align 1 %0, float %1, i8 %2, i8 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo808(ptr noalias sret(%struct.point808) align 1 %0, float %1, i8 %2, i8 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo809(ptr noalias sret(%struct.point809) align 1 %0, float %1, i8 %2, i8 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo810(ptr noalias sret(%struct.point810) align 1 %0, float %1, i8 %2, i8 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo811(ptr noalias sret(%struct.point811) align 1 %0, float %1, i8 %2, i8 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo812(ptr noalias sret(%struct.point812) align 1 %0, float %1, i8 %2, i8 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo813(ptr noalias sret(%struct.point813) align 1 %0, float %1, i8 %2, i8 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo814(ptr noalias sret(%struct.point814) align 1 %0, float %1, i8 %2, i8 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo815(ptr noalias sret(%struct.point815) align 1 %0, float %1, i8 %2, i8 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo816(ptr noalias sret(%struct.point816) align 1 %0, float %1, i8 %2, i8 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo817(ptr noalias sret(%struct.point817) align 1 %0, float %1, i8 %2, i8 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo818(ptr noalias sret(%struct.point818) align 1 %0, float %1, i8 %2, i8 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo819(ptr noalias sret(%struct.point819) align 1 %0, float %1, i8 %2, i8 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point820 @demo820(float %0, i8 %1, i16 %2) #0 {
+  ret %struct.point820 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point821 @demo821(float %0, i8 %1, i16 %2, i8 %3) #0 {
+  ret %struct.point821 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo822(ptr noalias sret(%struct.point822) align 1 %0, float %1, i8 %2, i16 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo823(ptr noalias sret(%struct.point823) align 1 %0, float %1, i8 %2, i16 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo824(ptr noalias sret(%struct.point824) align 1 %0, float %1, i8 %2, i16 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo825(ptr noalias sret(%struct.point825) align 1 %0, float %1, i8 %2, i16 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo826(ptr noalias sret(%struct.point826) align 1 %0, float %1, i8 %2, i16 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo827(ptr noalias sret(%struct.point827) align 1 %0, float %1, i8 %2, i16 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo828(ptr noalias sret(%struct.point828) align 1 %0, float %1, i8 %2, i16 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo829(ptr noalias sret(%struct.point829) align 1 %0, float %1, i8 %2, i16 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo830(ptr noalias sret(%struct.point830) align 1 %0, float %1, i8 %2, i16 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo831(ptr noalias sret(%struct.point831) align 1 %0, float %1, i8 %2, i16 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo832(ptr noalias sret(%struct.point832) align 1 %0, float %1, i8 %2, i16 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo833(ptr noalias sret(%struct.point833) align 1 %0, float %1, i8 %2, i16 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo834(ptr noalias sret(%struct.point834) align 1 %0, float %1, i8 %2, i16 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo835(ptr noalias sret(%struct.point835) align 1 %0, float %1, i8 %2, i16 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo836(ptr noalias sret(%struct.point836) align 1 %0, float %1, i8 %2, i16 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo837(ptr noalias sret(%struct.point837) align 1 %0, float %1, i8 %2, i16 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo838(ptr noalias sret(%struct.point838) align 1 %0, float %1, i8 %2, i16 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo839(ptr noalias sret(%struct.point839) align 1 %0, float %1, i8 %2, i16 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo840(ptr noalias sret(%struct.point840) align 1 %0, float %1, i8 %2, i16 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo841(ptr noalias sret(%struct.point841) align 1 %0, float %1, i8 %2, i32 %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo842(ptr noalias sret(%struct.point842) align 1 %0, float %1, i8 %2, i32 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo843(ptr noalias sret(%struct.point843) align 1 %0, float %1, i8 %2, i32 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo844(ptr noalias sret(%struct.point844) align 1 %0, float %1, i8 %2, i32 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo845(ptr noalias sret(%struct.point845) align 1 %0, float %1, i8 %2, i32 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo846(ptr noalias sret(%struct.point846) align 1 %0, float %1, i8 %2, i32 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo847(ptr noalias sret(%struct.point847) align 1 %0, float %1, i8 %2, i32 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo848(ptr noalias sret(%struct.point848) align 1 %0, float %1, i8 %2, i32 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo849(ptr noalias sret(%struct.point849) align 1 %0, float %1, i8 %2, i32 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo850(ptr noalias sret(%struct.point850) align 1 %0, float %1, i8 %2, i32 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo851(ptr noalias sret(%struct.point851) align 1 %0, float %1, i8 %2, i32 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo852(ptr noalias sret(%struct.point852) align 1 %0, float %1, i8 %2, i32 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo853(ptr noalias sret(%struct.point853) align 1 %0, float %1, i8 %2, i32 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo854(ptr noalias sret(%struct.point854) align 1 %0, float %1, i8 %2, i32 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo855(ptr noalias sret(%struct.point855) align 1 %0, ptr noundef byval(%struct.point855) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo856(ptr noalias sret(%struct.point856) align 1 %0, ptr noundef byval(%struct.point856) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo857(ptr noalias sret(%struct.point857) align 1 %0, float %1, i8 %2, i32 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo858(ptr noalias sret(%struct.point858) align 1 %0, float %1, i8 %2, i32 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo859(ptr noalias sret(%struct.point859) align 1 %0, float %1, i8 %2, i32 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo860(ptr noalias sret(%struct.point860) align 1 %0, ptr noundef byval(%struct.point860) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo861(ptr noalias sret(%struct.point861) align 1 %0, ptr noundef byval(%struct.point861) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo862(ptr noalias sret(%struct.point862) align 1 %0, float %1, i8 %2, float %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo863(ptr noalias sret(%struct.point863) align 1 %0, float %1, i8 %2, float %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo864(ptr noalias sret(%struct.point864) align 1 %0, float %1, i8 %2, float %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo865(ptr noalias sret(%struct.point865) align 1 %0, float %1, i8 %2, float %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo866(ptr noalias sret(%struct.point866) align 1 %0, float %1, i8 %2, float %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo867(ptr noalias sret(%struct.point867) align 1 %0, float %1, i8 %2, float %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo868(ptr noalias sret(%struct.point868) align 1 %0, float %1, i8 %2, float %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo869(ptr noalias sret(%struct.point869) align 1 %0, float %1, i8 %2, float %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo870(ptr noalias sret(%struct.point870) align 1 %0, float %1, i8 %2, float %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo871(ptr noalias sret(%struct.point871) align 1 %0, float %1, i8 %2, float %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo872(ptr noalias sret(%struct.point872) align 1 %0, float %1, i8 %2, float %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo873(ptr noalias sret(%struct.point873) align 1 %0, float %1, i8 %2, float %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo874(ptr noalias sret(%struct.point874) align 1 %0, float %1, i8 %2, float %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo875(ptr noalias sret(%struct.point875) align 1 %0, float %1, i8 %2, float %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo876(ptr noalias sret(%struct.point876) align 1 %0, ptr noundef byval(%struct.point876) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo877(ptr noalias sret(%struct.point877) align 1 %0, ptr noundef byval(%struct.point877) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo878(ptr noalias sret(%struct.point878) align 1 %0, float %1, i8 %2, float %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo879(ptr noalias sret(%struct.point879) align 1 %0, float %1, i8 %2, float %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo880(ptr noalias sret(%struct.point880) align 1 %0, float %1, i8 %2, float %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo881(ptr noalias sret(%struct.point881) align 1 %0, ptr noundef byval(%struct.point881) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo882(ptr noalias sret(%struct.point882) align 1 %0, ptr noundef byval(%struct.point882) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point883 @demo883(float %0, i16 %1, i8 %2) #0 {
+  ret %struct.point883 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point884 @demo884(float %0, i16 %1, i8 %2, i8 %3) #0 {
+  ret %struct.point884 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo885(ptr noalias sret(%struct.point885) align 1 %0, float %1, i16 %2, i8 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo886(ptr noalias sret(%struct.point886) align 1 %0, float %1, i16 %2, i8 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo887(ptr noalias sret(%struct.point887) align 1 %0, float %1, i16 %2, i8 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo888(ptr noalias sret(%struct.point888) align 1 %0, float %1, i16 %2, i8 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo889(ptr noalias sret(%struct.point889) align 1 %0, float %1, i16 %2, i8 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo890(ptr noalias sret(%struct.point890) align 1 %0, float %1, i16 %2, i8 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo891(ptr noalias sret(%struct.point891) align 1 %0, float %1, i16 %2, i8 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo892(ptr noalias sret(%struct.point892) align 1 %0, float %1, i16 %2, i8 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo893(ptr noalias sret(%struct.point893) align 1 %0, float %1, i16 %2, i8 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo894(ptr noalias sret(%struct.point894) align 1 %0, float %1, i16 %2, i8 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo895(ptr noalias sret(%struct.point895) align 1 %0, float %1, i16 %2, i8 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo896(ptr noalias sret(%struct.point896) align 1 %0, float %1, i16 %2, i8 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo897(ptr noalias sret(%struct.point897) align 1 %0, float %1, i16 %2, i8 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo898(ptr noalias sret(%struct.point898) align 1 %0, float %1, i16 %2, i8 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo899(ptr noalias sret(%struct.point899) align 1 %0, float %1, i16 %2, i8 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo900(ptr noalias sret(%struct.point900) align 1 %0, float %1, i16 %2, i8 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo901(ptr noalias sret(%struct.point901) align 1 %0, float %1, i16 %2, i8 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo902(ptr noalias sret(%struct.point902) align 1 %0, float %1, i16 %2, i8 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo903(ptr noalias sret(%struct.point903) align 1 %0, float %1, i16 %2, i8 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local %struct.point904 @demo904(float %0, i16 %1, i16 %2) #0 {
+  ret %struct.point904 zeroinitializer
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo905(ptr noalias sret(%struct.point905) align 1 %0, float %1, i16 %2, i16 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo906(ptr noalias sret(%struct.point906) align 1 %0, float %1, i16 %2, i16 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo907(ptr noalias sret(%struct.point907) align 1 %0, float %1, i16 %2, i16 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo908(ptr noalias sret(%struct.point908) align 1 %0, float %1, i16 %2, i16 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo909(ptr noalias sret(%struct.point909) align 1 %0, float %1, i16 %2, i16 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo910(ptr noalias sret(%struct.point910) align 1 %0, float %1, i16 %2, i16 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo911(ptr noalias sret(%struct.point911) align 1 %0, float %1, i16 %2, i16 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo912(ptr noalias sret(%struct.point912) align 1 %0, float %1, i16 %2, i16 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo913(ptr noalias sret(%struct.point913) align 1 %0, float %1, i16 %2, i16 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo914(ptr noalias sret(%struct.point914) align 1 %0, float %1, i16 %2, i16 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo915(ptr noalias sret(%struct.point915) align 1 %0, float %1, i16 %2, i16 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo916(ptr noalias sret(%struct.point916) align 1 %0, float %1, i16 %2, i16 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo917(ptr noalias sret(%struct.point917) align 1 %0, float %1, i16 %2, i16 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo918(ptr noalias sret(%struct.point918) align 1 %0, float %1, i16 %2, i16 %3, i32 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo919(ptr noalias sret(%struct.point919) align 1 %0, float %1, i16 %2, i16 %3, i32 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo920(ptr noalias sret(%struct.point920) align 1 %0, float %1, i16 %2, i16 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo921(ptr noalias sret(%struct.point921) align 1 %0, float %1, i16 %2, i16 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo922(ptr noalias sret(%struct.point922) align 1 %0, float %1, i16 %2, i16 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo923(ptr noalias sret(%struct.point923) align 1 %0, float %1, i16 %2, i16 %3, float %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo924(ptr noalias sret(%struct.point924) align 1 %0, float %1, i16 %2, i16 %3, float %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo925(ptr noalias sret(%struct.point925) align 1 %0, float %1, i16 %2, i32 %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo926(ptr noalias sret(%struct.point926) align 1 %0, float %1, i16 %2, i32 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo927(ptr noalias sret(%struct.point927) align 1 %0, float %1, i16 %2, i32 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo928(ptr noalias sret(%struct.point928) align 1 %0, float %1, i16 %2, i32 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo929(ptr noalias sret(%struct.point929) align 1 %0, float %1, i16 %2, i32 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo930(ptr noalias sret(%struct.point930) align 1 %0, float %1, i16 %2, i32 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo931(ptr noalias sret(%struct.point931) align 1 %0, float %1, i16 %2, i32 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo932(ptr noalias sret(%struct.point932) align 1 %0, float %1, i16 %2, i32 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo933(ptr noalias sret(%struct.point933) align 1 %0, float %1, i16 %2, i32 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo934(ptr noalias sret(%struct.point934) align 1 %0, float %1, i16 %2, i32 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo935(ptr noalias sret(%struct.point935) align 1 %0, float %1, i16 %2, i32 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo936(ptr noalias sret(%struct.point936) align 1 %0, float %1, i16 %2, i32 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo937(ptr noalias sret(%struct.point937) align 1 %0, float %1, i16 %2, i32 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo938(ptr noalias sret(%struct.point938) align 1 %0, float %1, i16 %2, i32 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo939(ptr noalias sret(%struct.point939) align 1 %0, ptr noundef byval(%struct.point939) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo940(ptr noalias sret(%struct.point940) align 1 %0, ptr noundef byval(%struct.point940) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo941(ptr noalias sret(%struct.point941) align 1 %0, float %1, i16 %2, i32 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo942(ptr noalias sret(%struct.point942) align 1 %0, float %1, i16 %2, i32 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo943(ptr noalias sret(%struct.point943) align 1 %0, float %1, i16 %2, i32 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo944(ptr noalias sret(%struct.point944) align 1 %0, ptr noundef byval(%struct.point944) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo945(ptr noalias sret(%struct.point945) align 1 %0, ptr noundef byval(%struct.point945) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo946(ptr noalias sret(%struct.point946) align 1 %0, float %1, i16 %2, float %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo947(ptr noalias sret(%struct.point947) align 1 %0, float %1, i16 %2, float %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo948(ptr noalias sret(%struct.point948) align 1 %0, float %1, i16 %2, float %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo949(ptr noalias sret(%struct.point949) align 1 %0, float %1, i16 %2, float %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo950(ptr noalias sret(%struct.point950) align 1 %0, float %1, i16 %2, float %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo951(ptr noalias sret(%struct.point951) align 1 %0, float %1, i16 %2, float %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo952(ptr noalias sret(%struct.point952) align 1 %0, float %1, i16 %2, float %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo953(ptr noalias sret(%struct.point953) align 1 %0, float %1, i16 %2, float %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo954(ptr noalias sret(%struct.point954) align 1 %0, float %1, i16 %2, float %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo955(ptr noalias sret(%struct.point955) align 1 %0, float %1, i16 %2, float %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo956(ptr noalias sret(%struct.point956) align 1 %0, float %1, i16 %2, float %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo957(ptr noalias sret(%struct.point957) align 1 %0, float %1, i16 %2, float %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo958(ptr noalias sret(%struct.point958) align 1 %0, float %1, i16 %2, float %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo959(ptr noalias sret(%struct.point959) align 1 %0, float %1, i16 %2, float %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo960(ptr noalias sret(%struct.point960) align 1 %0, ptr noundef byval(%struct.point960) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo961(ptr noalias sret(%struct.point961) align 1 %0, ptr noundef byval(%struct.point961) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo962(ptr noalias sret(%struct.point962) align 1 %0, float %1, i16 %2, float %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo963(ptr noalias sret(%struct.point963) align 1 %0, float %1, i16 %2, float %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo964(ptr noalias sret(%struct.point964) align 1 %0, float %1, i16 %2, float %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo965(ptr noalias sret(%struct.point965) align 1 %0, ptr noundef byval(%struct.point965) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo966(ptr noalias sret(%struct.point966) align 1 %0, ptr noundef byval(%struct.point966) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo967(ptr noalias sret(%struct.point967) align 1 %0, float %1, i32 %2, i8 %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo968(ptr noalias sret(%struct.point968) align 1 %0, float %1, i32 %2, i8 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo969(ptr noalias sret(%struct.point969) align 1 %0, float %1, i32 %2, i8 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo970(ptr noalias sret(%struct.point970) align 1 %0, float %1, i32 %2, i8 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo971(ptr noalias sret(%struct.point971) align 1 %0, float %1, i32 %2, i8 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo972(ptr noalias sret(%struct.point972) align 1 %0, float %1, i32 %2, i8 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo973(ptr noalias sret(%struct.point973) align 1 %0, float %1, i32 %2, i8 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo974(ptr noalias sret(%struct.point974) align 1 %0, float %1, i32 %2, i8 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo975(ptr noalias sret(%struct.point975) align 1 %0, float %1, i32 %2, i8 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo976(ptr noalias sret(%struct.point976) align 1 %0, float %1, i32 %2, i8 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo977(ptr noalias sret(%struct.point977) align 1 %0, float %1, i32 %2, i8 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo978(ptr noalias sret(%struct.point978) align 1 %0, float %1, i32 %2, i8 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo979(ptr noalias sret(%struct.point979) align 1 %0, float %1, i32 %2, i8 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo980(ptr noalias sret(%struct.point980) align 1 %0, float %1, i32 %2, i8 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo981(ptr noalias sret(%struct.point981) align 1 %0, ptr noundef byval(%struct.point981) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo982(ptr noalias sret(%struct.point982) align 1 %0, ptr noundef byval(%struct.point982) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo983(ptr noalias sret(%struct.point983) align 1 %0, float %1, i32 %2, i8 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo984(ptr noalias sret(%struct.point984) align 1 %0, float %1, i32 %2, i8 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo985(ptr noalias sret(%struct.point985) align 1 %0, float %1, i32 %2, i8 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo986(ptr noalias sret(%struct.point986) align 1 %0, ptr noundef byval(%struct.point986) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo987(ptr noalias sret(%struct.point987) align 1 %0, ptr noundef byval(%struct.point987) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo988(ptr noalias sret(%struct.point988) align 1 %0, float %1, i32 %2, i16 %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo989(ptr noalias sret(%struct.point989) align 1 %0, float %1, i32 %2, i16 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo990(ptr noalias sret(%struct.point990) align 1 %0, float %1, i32 %2, i16 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo991(ptr noalias sret(%struct.point991) align 1 %0, float %1, i32 %2, i16 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo992(ptr noalias sret(%struct.point992) align 1 %0, float %1, i32 %2, i16 %3, i8 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo993(ptr noalias sret(%struct.point993) align 1 %0, float %1, i32 %2, i16 %3, i8 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo994(ptr noalias sret(%struct.point994) align 1 %0, float %1, i32 %2, i16 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo995(ptr noalias sret(%struct.point995) align 1 %0, float %1, i32 %2, i16 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo996(ptr noalias sret(%struct.point996) align 1 %0, float %1, i32 %2, i16 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo997(ptr noalias sret(%struct.point997) align 1 %0, float %1, i32 %2, i16 %3, i16 %4, i32 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo998(ptr noalias sret(%struct.point998) align 1 %0, float %1, i32 %2, i16 %3, i16 %4, float %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo999(ptr noalias sret(%struct.point999) align 1 %0, float %1, i32 %2, i16 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1000(ptr noalias sret(%struct.point1000) align 1 %0, float %1, i32 %2, i16 %3, i32 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1001(ptr noalias sret(%struct.point1001) align 1 %0, float %1, i32 %2, i16 %3, i32 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1002(ptr noalias sret(%struct.point1002) align 1 %0, ptr noundef byval(%struct.point1002) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1003(ptr noalias sret(%struct.point1003) align 1 %0, ptr noundef byval(%struct.point1003) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1004(ptr noalias sret(%struct.point1004) align 1 %0, float %1, i32 %2, i16 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1005(ptr noalias sret(%struct.point1005) align 1 %0, float %1, i32 %2, i16 %3, float %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1006(ptr noalias sret(%struct.point1006) align 1 %0, float %1, i32 %2, i16 %3, float %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1007(ptr noalias sret(%struct.point1007) align 1 %0, ptr noundef byval(%struct.point1007) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1008(ptr noalias sret(%struct.point1008) align 1 %0, ptr noundef byval(%struct.point1008) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1009(ptr noalias sret(%struct.point1009) align 1 %0, float %1, i32 %2, i32 %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1010(ptr noalias sret(%struct.point1010) align 1 %0, float %1, i32 %2, i32 %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1011(ptr noalias sret(%struct.point1011) align 1 %0, float %1, i32 %2, i32 %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1012(ptr noalias sret(%struct.point1012) align 1 %0, float %1, i32 %2, i32 %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1013(ptr noalias sret(%struct.point1013) align 1 %0, ptr noundef byval(%struct.point1013) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1014(ptr noalias sret(%struct.point1014) align 1 %0, ptr noundef byval(%struct.point1014) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1015(ptr noalias sret(%struct.point1015) align 1 %0, float %1, i32 %2, i32 %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1016(ptr noalias sret(%struct.point1016) align 1 %0, float %1, i32 %2, i32 %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1017(ptr noalias sret(%struct.point1017) align 1 %0, float %1, i32 %2, i32 %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1018(ptr noalias sret(%struct.point1018) align 1 %0, ptr noundef byval(%struct.point1018) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1019(ptr noalias sret(%struct.point1019) align 1 %0, ptr noundef byval(%struct.point1019) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1020(ptr noalias sret(%struct.point1020) align 1 %0, float %1, i32 %2, i32 %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1021(ptr noalias sret(%struct.point1021) align 1 %0, ptr noundef byval(%struct.point1021) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1022(ptr noalias sret(%struct.point1022) align 1 %0, ptr noundef byval(%struct.point1022) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1023(ptr noalias sret(%struct.point1023) align 1 %0, ptr noundef byval(%struct.point1023) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1024(ptr noalias sret(%struct.point1024) align 1 %0, ptr noundef byval(%struct.point1024) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1025(ptr noalias sret(%struct.point1025) align 1 %0, float %1, i32 %2, i32 %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1026(ptr noalias sret(%struct.point1026) align 1 %0, ptr noundef byval(%struct.point1026) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1027(ptr noalias sret(%struct.point1027) align 1 %0, ptr noundef byval(%struct.point1027) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1028(ptr noalias sret(%struct.point1028) align 1 %0, ptr noundef byval(%struct.point1028) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1029(ptr noalias sret(%struct.point1029) align 1 %0, ptr noundef byval(%struct.point1029) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1030(ptr noalias sret(%struct.point1030) align 1 %0, float %1, i32 %2, float %3) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1031(ptr noalias sret(%struct.point1031) align 1 %0, float %1, i32 %2, float %3, i8 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1032(ptr noalias sret(%struct.point1032) align 1 %0, float %1, i32 %2, float %3, i8 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1033(ptr noalias sret(%struct.point1033) align 1 %0, float %1, i32 %2, float %3, i8 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1034(ptr noalias sret(%struct.point1034) align 1 %0, ptr noundef byval(%struct.point1034) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1035(ptr noalias sret(%struct.point1035) align 1 %0, ptr noundef byval(%struct.point1035) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1036(ptr noalias sret(%struct.point1036) align 1 %0, float %1, i32 %2, float %3, i16 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1037(ptr noalias sret(%struct.point1037) align 1 %0, float %1, i32 %2, float %3, i16 %4, i8 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1038(ptr noalias sret(%struct.point1038) align 1 %0, float %1, i32 %2, float %3, i16 %4, i16 %5) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1039(ptr noalias sret(%struct.point1039) align 1 %0, ptr noundef byval(%struct.point1039) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1040(ptr noalias sret(%struct.point1040) align 1 %0, ptr noundef byval(%struct.point1040) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1041(ptr noalias sret(%struct.point1041) align 1 %0, float %1, i32 %2, float %3, i32 %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1042(ptr noalias sret(%struct.point1042) align 1 %0, ptr noundef byval(%struct.point1042) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1043(ptr noalias sret(%struct.point1043) align 1 %0, ptr noundef byval(%struct.point1043) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1044(ptr noalias sret(%struct.point1044) align 1 %0, ptr noundef byval(%struct.point1044) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1045(ptr noalias sret(%struct.point1045) align 1 %0, ptr noundef byval(%struct.point1045) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1046(ptr noalias sret(%struct.point1046) align 1 %0, float %1, i32 %2, float %3, float %4) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1047(ptr noalias sret(%struct.point1047) align 1 %0, ptr noundef byval(%struct.point1047) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1048(ptr noalias sret(%struct.point1048) align 1 %0, ptr noundef byval(%struct.point1048) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1049(ptr noalias sret(%struct.point1049) align 1 %0, ptr noundef byval(%struct.point1049) align 1 %1) #0 {
+  ret void
+}
+
+; Function Attrs: noinline nounwind optnone
+define dso_local void @demo1050(ptr noalias sret(%struct.point1050) align 1 %0, ptr noundef byval(%struct.point1050) align 1 %1) #0 {
+  ret void
+}
+
+attributes #0 = { noinline nounwind optnone "frame-pointer"="all" "no-trapping-math"="true" "stack-protector-buffer-size"="8" "target-cpu"="atmega328p" }
@@ -0,0 +1,8 @@
+#!/bin/sh
+# C int and double are 16 and 32 bits on avr: -Dint=long and -mdouble=64 give
+# the 32-bit int and 64-bit double the Go demos pass.
+# The checked-in arch/avr fixtures hold only the function signatures, derived
+# from clang's AVR calling convention (AVRABIInfo); this regenerates them in
+# full with a clang built with the AVR backend.
+mkdir -p arch/avr
+(cd arch/avr && clang -target avr -mmcu=atmega328p -mdouble=64 -Dint=long -S -emit-llvm ../../wrap/*.c)
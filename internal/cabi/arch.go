@@ -651,3 +651,310 @@ func (p *TypeInfoEsp32) GetTypeInfo(ctx llvm.Context, ftyp llvm.Type, typ llvm.T
 	}
 	return info
 }
+
+// TypeInfoAvr implements the avr-gcc calling convention. Arguments are
+// allocated downwards from r25 to r8, each one rounded up to an even number
+// of registers (so 8-bit scalars take a register pair like 16-bit ones). An
+// argument that doesn't fit in the remaining registers is passed on the
+// stack, and so are all the following arguments. Aggregates up to 8 bytes
+// are returned in r18-r25, larger ones through a hidden pointer argument.
+type TypeInfoAvr struct {
+	*Transformer
+}
+
+const (
+	avrParamRegs = 18 // r8-r25
+	avrRetRegs   = 8  // r18-r25
+	avrPtrRegs   = 2  // hidden sret pointer
+)
+
+func (p *TypeInfoAvr) SupportByVal() bool {
+	return true
+}
+
+func (p *TypeInfoAvr) SkipEmptyParams() bool {
+	return true
+}
+
+func (p *TypeInfoAvr) IsWrapType(ctx llvm.Context, ftyp llvm.Type, typ llvm.Type, index int) bool {
+	switch typ.TypeKind() {
+	case llvm.StructTypeKind, llvm.ArrayTypeKind:
+		return true
+	}
+	return false
+}
+
+// regsOf returns the number of registers used to pass a value of typ.
+func (p *TypeInfoAvr) regsOf(typ llvm.Type) int {
+	if typ.TypeKind() == llvm.VoidTypeKind {
+		return 0
+	}
+	return (p.Sizeof(typ) + 1) &^ 1
+}
+
+// freeRegs returns the number of registers left for the parameter at index.
+func (p *TypeInfoAvr) freeRegs(ftyp llvm.Type, index int) int {
+	// arguments of variadic functions, named ones included, are passed on the stack
+	if ftyp.IsFunctionVarArg() {
+		return 0
+	}
+	regs := avrParamRegs
+	if ret := ftyp.ReturnType(); ret.TypeKind() != llvm.VoidTypeKind && p.Sizeof(ret) > avrRetRegs {
+		regs -= avrPtrRegs
+	}
+	for _, typ := range ftyp.ParamTypes()[:index-1] {
+		n := p.regsOf(typ)
+		if n > regs {
+			return 0
+		}
+		regs -= n
+	}
+	return regs
+}
+
+func (p *TypeInfoAvr) GetTypeInfo(ctx llvm.Context, ftyp llvm.Type, typ llvm.Type, index int) *TypeInfo {
+	info := &TypeInfo{}
+	info.Type = typ
+	info.Type1 = typ
+	if typ.TypeKind() == llvm.VoidTypeKind {
+		info.Kind = AttrVoid
+		return info
+	}
+	info.Size = p.Sizeof(typ)
+	info.Align = p.Alignof(typ)
+	switch typ.TypeKind() {
+	case llvm.StructTypeKind, llvm.ArrayTypeKind:
+		if index == 0 {
+			if info.Size > avrRetRegs {
+				info.Kind = AttrPointer
+				info.Type1 = llvm.PointerType(typ, 0)
+			}
+			return info
+		}
+		if p.regsOf(typ) > p.freeRegs(ftyp, index) {
+			info.Kind = AttrPointer
+			info.Type1 = llvm.PointerType(typ, 0)
+		} else if typ.TypeKind() == llvm.StructTypeKind {
+			// clang flattens structs passed in registers
+			info.Kind = AttrExtract
+		}
+	}
+	return info
+}
+
+// TypeInfoPpc64le implements the ELFv2 ABI of ppc64le.
+type TypeInfoPpc64le struct {
+	*Transformer
+}
+
+func (p *TypeInfoPpc64le) SupportByVal() bool {
+	return true
+}
+
+func (p *TypeInfoPpc64le) SkipEmptyParams() bool {
+	return true
+}
+
+func (p *TypeInfoPpc64le) IsWrapType(ctx llvm.Context, ftyp llvm.Type, typ llvm.Type, index int) bool {
+	switch typ.TypeKind() {
+	case llvm.StructTypeKind, llvm.ArrayTypeKind:
+		return true
+	}
+	return false
+}
+
+func (p *TypeInfoPpc64le) GetTypeInfo(ctx llvm.Context, ftyp llvm.Type, typ llvm.Type, index int) *TypeInfo {
+	info := &TypeInfo{}
+	info.Type = typ
+	info.Type1 = typ
+	if typ.TypeKind() == llvm.VoidTypeKind {
+		info.Kind = AttrVoid
+		return info
+	}
+	info.Size = p.Sizeof(typ)
+	info.Align = p.Alignof(typ)
+	switch typ.TypeKind() {
+	case llvm.StructTypeKind, llvm.ArrayTypeKind:
+		// homogeneous float aggregates of up to 8 members use floating-point registers
+		types := elementTypes(p.td, typ)
+		if n := len(types); n > 0 && n <= 8 && (checkTypes(types, ctx.FloatType()) || checkTypes(types, ctx.DoubleType())) {
+			info.Kind = AttrWidthType
+			info.Type1 = llvm.ArrayType(types[0], n)
+			return info
+		}
+		if index == 0 {
+			if info.Size > 16 {
+				info.Kind = AttrPointer
+				info.Type1 = llvm.PointerType(typ, 0)
+			} else if info.Size <= 8 {
+				info.Kind = AttrWidthType
+				info.Type1 = ctx.IntType(info.Size * 8)
+			} else {
+				info.Kind = AttrWidthType
+				info.Type1 = ctx.StructType([]llvm.Type{ctx.Int64Type(), ctx.Int64Type()}, false)
+			}
+			return info
+		}
+		if info.Size > 64 {
+			info.Kind = AttrPointer
+			info.Type1 = llvm.PointerType(typ, 0)
+		} else if info.Size <= 8 {
+			info.Kind = AttrWidthType
+			info.Type1 = ctx.IntType(info.Size * 8)
+		} else {
+			info.Kind = AttrWidthType
+			info.Type1 = llvm.ArrayType(ctx.Int64Type(), (info.Size+7)/8)
+		}
+	}
+	return info
+}
+
+// TypeInfoS390x implements the s390x ELF ABI: aggregates are returned in
+// memory, and passed in a register only if they have the size of an integer
+// register (or hold a single float), otherwise by reference to a copy.
+type TypeInfoS390x struct {
+	*Transformer
+}
+
+func (p *TypeInfoS390x) SupportByVal() bool {
+	return false
+}
+
+func (p *TypeInfoS390x) SkipEmptyParams() bool {
+	return true
+}
+
+func (p *TypeInfoS390x) IsWrapType(ctx llvm.Context, ftyp llvm.Type, typ llvm.Type, index int) bool {
+	switch typ.TypeKind() {
+	case llvm.StructTypeKind, llvm.ArrayTypeKind:
+		return true
+	}
+	return false
+}
+
+func (p *TypeInfoS390x) GetTypeInfo(ctx llvm.Context, ftyp llvm.Type, typ llvm.Type, index int) *TypeInfo {
+	info := &TypeInfo{}
+	info.Type = typ
+	info.Type1 = typ
+	if typ.TypeKind() == llvm.VoidTypeKind {
+		info.Kind = AttrVoid
+		return info
+	}
+	info.Size = p.Sizeof(typ)
+	info.Align = p.Alignof(typ)
+	switch typ.TypeKind() {
+	case llvm.StructTypeKind, llvm.ArrayTypeKind:
+		if index != 0 {
+			types := elementTypes(p.td, typ)
+			if len(types) == 1 && (types[0] == ctx.FloatType() || types[0] == ctx.DoubleType()) {
+				info.Kind = AttrWidthType
+				info.Type1 = types[0]
+				return info
+			}
+			switch info.Size {
+			case 1, 2, 4, 8:
+				info.Kind = AttrWidthType
+				info.Type1 = ctx.IntType(info.Size * 8)
+				return info
+			}
+		}
+		info.Kind = AttrPointer
+		info.Type1 = llvm.PointerType(typ, 0)
+	}
+	return info
+}
+
+// TypeInfoMips64 implements the N64 ABI of mips64 and mips64le: aggregates
+// are passed in 64-bit integer registers, except for aligned double fields
+// which use floating-point registers.
+type TypeInfoMips64 struct {
+	*Transformer
+}
+
+func (p *TypeInfoMips64) SupportByVal() bool {
+	return false
+}
+
+func (p *TypeInfoMips64) SkipEmptyParams() bool {
+	return true
+}
+
+func (p *TypeInfoMips64) IsWrapType(ctx llvm.Context, ftyp llvm.Type, typ llvm.Type, index int) bool {
+	switch typ.TypeKind() {
+	case llvm.StructTypeKind, llvm.ArrayTypeKind:
+		return true
+	}
+	return false
+}
+
+func (p *TypeInfoMips64) GetTypeInfo(ctx llvm.Context, ftyp llvm.Type, typ llvm.Type, index int) *TypeInfo {
+	info := &TypeInfo{}
+	info.Type = typ
+	info.Type1 = typ
+	if typ.TypeKind() == llvm.VoidTypeKind {
+		info.Kind = AttrVoid
+		return info
+	}
+	info.Size = p.Sizeof(typ)
+	info.Align = p.Alignof(typ)
+	switch typ.TypeKind() {
+	case llvm.StructTypeKind, llvm.ArrayTypeKind:
+		if index == 0 {
+			if info.Size > 16 {
+				info.Kind = AttrPointer
+				info.Type1 = llvm.PointerType(typ, 0)
+				return info
+			}
+			info.Kind = AttrWidthType
+			// structs of one or two floating-point fields are returned in $f0 and $f2
+			if typ.TypeKind() == llvm.StructTypeKind {
+				subs := typ.StructElementTypes()
+				if n := len(subs); n > 0 && n <= 2 && checkFloatTypes(ctx, subs) {
+					info.Type1 = ctx.StructType(subs, false)
+					return info
+				}
+			}
+			info.Type1 = ctx.StructType(p.intTypes(ctx, info.Size), false)
+			return info
+		}
+		var fields []llvm.Type
+		var last int
+		if typ.TypeKind() == llvm.StructTypeKind {
+			for i, sub := range typ.StructElementTypes() {
+				off := int(p.td.ElementOffset(typ, i))
+				if sub != ctx.DoubleType() || off%8 != 0 {
+					continue
+				}
+				for j := (off - last) / 8; j > 0; j-- {
+					fields = append(fields, ctx.Int64Type())
+				}
+				fields = append(fields, sub)
+				last = off + 8
+			}
+		}
+		fields = append(fields, p.intTypes(ctx, info.Size-last)...)
+		info.Kind = AttrWidthType
+		info.Type1 = ctx.StructType(fields, false)
+	}
+	return info
+}
+
+// intTypes returns the integer types covering size bytes in 64-bit chunks.
+func (p *TypeInfoMips64) intTypes(ctx llvm.Context, size int) (types []llvm.Type) {
+	for ; size >= 8; size -= 8 {
+		types = append(types, ctx.Int64Type())
+	}
+	if size > 0 {
+		types = append(types, ctx.IntType(size*8))
+	}
+	return
+}
+
+func checkFloatTypes(ctx llvm.Context, typs []llvm.Type) bool {
+	for _, t := range typs {
+		if t != ctx.FloatType() && t != ctx.DoubleType() {
+			return false
+		}
+	}
+	return true
+}
@@ -52,6 +52,17 @@ func NewTransformer(prog ssa.Program, llvmTarget string, targetAbi string, mode
 		tr.sys = &TypeInfoRiscv64{tr, targetAbi}
 	case "386":
 		tr.sys = &TypeInfo386{tr}
+	case "avr":
+		tr.sys = &TypeInfoAvr{tr}
+	case "loong64":
+		// the LoongArch LP64D rules for aggregates are those of RISC-V LP64D
+		tr.sys = &TypeInfoRiscv64{tr, MABI_LP64D}
+	case "ppc64le":
+		tr.sys = &TypeInfoPpc64le{tr}
+	case "s390x":
+		tr.sys = &TypeInfoS390x{tr}
+	case "mips64", "mips64le":
+		tr.sys = &TypeInfoMips64{tr}
 	}
 	return tr
 }
@@ -14,11 +14,12 @@ import (
 
 	"github.com/goplus/llgo/internal/build"
 	"github.com/goplus/llgo/internal/cabi"
+	"github.com/goplus/llgo/ssa"
 )
 
 var (
 	modes = []cabi.Mode{cabi.ModeNone, cabi.ModeCFunc, cabi.ModeAllFunc}
-	archs = []string{"amd64", "arm64", "riscv64", "armv6", "i386"}
+	archs = []string{"amd64", "arm64", "riscv64", "armv6", "i386", "avr"}
 )
 
 func init() {
@@ -52,6 +53,8 @@ func buildConf(mode cabi.Mode, arch string) (conf *build.Config, targetAbi strin
 		conf.Target = "esp32"
 	case "esp32c3":
 		conf.Target = "esp32c3"
+	case "avr":
+		conf.Target = "avr"
 	case "riscv64_lp64f":
 		conf.Goarch = "riscv64"
 		targetAbi = "lp64f"
@@ -703,3 +706,179 @@ entry:
 		t.Fatalf("non-runtime large aggregate should still be wrapped:\n%s", mixed.String())
 	}
 }
+
+// TestAvrRegisterAllocation verifies the avr-gcc rules: aggregates are passed
+// in registers while the 18 argument registers last, and all the following
+// arguments go on the stack once one doesn't fit.
+func TestAvrRegisterAllocation(t *testing.T) {
+	testIR := `; ModuleID = 'test'
+source_filename = "test"
+
+%P1 = type { i8 }
+%P2 = type { i8, i8 }
+%P9 = type { i8, i8, i8, i8, i8, i8, i8, i8, i8 }
+%P16 = type { [16 x i8] }
+%P20 = type { [20 x i8] }
+
+define %P2 @small(%P2 %0) {
+entry:
+  ret %P2 %0
+}
+
+define %P1 @tiny(%P1 %0) {
+entry:
+  ret %P1 %0
+}
+
+define %P9 @large(%P16 %0, %P2 %1) {
+entry:
+  ret %P9 zeroinitializer
+}
+
+define void @spill(i8 %0, i8 %1, i8 %2, i8 %3, i8 %4, i8 %5, i8 %6, i8 %7, %P2 %8, %P2 %9) {
+entry:
+  ret void
+}
+
+define void @big(%P20 %0, %P2 %1) {
+entry:
+  ret void
+}
+
+define void @variadic(%P2 %0, ...) {
+entry:
+  ret void
+}
+`
+
+	ctx := llvm.NewContext()
+	defer ctx.Dispose()
+
+	tmpfile := filepath.Join(t.TempDir(), "avr.ll")
+	if err := os.WriteFile(tmpfile, []byte(testIR), 0644); err != nil {
+		t.Fatalf("Failed to write test IR: %v", err)
+	}
+	buf, err := llvm.NewMemoryBufferFromFile(tmpfile)
+	if err != nil {
+		t.Fatalf("Failed to read test IR: %v", err)
+	}
+	mod, err := ctx.ParseIR(buf)
+	if err != nil {
+		t.Fatalf("Failed to parse test IR: %v", err)
+	}
+	defer mod.Dispose()
+
+	prog := ssa.NewProgram(&ssa.Target{GOOS: "linux", GOARCH: "arm", Target: "avr"})
+	tr := cabi.NewTransformer(prog, "avr", "", cabi.ModeAllFunc, false)
+	tr.TransformModule("test", mod)
+
+	head := func(name string) string {
+		fn := mod.NamedFunction(name)
+		if fn.IsNil() {
+			t.Fatalf("%s not found", name)
+		}
+		return strings.SplitN(fn.String(), "\n", 2)[0]
+	}
+
+	tests := []struct {
+		name  string
+		want  []string
+		byval int
+	}{
+		{"small", []string{"define %P2 @small(i8 %0, i8 %1)"}, 0},
+		{"tiny", []string{"define %P1 @tiny(i8 %0)"}, 0},
+		{"large", []string{"sret(%P9)", "[16 x i8]"}, 1},
+		{"spill", []string{"i8 %8, i8 %9, ptr byval(%P2)"}, 1},
+		{"big", []string{"ptr byval(%P20)", "ptr byval(%P2)"}, 2},
+		{"variadic", []string{"ptr byval(%P2)"}, 1},
+	}
+	for _, tt := range tests {
+		h := head(tt.name)
+		for _, want := range tt.want {
+			if !strings.Contains(h, want) {
+				t.Errorf("%s: want %q in\n%s", tt.name, want, h)
+			}
+		}
+		if n := strings.Count(h, "byval("); n != tt.byval {
+			t.Errorf("%s: got %d byval params, want %d\n%s", tt.name, n, tt.byval, h)
+		}
+	}
+}
+
+func TestLinux64Archs(t *testing.T) {
+	testIR := `; ModuleID = 'test'
+source_filename = "test"
+
+%S3 = type { i8, i8, i8 }
+%DD = type { double, double }
+%DI = type { double, i32 }
+%L3 = type { i64, i64, i64 }
+
+define %S3 @f1(%S3 %0) {
+entry:
+  ret %S3 %0
+}
+
+define void @f2(%DD %0, %DI %1) {
+entry:
+  ret void
+}
+
+define %L3 @f3(%L3 %0) {
+entry:
+  ret %L3 %0
+}
+`
+	tests := []struct {
+		goarch string
+		want   []string
+	}{
+		{"ppc64le", []string{
+			"define i24 @f1(i24 %0)",
+			"define void @f2([2 x double] %0, [2 x i64] %1)",
+			"define void @f3(ptr sret(%L3) %0, [3 x i64] %1)",
+		}},
+		{"s390x", []string{
+			"define void @f1(ptr sret(%S3) %0, ptr %1)",
+			"define void @f2(ptr %0, ptr %1)",
+			"define void @f3(ptr sret(%L3) %0, ptr %1)",
+		}},
+		{"mips64", []string{
+			"define { i24 } @f1({ i24 } %0)",
+			"define void @f2({ double, double } %0, { double, i64 } %1)",
+			"define void @f3(ptr sret(%L3) %0, { i64, i64, i64 } %1)",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.goarch, func(t *testing.T) {
+			ctx := llvm.NewContext()
+			defer ctx.Dispose()
+
+			tmpfile := filepath.Join(t.TempDir(), "test.ll")
+			if err := os.WriteFile(tmpfile, []byte(testIR), 0644); err != nil {
+				t.Fatalf("Failed to write test IR: %v", err)
+			}
+			buf, err := llvm.NewMemoryBufferFromFile(tmpfile)
+			if err != nil {
+				t.Fatalf("Failed to read test IR: %v", err)
+			}
+			mod, err := ctx.ParseIR(buf)
+			if err != nil {
+				t.Fatalf("Failed to parse test IR: %v", err)
+			}
+			defer mod.Dispose()
+
+			prog := ssa.NewProgram(&ssa.Target{GOOS: "linux", GOARCH: tt.goarch})
+			tr := cabi.NewTransformer(prog, "", "", cabi.ModeAllFunc, false)
+			tr.TransformModule("test", mod)
+
+			for i, want := range tt.want {
+				fn := mod.NamedFunction([]string{"f1", "f2", "f3"}[i])
+				head := strings.SplitN(fn.String(), "\n", 2)[0]
+				if !strings.HasPrefix(head, want) {
+					t.Errorf("got %s, want %s", head, want)
+				}
+			}
+		})
+	}
+}
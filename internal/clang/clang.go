@@ -96,20 +96,32 @@ func NewLinker(config Config) *Cmd {
 
 // Compile executes a compilation command with merged flags.
 func (c *Cmd) Compile(args ...string) error {
-	flags := c.mergeCompilerFlags()
-	allArgs := make([]string, 0, len(flags)+len(args))
-	allArgs = append(allArgs, flags...)
-	allArgs = append(allArgs, args...)
-	return c.exec(allArgs...)
+	return c.exec(c.CompileCommand(args...)[1:]...)
 }
 
 // Link executes a linking command with merged flags.
 func (c *Cmd) Link(args ...string) error {
-	flags := c.mergeLinkerFlags()
-	allArgs := make([]string, 0, len(flags)+len(args))
+	return c.exec(c.LinkCommand(args...)[1:]...)
+}
+
+// CompileCommand returns the command line, program first, that Compile runs
+// for args.
+func (c *Cmd) CompileCommand(args ...string) []string {
+	return c.command(c.mergeCompilerFlags(), args)
+}
+
+// LinkCommand returns the command line, program first, that Link runs for
+// args.
+func (c *Cmd) LinkCommand(args ...string) []string {
+	return c.command(c.mergeLinkerFlags(), args)
+}
+
+func (c *Cmd) command(flags, args []string) []string {
+	allArgs := make([]string, 0, 1+len(flags)+len(args))
+	allArgs = append(allArgs, c.app)
 	allArgs = append(allArgs, flags...)
 	allArgs = append(allArgs, args...)
-	return c.exec(allArgs...)
+	return allArgs
 }
 
 // mergeCompilerFlags merges environment CCFLAGS/CFLAGS with config flags.
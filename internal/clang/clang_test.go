@@ -295,6 +295,29 @@ func TestLink(t *testing.T) {
 	}
 }
 
+func TestCommand(t *testing.T) {
+	t.Setenv("CCFLAGS", "")
+	t.Setenv("CFLAGS", "")
+	t.Setenv("LDFLAGS", "")
+
+	config := Config{
+		CCFLAGS: []string{"-Wall"},
+		LDFLAGS: []string{"-lm"},
+	}
+	cmd := New("clang", config)
+
+	got := cmd.CompileCommand("-c", "test.c")
+	expected := []string{"clang", "-Wall", "-c", "test.c"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected compile command %v, got %v", expected, got)
+	}
+	got = cmd.LinkCommand("test.o", "-o", "test")
+	expected = []string{"clang", "-lm", "test.o", "-o", "test"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected link command %v, got %v", expected, got)
+	}
+}
+
 func TestVerboseMode(t *testing.T) {
 	config := Config{}
 	cmd := New("echo", config)
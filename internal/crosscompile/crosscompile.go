@@ -62,6 +62,50 @@ func cacheDir() string {
 	return filepath.Join(cacheRoot(), "crosscompile")
 }
 
+// linuxCross describes the GNU cross toolchain used to build for a Linux
+// architecture, as packaged by Debian/Ubuntu (eg. gcc-powerpc64le-linux-gnu),
+// and the qemu-user emulator used to run the resulting executables.
+type linuxCross struct {
+	gnu  string // GNU triple, the sysroot is /usr/<gnu>
+	qemu string // qemu-user command
+}
+
+var linuxCrossArchs = map[string]linuxCross{
+	"loong64":  {"loongarch64-linux-gnu", "qemu-loongarch64"},
+	"ppc64le":  {"powerpc64le-linux-gnu", "qemu-ppc64le"},
+	"s390x":    {"s390x-linux-gnu", "qemu-s390x"},
+	"mips64":   {"mips64-linux-gnuabi64", "qemu-mips64"},
+	"mips64le": {"mips64el-linux-gnuabi64", "qemu-mips64el"},
+}
+
+// useLinuxCross sets up export to cross-compile for a Linux architecture
+// listed in linuxCrossArchs. clang locates the GNU cross toolchain from the
+// target triple.
+func useLinuxCross(export *Export, targetTriple string, cross linuxCross) {
+	export.CCFLAGS = []string{
+		"-target", targetTriple,
+		"-Qunused-arguments",
+		"-Wno-unused-command-line-argument",
+		"-fdata-sections",
+		"-ffunction-sections",
+	}
+	export.LDFLAGS = []string{
+		"-target", targetTriple,
+		"-Qunused-arguments",
+		"-Wno-unused-command-line-argument",
+		"-Wl,--error-limit=0",
+		"-fuse-ld=lld",
+		"-fdata-sections",
+		"-ffunction-sections",
+		"-Xlinker",
+		"--gc-sections",
+		"-latomic",
+		"-lpthread",
+		"-ldl",
+	}
+	export.Emulator = cross.qemu + " -L /usr/" + cross.gnu + " {}"
+}
+
 // buildEnvMap creates a map of template variables for the current context
 func buildEnvMap(llgoRoot string) map[string]string {
 	envs := make(map[string]string)
@@ -291,6 +335,12 @@ func use(goos, goarch string, wasiThreads, forceEspClang bool) (export Export, e
 		}
 		return
 	}
+	if goos == "linux" {
+		if cross, ok := linuxCrossArchs[goarch]; ok {
+			useLinuxCross(&export, targetTriple, cross)
+			return
+		}
+	}
 	if goarch != "wasm" {
 		return
 	}
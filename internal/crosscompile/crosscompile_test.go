@@ -167,6 +167,39 @@ func TestUseCrossCompileSDK(t *testing.T) {
 	}
 }
 
+func TestUseLinuxCross(t *testing.T) {
+	testCases := []struct {
+		goarch   string
+		triple   string
+		emulator string
+	}{
+		{"loong64", "loongarch64-unknown-linux-gnu", "qemu-loongarch64 -L /usr/loongarch64-linux-gnu {}"},
+		{"ppc64le", "powerpc64le-unknown-linux-gnu", "qemu-ppc64le -L /usr/powerpc64le-linux-gnu {}"},
+		{"s390x", "s390x-unknown-linux-gnu", "qemu-s390x -L /usr/s390x-linux-gnu {}"},
+		{"mips64", "mips64-unknown-linux-gnuabi64", "qemu-mips64 -L /usr/mips64-linux-gnuabi64 {}"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.goarch, func(t *testing.T) {
+			if runtime.GOOS == "linux" && runtime.GOARCH == tc.goarch {
+				t.Skip("not a cross build")
+			}
+			export, err := use("linux", tc.goarch, false, false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			for _, flags := range [][]string{export.CCFLAGS, export.LDFLAGS} {
+				i := slices.Index(flags, "-target")
+				if i < 0 || i+1 >= len(flags) || flags[i+1] != tc.triple {
+					t.Errorf("Expected -target %s in %v", tc.triple, flags)
+				}
+			}
+			if export.Emulator != tc.emulator {
+				t.Errorf("Emulator = %q, want %q", export.Emulator, tc.emulator)
+			}
+		})
+	}
+}
+
 func TestUseTarget(t *testing.T) {
 	// Test cases for target-based configuration
 	testCases := []struct {
@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package env
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// LLGoEnvVar names the environment variable overriding the location of the
+// config file; LLGO_ENV=off disables it.
+const LLGoEnvVar = "LLGO_ENV"
+
+// ConfigFile returns the user config file holding the defaults set by
+// "llgo env -w", or "" if there is none.
+func ConfigFile() string {
+	if file := os.Getenv(LLGoEnvVar); file != "" {
+		if file == "off" {
+			return ""
+		}
+		return file
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "llgo", "env")
+}
+
+var config struct {
+	sync.Mutex
+	file string
+	vars map[string]string
+}
+
+// Getenv returns the value of the environment variable key like os.Getenv,
+// falling back to the default in the config file if the variable is unset
+// or empty.
+func Getenv(key string) string {
+	if val := os.Getenv(key); val != "" {
+		return val
+	}
+	return configVars()[key]
+}
+
+// configVars returns the variables of the config file, read once per file.
+func configVars() map[string]string {
+	file := ConfigFile()
+	config.Lock()
+	defer config.Unlock()
+	if config.vars == nil || config.file != file {
+		config.file = file
+		config.vars, _ = ReadConfig(file)
+	}
+	return config.vars
+}
+
+// ReadConfig reads the NAME=VALUE lines of a config file. Blank lines and
+// lines starting with # are skipped. A missing file holds no variables.
+func ReadConfig(file string) (map[string]string, error) {
+	vars := make(map[string]string)
+	if file == "" {
+		return vars, nil
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return vars, err
+	}
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		if name, val, ok := strings.Cut(line, "="); ok {
+			vars[strings.TrimSpace(name)] = val
+		}
+	}
+	return vars, s.Err()
+}
+
+// UpdateConfig sets the variables of set and removes the ones of unset in
+// the config file.
+func UpdateConfig(set map[string]string, unset []string) error {
+	file := ConfigFile()
+	if file == "" {
+		return fmt.Errorf("cannot update config: %s=off", LLGoEnvVar)
+	}
+	vars, err := ReadConfig(file)
+	if err != nil {
+		return err
+	}
+	for name, val := range set {
+		vars[name] = val
+	}
+	for _, name := range unset {
+		delete(vars, name)
+	}
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b bytes.Buffer
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s=%s\n", name, vars[name])
+	}
+	if err = os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	if err = os.WriteFile(file, b.Bytes(), 0644); err != nil {
+		return err
+	}
+	config.Lock()
+	config.vars = nil
+	config.Unlock()
+	return nil
+}
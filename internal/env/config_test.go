@@ -0,0 +1,79 @@
+//go:build !llgo
+// +build !llgo
+
+package env
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestConfigFile(t *testing.T) {
+	t.Setenv(LLGoEnvVar, "off")
+	if got := ConfigFile(); got != "" {
+		t.Fatalf("ConfigFile() with LLGO_ENV=off = %q, want empty", got)
+	}
+	if err := UpdateConfig(map[string]string{"LLGO_DEBUG": "1"}, nil); err == nil {
+		t.Fatal("UpdateConfig with LLGO_ENV=off should fail")
+	}
+	file := filepath.Join(t.TempDir(), "env")
+	t.Setenv(LLGoEnvVar, file)
+	if got := ConfigFile(); got != file {
+		t.Fatalf("ConfigFile() = %q, want %q", got, file)
+	}
+}
+
+func TestUpdateConfig(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "llgo", "env")
+	t.Setenv(LLGoEnvVar, file)
+	t.Setenv("LLGO_TEST_A", "")
+	t.Setenv("LLGO_TEST_B", "")
+
+	if got := Getenv("LLGO_TEST_A"); got != "" {
+		t.Fatalf("Getenv without config = %q, want empty", got)
+	}
+	set := map[string]string{"LLGO_TEST_A": "a=1", "LLGO_TEST_B": "b"}
+	if err := UpdateConfig(set, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := Getenv("LLGO_TEST_A"); got != "a=1" {
+		t.Fatalf("Getenv from config = %q, want %q", got, "a=1")
+	}
+	os.Setenv("LLGO_TEST_A", "env")
+	if got := Getenv("LLGO_TEST_A"); got != "env" {
+		t.Fatalf("Getenv should prefer the environment, got %q", got)
+	}
+
+	if err := UpdateConfig(nil, []string{"LLGO_TEST_B"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := Getenv("LLGO_TEST_B"); got != "" {
+		t.Fatalf("Getenv after unset = %q, want empty", got)
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), "LLGO_TEST_A=a=1\n"; got != want {
+		t.Fatalf("config file = %q, want %q", got, want)
+	}
+}
+
+func TestReadConfig(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "env")
+	if vars, err := ReadConfig(file); err != nil || len(vars) != 0 {
+		t.Fatalf("ReadConfig(missing) = %v, %v", vars, err)
+	}
+	content := "# comment\n\nLLGO_OPTIMIZE=0\n CGO_CFLAGS =-O2 -g\nbogus\n"
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	vars, err := ReadConfig(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vars) != 2 || vars["LLGO_OPTIMIZE"] != "0" || vars["CGO_CFLAGS"] != "-O2 -g" {
+		t.Fatalf("ReadConfig = %v", vars)
+	}
+}
@@ -47,7 +47,7 @@ func LLGoRuntimeDir() string {
 }
 
 func LLGoROOT() string {
-	llgoRootEnv := os.Getenv("LLGO_ROOT")
+	llgoRootEnv := Getenv("LLGO_ROOT")
 	if llgoRootEnv != "" {
 		if root, ok := isLLGoRoot(llgoRootEnv); ok {
 			return root
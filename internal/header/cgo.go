@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package header
+
+import (
+	"fmt"
+	"go/types"
+	"io"
+	"strings"
+
+	"github.com/goplus/llgo/ssa"
+)
+
+// cgoBasicTypes maps the names cgo gives C basic types (C.uint is
+// _Ctype_uint) to their C spellings.
+var cgoBasicTypes = map[string]string{
+	"char":          "char",
+	"schar":         "signed char",
+	"uchar":         "unsigned char",
+	"short":         "short",
+	"ushort":        "unsigned short",
+	"int":           "int",
+	"uint":          "unsigned int",
+	"long":          "long",
+	"ulong":         "unsigned long",
+	"longlong":      "long long",
+	"ulonglong":     "unsigned long long",
+	"float":         "float",
+	"double":        "double",
+	"complexfloat":  "float _Complex",
+	"complexdouble": "double _Complex",
+	"void":          "void",
+}
+
+// cgoCTypeName returns the C spelling of t if it is a C type seen through
+// cgo, such as C.int or C.struct_foo.
+func cgoCTypeName(t types.Type) (string, bool) {
+	var obj *types.TypeName
+	switch typ := t.(type) {
+	case *types.Named:
+		obj = typ.Obj()
+	case *types.Alias:
+		obj = typ.Obj()
+	default:
+		return "", false
+	}
+	name, ok := strings.CutPrefix(obj.Name(), "_Ctype_")
+	if !ok {
+		return "", false
+	}
+	if cname, ok := cgoBasicTypes[name]; ok {
+		return cname, true
+	}
+	for _, tag := range []string{"struct", "union", "enum"} {
+		if tagName, ok := strings.CutPrefix(name, tag+"_"); ok {
+			if strings.HasPrefix(tagName, "__") { // anonymous, eg. _Ctype_struct___0
+				return "", false
+			}
+			return tag + " " + tagName, true
+		}
+	}
+	return name, true // a typedef name
+}
+
+// GenCgoExportHeader writes _cgo_export.h of a cgo package: it declares the
+// functions pkg exports by //export, for the C sources of the package to call
+// them. preamble holds the preambles of the Go files containing //export,
+// which declare the C types the functions use.
+func GenCgoExportHeader(p ssa.Program, pkg ssa.Package, preamble string, w io.Writer) (err error) {
+	hw := newCHeaderWriter(p)
+	hw.cgo = true
+	if err = hw.writeCommonIncludes(); err != nil {
+		return
+	}
+	if err = hw.writeExportFuncs(pkg); err != nil {
+		return
+	}
+	_, err = fmt.Fprintf(w, `/* Code generated by llgo; DO NOT EDIT. */
+
+#ifndef __CGO_EXPORT_H_
+#define __CGO_EXPORT_H_
+
+#include <stddef.h>
+#include <stdint.h>
+#include <stdbool.h>
+
+%s
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+`, preamble)
+	if err != nil {
+		return
+	}
+	if err = hw.writeTo(w); err != nil {
+		return
+	}
+	_, err = io.WriteString(w, `
+#ifdef __cplusplus
+}
+#endif
+
+#endif /* __CGO_EXPORT_H_ */
+`)
+	return
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package header
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// writeCXX writes C++ wrappers of the declarations collected by hw:
+// inline functions forwarding to the exported C functions, and for each
+// struct type with methods, a struct deriving from the C typedef whose
+// member functions forward to the Type_Method C functions.
+func (hw *cheaderWriter) writeCXX(w io.Writer) error {
+	for _, fn := range hw.funcs {
+		fmt.Fprintf(w, "inline %s %s(%s) { return ::%s(%s); }\n\n",
+			fn.ret, fn.name, strings.Join(fn.params, ", "), fn.name, strings.Join(fn.args, ", "))
+	}
+	for _, st := range hw.structs {
+		fmt.Fprintf(w, "struct %s : ::%s {\n", st.name, st.cName)
+		for _, m := range st.methods {
+			self := "*this"
+			if m.ptrRecv {
+				self = "this"
+			}
+			args := append([]string{self}, m.args[1:]...)
+			fmt.Fprintf(w, "    %s %s(%s) { return ::%s(%s); }\n",
+				m.ret, m.method, strings.Join(m.params[1:], ", "), m.name, strings.Join(args, ", "))
+		}
+		fmt.Fprint(w, "};\n\n")
+	}
+	return nil
+}
+
+// genCXXHeaderFile writes the C++ wrapper header of libName next to the C
+// header at headerPath. body is the content of the wrapper namespace.
+func genCXXHeaderFile(body []byte, libName, headerPath string, verbose bool) error {
+	cxxPath := strings.TrimSuffix(headerPath, filepath.Ext(headerPath)) + ".hpp"
+	w, err := os.Create(cxxPath)
+	if err != nil {
+		return fmt.Errorf("failed to write header file %s: %w", cxxPath, err)
+	}
+	defer w.Close()
+
+	if verbose {
+		fmt.Fprintf(os.Stderr, "Generated C++ header: %s\n", cxxPath)
+	}
+
+	ns := cxxIdent(libName)
+	headerIdent := strings.ToUpper(ns)
+	_, err = fmt.Fprintf(w, `/* Code generated by llgo; DO NOT EDIT. */
+
+#ifndef __%s_HPP_
+#define __%s_HPP_
+
+#include "%s"
+
+namespace %s {
+
+%s} // namespace %s
+
+#endif /* __%s_HPP_ */
+`, headerIdent, headerIdent, filepath.Base(headerPath), ns, body, ns, headerIdent)
+	return err
+}
+
+// cxxIdent converts name to a valid C++ identifier.
+func cxxIdent(name string) string {
+	ident := []byte(name)
+	for i, c := range ident {
+		if !(c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			ident[i] = '_'
+		}
+	}
+	if len(ident) == 0 || ident[0] >= '0' && ident[0] <= '9' {
+		return "_" + string(ident)
+	}
+	return string(ident)
+}
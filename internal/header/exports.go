@@ -0,0 +1,343 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package header
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/goplus/llgo/ssa"
+)
+
+// Options controls the optional parts of a generated C header.
+type Options struct {
+	// Pkgs lists the Go packages whose exported types, constants and methods
+	// are written to the header, in addition to the exported functions.
+	Pkgs []SourcePkg
+
+	// CXX generates a C++ wrapper header (.hpp) next to the C header.
+	CXX bool
+}
+
+// SourcePkg describes the Go source of a package exported to a C header.
+type SourcePkg struct {
+	Types *types.Package
+	Files []*ast.File // optional, used to carry doc comments over
+}
+
+// cFunc records a C function declaration, used to generate C++ wrappers.
+type cFunc struct {
+	name   string   // C function name
+	ret    string   // C return type
+	params []string // parameter declarations, every parameter is named
+	args   []string // parameter names
+}
+
+// cMethod records a Go method exposed as a C function.
+type cMethod struct {
+	*cFunc
+	method  string // Go method name
+	ptrRecv bool   // method has a pointer receiver
+}
+
+// cStruct records an exported struct type with methods.
+type cStruct struct {
+	name    string // Go type name
+	cName   string // C type name
+	methods []*cMethod
+}
+
+// recoverTypeError converts a panic raised while mapping Go types to C
+// (eg. "unsupported type") into an error.
+func recoverTypeError(err *error) {
+	if r := recover(); r != nil {
+		switch e := r.(type) {
+		case error:
+			*err = e
+		case string:
+			*err = errors.New(e)
+		default:
+			panic(r)
+		}
+	}
+}
+
+// writeDoc writes a Go doc comment as C line comments.
+func writeDoc(w io.Writer, doc string) {
+	doc = strings.TrimSpace(doc)
+	if doc == "" {
+		return
+	}
+	for _, line := range strings.Split(doc, "\n") {
+		// a trailing backslash would continue the comment to the next line
+		line = strings.TrimRight(line, "\\ \t")
+		if line == "" {
+			fmt.Fprintln(w, "//")
+		} else {
+			fmt.Fprintln(w, "// "+line)
+		}
+	}
+}
+
+// collectDocs collects doc comments of package-level declarations in files.
+// The keys of docs are Go full names: pkg.Name, pkg.T.Method or pkg.(*T).Method.
+func collectDocs(pkgPath string, files []*ast.File, docs map[string]string) {
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				docs[astFuncName(pkgPath, decl)] = decl.Doc.Text()
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						docs[pkgPath+"."+spec.Name.Name] = specDoc(decl, spec.Doc, spec.Comment)
+					case *ast.ValueSpec:
+						doc := specDoc(decl, spec.Doc, spec.Comment)
+						for _, name := range spec.Names {
+							docs[pkgPath+"."+name.Name] = doc
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func specDoc(decl *ast.GenDecl, doc, comment *ast.CommentGroup) string {
+	if doc != nil {
+		return doc.Text()
+	}
+	if comment != nil {
+		return comment.Text()
+	}
+	if len(decl.Specs) == 1 {
+		return decl.Doc.Text()
+	}
+	return ""
+}
+
+func astFuncName(pkgPath string, fn *ast.FuncDecl) string {
+	name := fn.Name.Name
+	if recv := fn.Recv; recv != nil && len(recv.List) == 1 {
+		t := recv.List[0].Type
+		if tp, ok := t.(*ast.StarExpr); ok {
+			return pkgPath + ".(*" + recvTypeName(tp.X) + ")." + name
+		}
+		return pkgPath + "." + recvTypeName(t) + "." + name
+	}
+	return pkgPath + "." + name
+}
+
+func recvTypeName(t ast.Expr) string {
+	switch t := t.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.IndexExpr:
+		return recvTypeName(t.X)
+	case *ast.IndexListExpr:
+		return recvTypeName(t.X)
+	case *ast.ParenExpr:
+		return recvTypeName(t.X)
+	}
+	return ""
+}
+
+func methodFullName(pkgPath string, m *types.Func) (fullName string, ptrRecv bool) {
+	recv := m.Type().(*types.Signature).Recv().Type()
+	if tp, ok := recv.(*types.Pointer); ok {
+		return pkgPath + ".(*" + tp.Elem().(*types.Named).Obj().Name() + ")." + m.Name(), true
+	}
+	return pkgPath + "." + recv.(*types.Named).Obj().Name() + "." + m.Name(), false
+}
+
+// writeSourcePkg writes the exported types, constants and methods of src.
+// lpkg is the compiled package, it may be nil if src isn't compiled.
+// Unlike //export functions, declarations that can't be expressed in C
+// don't fail the generation: they are skipped with a diagnostic comment.
+func (hw *cheaderWriter) writeSourcePkg(src SourcePkg, lpkg ssa.Package) {
+	pkgPath := src.Types.Path()
+	scope := src.Types.Scope()
+	for _, name := range scope.Names() {
+		if !token.IsExported(name) {
+			continue
+		}
+		switch obj := scope.Lookup(name).(type) {
+		case *types.TypeName:
+			named, ok := obj.Type().(*types.Named)
+			if !ok || obj.IsAlias() || named.TypeParams().Len() > 0 {
+				continue
+			}
+			if err := hw.writeNamedType(pkgPath, named, lpkg); err != nil {
+				writeSkipped(hw.typeBuf, "type", pkgPath+"."+name, err)
+			}
+		case *types.Const:
+			if err := hw.writeConst(pkgPath, obj); err != nil {
+				writeSkipped(hw.constBuf, "constant", pkgPath+"."+name, err)
+			}
+		}
+	}
+}
+
+// writeSkipped writes a diagnostic comment for a declaration that isn't exported.
+func writeSkipped(w io.Writer, kind, name string, err error) {
+	fmt.Fprintf(w, "/* %s %s is not exported: %s */\n\n", kind, name, strings.ReplaceAll(err.Error(), "*/", "* /"))
+}
+
+// writeNamedType writes the typedef, layout checks and methods of an
+// exported named type.
+func (hw *cheaderWriter) writeNamedType(pkgPath string, named *types.Named, lpkg ssa.Package) (err error) {
+	defer recoverTypeError(&err)
+
+	cName := hw.goCTypeName(named)
+	if err = hw.writeTypedef(named); err != nil {
+		return
+	}
+	st, isStruct := named.Underlying().(*types.Struct)
+	if isStruct {
+		hw.writeLayoutChecks(cName, named, st)
+	}
+	if lpkg == nil {
+		return
+	}
+	var methods []*cMethod
+	for i, n := 0, named.NumMethods(); i < n; i++ {
+		m := named.Method(i)
+		if !m.Exported() {
+			continue
+		}
+		fullName, ptrRecv := methodFullName(pkgPath, m)
+		fn := lpkg.FuncOf(fullName)
+		if fn == nil { // method isn't compiled
+			continue
+		}
+		sig := fn.Type.RawType().(*types.Signature)
+		params := sig.Params()
+		vars := make([]*types.Var, params.Len())
+		for j := range vars {
+			vars[j] = params.At(j)
+		}
+		vars[0] = types.NewParam(vars[0].Pos(), vars[0].Pkg(), "self", vars[0].Type())
+		sig = types.NewSignatureType(nil, nil, nil, types.NewTuple(vars...), sig.Results(), sig.Variadic())
+
+		cMethodName := cName + "_" + m.Name()
+		hw.docs[cMethodName] = hw.goDocs[fullName]
+		cfn, err := hw.writeFuncDecl(cMethodName, fullName, sig)
+		if err != nil {
+			writeSkipped(hw.funcBuf, "method", fullName, err)
+			continue
+		}
+		methods = append(methods, &cMethod{cFunc: cfn, method: m.Name(), ptrRecv: ptrRecv})
+	}
+	if isStruct && len(methods) > 0 {
+		hw.structs = append(hw.structs, &cStruct{named.Obj().Name(), cName, methods})
+	}
+	return nil
+}
+
+// writeLayoutChecks writes static assertions that the C compiler lays out
+// the struct typedef the same way as llgo does.
+func (hw *cheaderWriter) writeLayoutChecks(cName string, named *types.Named, st *types.Struct) {
+	prog := hw.p
+	t := prog.Type(named, ssa.InGo)
+	size := prog.SizeOf(t)
+	if size == 0 { // empty structs have different sizes in C and C++
+		return
+	}
+	w := hw.typeBuf
+	fmt.Fprintf(w, "GO_STATIC_ASSERT(sizeof(%s) == %d, \"%s: unexpected size\");\n", cName, size, cName)
+	fmt.Fprintf(w, "GO_STATIC_ASSERT(GO_ALIGNOF(%s) == %d, \"%s: unexpected alignment\");\n", cName, prog.AlignOf(t), cName)
+	for i, n := 0, st.NumFields(); i < n; i++ {
+		name := st.Field(i).Name()
+		if name == "_" {
+			continue
+		}
+		fmt.Fprintf(w, "GO_STATIC_ASSERT(offsetof(%s, %s) == %d, \"%s.%s: unexpected offset\");\n",
+			cName, name, prog.OffsetOf(t, i), cName, name)
+	}
+	fmt.Fprintln(w)
+}
+
+// writeConst writes an exported constant as a #define.
+func (hw *cheaderWriter) writeConst(pkgPath string, c *types.Const) error {
+	typ := c.Type()
+	val := c.Val()
+	if t, ok := typ.Underlying().(*types.Basic); ok && t.Info()&types.IsFloat != 0 {
+		val = constant.ToFloat(val)
+	}
+	lit := cConstValue(val)
+	if lit == "" {
+		return fmt.Errorf("value %v can't be represented in C", val)
+	}
+	// Only numeric and bool types are scalars in C: the C type of a string is
+	// a GoString struct, which a string literal can't be cast to.
+	named, ok := typ.(*types.Named)
+	if ok && typ.Underlying().(*types.Basic).Info()&(types.IsNumeric|types.IsBoolean) != 0 {
+		if err := hw.writeTypedef(named); err != nil {
+			return err
+		}
+		lit = fmt.Sprintf("((%s)%s)", hw.goCTypeName(named), lit)
+	} else if strings.HasPrefix(lit, "-") {
+		lit = "(" + lit + ")"
+	}
+	writeDoc(hw.constBuf, hw.goDocs[pkgPath+"."+c.Name()])
+	fmt.Fprintf(hw.constBuf, "#define %s_%s %s\n", c.Pkg().Name(), c.Name(), lit)
+	return nil
+}
+
+// cConstValue returns the C literal of a constant value, or "" if the value
+// can't be represented in C.
+func cConstValue(v constant.Value) string {
+	switch v.Kind() {
+	case constant.Bool:
+		if constant.BoolVal(v) {
+			return "true"
+		}
+		return "false"
+	case constant.String:
+		return strconv.Quote(constant.StringVal(v))
+	case constant.Int:
+		if i, ok := constant.Int64Val(v); ok {
+			if i < math.MinInt32 || i > math.MaxInt32 {
+				return strconv.FormatInt(i, 10) + "LL"
+			}
+			return strconv.FormatInt(i, 10)
+		}
+		if u, ok := constant.Uint64Val(v); ok {
+			return strconv.FormatUint(u, 10) + "ULL"
+		}
+	case constant.Float:
+		f, _ := constant.Float64Val(v)
+		if math.IsInf(f, 0) {
+			return ""
+		}
+		s := strconv.FormatFloat(f, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") {
+			s += ".0"
+		}
+		return s
+	}
+	return ""
+}
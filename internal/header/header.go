@@ -31,9 +31,15 @@ import (
 // cheaderWriter handles C header generation with type definition management
 type cheaderWriter struct {
 	p             ssa.Program
-	typeBuf       *bytes.Buffer   // buffer for type definitions
-	funcBuf       *bytes.Buffer   // buffer for function declarations
-	declaredTypes map[string]bool // track declared types to avoid duplicates
+	typeBuf       *bytes.Buffer     // buffer for type definitions
+	constBuf      *bytes.Buffer     // buffer for constant definitions
+	funcBuf       *bytes.Buffer     // buffer for function declarations
+	declaredTypes map[string]bool   // track declared types to avoid duplicates
+	goDocs        map[string]string // Go full name => doc comment
+	docs          map[string]string // C name => doc comment
+	funcs         []*cFunc          // exported functions, for C++ wrappers
+	structs       []*cStruct        // exported struct types with methods, for C++ wrappers
+	cgo           bool              // spell the C types of cgo (C.int etc.) as in C
 }
 
 // newCHeaderWriter creates a new C header writer
@@ -41,8 +47,11 @@ func newCHeaderWriter(p ssa.Program) *cheaderWriter {
 	return &cheaderWriter{
 		p:             p,
 		typeBuf:       &bytes.Buffer{},
+		constBuf:      &bytes.Buffer{},
 		funcBuf:       &bytes.Buffer{},
 		declaredTypes: make(map[string]bool),
+		goDocs:        make(map[string]string),
+		docs:          make(map[string]string),
 	}
 }
 
@@ -68,6 +77,12 @@ func (hw *cheaderWriter) writeTypedefRecursive(t types.Type, visiting map[string
 		return hw.writeTypedefRecursive(typ.Elem(), visiting)
 	}
 
+	if hw.cgo {
+		if _, ok := cgoCTypeName(t); ok {
+			return nil // declared by the preamble
+		}
+	}
+
 	cType := hw.goCTypeName(t)
 	if cType == "" || hw.declaredTypes[cType] {
 		return nil
@@ -88,6 +103,7 @@ func (hw *cheaderWriter) writeTypedefRecursive(t types.Type, visiting map[string
 	// Then write the typedef for this type
 	typedef := hw.generateTypedef(t)
 	if typedef != "" {
+		writeDoc(hw.typeBuf, hw.docs[cType])
 		fmt.Fprintln(hw.typeBuf, typedef)
 		// Add empty line after each type definition
 		fmt.Fprintln(hw.typeBuf)
@@ -158,6 +174,11 @@ func (hw *cheaderWriter) processSignatureTypes(sig *types.Signature, visiting ma
 
 // goCTypeName returns the C type name for a Go type
 func (hw *cheaderWriter) goCTypeName(t types.Type) string {
+	if hw.cgo {
+		if name, ok := cgoCTypeName(t); ok {
+			return name
+		}
+	}
 	switch typ := t.(type) {
 	case *types.Basic:
 		switch typ.Kind() {
@@ -234,6 +255,8 @@ func (hw *cheaderWriter) goCTypeName(t types.Type) string {
 		// For named types, always use the named type
 		pkg := typ.Obj().Pkg()
 		return fmt.Sprintf("%s_%s", pkg.Name(), typ.Obj().Name())
+	case *types.Alias:
+		return hw.goCTypeName(types.Unalias(typ))
 	case *types.Signature:
 		// Function types are represented as function pointers in C
 		// Generate proper function pointer syntax
@@ -570,6 +593,18 @@ func (hw *cheaderWriter) writeFunctionDecl(fullName, linkName string, fn ssa.Fun
 	if !ok {
 		return fmt.Errorf("function %s does not have signature type", fullName)
 	}
+	cfn, err := hw.writeFuncDecl(fullName, linkName, sig)
+	if err != nil {
+		return err
+	}
+	hw.funcs = append(hw.funcs, cfn)
+	return nil
+}
+
+// writeFuncDecl writes C function declaration for the given signature.
+// Unsupported types are reported as errors instead of panics.
+func (hw *cheaderWriter) writeFuncDecl(fullName, linkName string, sig *types.Signature) (cfn *cFunc, err error) {
+	defer recoverTypeError(&err)
 
 	// Generate return type
 	var returnType string
@@ -578,21 +613,22 @@ func (hw *cheaderWriter) writeFunctionDecl(fullName, linkName string, fn ssa.Fun
 	} else if sig.Results().Len() == 1 {
 		retType := sig.Results().At(0).Type()
 		if err := hw.writeTypedef(retType); err != nil {
-			return err
+			return nil, err
 		}
 		returnType = hw.generateReturnType(retType)
 	} else {
-		return fmt.Errorf("function %s has more than one result", fullName)
+		return nil, fmt.Errorf("function %s has more than one result", fullName)
 	}
 
 	// Generate parameters
 	var params []string
+	cfn = &cFunc{name: fullName, ret: returnType}
 	for i := 0; i < sig.Params().Len(); i++ {
 		param := sig.Params().At(i)
 		paramType := param.Type()
 
 		if err := hw.writeTypedef(paramType); err != nil {
-			return err
+			return nil, err
 		}
 
 		paramName := param.Name()
@@ -600,12 +636,20 @@ func (hw *cheaderWriter) writeFunctionDecl(fullName, linkName string, fn ssa.Fun
 		// Generate parameter declaration
 		paramDecl := hw.generateParameterDeclaration(paramType, paramName)
 		params = append(params, paramDecl)
+
+		// C++ wrappers need a name for every parameter
+		if paramName == "" || paramName == "_" {
+			paramName = fmt.Sprintf("arg%d", i)
+		}
+		cfn.params = append(cfn.params, hw.generateParameterDeclaration(paramType, paramName))
+		cfn.args = append(cfn.args, paramName)
 	}
 
 	paramStr := strings.Join(params, ", ")
 	if paramStr == "" {
 		paramStr = "void"
 	}
+	writeDoc(hw.funcBuf, hw.docs[fullName])
 	// Write function declaration with return type on separate line for normal functions
 	fmt.Fprintln(hw.funcBuf, returnType)
 	// Generate function declaration using cross-platform macro when names differ
@@ -620,7 +664,7 @@ func (hw *cheaderWriter) writeFunctionDecl(fullName, linkName string, fn ssa.Fun
 	// Add empty line after each function declaration
 	fmt.Fprintln(hw.funcBuf)
 
-	return nil
+	return cfn, nil
 }
 
 // writeCommonIncludes writes common C header includes and Go runtime type definitions
@@ -633,6 +677,15 @@ func (hw *cheaderWriter) writeCommonIncludes() error {
     #define GO_SYMBOL_RENAME(go_name) __asm(go_name);
 #endif
 
+// Compile-time layout checks of exported Go types
+#ifdef __cplusplus
+    #define GO_STATIC_ASSERT(cond, msg) static_assert(cond, msg)
+    #define GO_ALIGNOF(type) alignof(type)
+#else
+    #define GO_STATIC_ASSERT(cond, msg) _Static_assert(cond, msg)
+    #define GO_ALIGNOF(type) _Alignof(type)
+#endif
+
 // Go runtime types
 typedef struct { const char *p; intptr_t n; } GoString;
 typedef struct { void *data; intptr_t len; intptr_t cap; } GoSlice;
@@ -647,6 +700,41 @@ typedef struct { double real; double imag; } GoComplex128;
 	if _, err := hw.typeBuf.WriteString(includes); err != nil {
 		return err
 	}
+
+	// Mark predefined Go types as declared
+	hw.declaredTypes["GoString"] = true
+	hw.declaredTypes["GoSlice"] = true
+	hw.declaredTypes["GoMap"] = true
+	hw.declaredTypes["GoChan"] = true
+	hw.declaredTypes["GoInterface"] = true
+	hw.declaredTypes["GoComplex64"] = true
+	hw.declaredTypes["GoComplex128"] = true
+	return nil
+}
+
+// writeExportFuncs writes declarations of the functions pkg exports to C.
+func (hw *cheaderWriter) writeExportFuncs(pkg ssa.Package) error {
+	exports := pkg.ExportFuncs()
+	// Sort functions for testing
+	exportNames := make([]string, 0, len(exports))
+	for name := range exports {
+		exportNames = append(exportNames, name)
+	}
+	sort.Strings(exportNames)
+
+	for _, name := range exportNames { // name is goName
+		link := exports[name] // link is cName
+		fn := pkg.FuncOf(link)
+		if fn == nil {
+			return fmt.Errorf("function %s not found", link)
+		}
+		hw.docs[link] = hw.goDocs[name]
+
+		// Write function declaration with proper C types
+		if err := hw.writeFunctionDecl(link, link, fn); err != nil {
+			return fmt.Errorf("failed to write declaration for function %s: %w", name, err)
+		}
+	}
 	return nil
 }
 
@@ -659,6 +747,16 @@ func (hw *cheaderWriter) writeTo(w io.Writer) error {
 		}
 	}
 
+	// Then write constant definitions
+	if hw.constBuf.Len() > 0 {
+		if _, err := hw.constBuf.WriteTo(w); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, "\n"); err != nil {
+			return err
+		}
+	}
+
 	// Then write function declarations
 	if hw.funcBuf.Len() > 0 {
 		if _, err := hw.funcBuf.WriteTo(w); err != nil {
@@ -670,43 +768,35 @@ func (hw *cheaderWriter) writeTo(w io.Writer) error {
 }
 
 func genHeader(p ssa.Program, pkgs []ssa.Package, w io.Writer) error {
+	return genHeaderEx(p, pkgs, nil, w, nil)
+}
+
+// genHeaderEx writes the C header content to w. If cxx is not nil, the
+// body of the C++ wrapper namespace is written to it.
+func genHeaderEx(p ssa.Program, pkgs []ssa.Package, opts *Options, w, cxx io.Writer) error {
 	hw := newCHeaderWriter(p)
+	if opts != nil {
+		for _, src := range opts.Pkgs {
+			pkgPath, scope := src.Types.Path(), src.Types.Scope()
+			collectDocs(pkgPath, src.Files, hw.goDocs)
+			// types may be written earlier as dependencies of functions
+			for _, name := range scope.Names() {
+				if _, ok := scope.Lookup(name).(*types.TypeName); ok {
+					hw.docs[src.Types.Name()+"_"+name] = hw.goDocs[pkgPath+"."+name]
+				}
+			}
+		}
+	}
 
 	// Write common header includes and type definitions
 	if err := hw.writeCommonIncludes(); err != nil {
 		return err
 	}
 
-	// Mark predefined Go types as declared
-	hw.declaredTypes["GoString"] = true
-	hw.declaredTypes["GoSlice"] = true
-	hw.declaredTypes["GoMap"] = true
-	hw.declaredTypes["GoChan"] = true
-	hw.declaredTypes["GoInterface"] = true
-	hw.declaredTypes["GoComplex64"] = true
-	hw.declaredTypes["GoComplex128"] = true
-
 	// Process all exported functions
 	for _, pkg := range pkgs {
-		exports := pkg.ExportFuncs()
-		// Sort functions for testing
-		exportNames := make([]string, 0, len(exports))
-		for name := range exports {
-			exportNames = append(exportNames, name)
-		}
-		sort.Strings(exportNames)
-
-		for _, name := range exportNames { // name is goName
-			link := exports[name] // link is cName
-			fn := pkg.FuncOf(link)
-			if fn == nil {
-				return fmt.Errorf("function %s not found", link)
-			}
-
-			// Write function declaration with proper C types
-			if err := hw.writeFunctionDecl(link, link, fn); err != nil {
-				return fmt.Errorf("failed to write declaration for function %s: %w", name, err)
-			}
+		if err := hw.writeExportFuncs(pkg); err != nil {
+			return err
 		}
 
 		initFnName := pkg.Path() + ".init"
@@ -720,11 +810,41 @@ func genHeader(p ssa.Program, pkgs []ssa.Package, w io.Writer) error {
 		}
 	}
 
+	// Process exported types, constants and methods of source packages
+	if opts != nil {
+		for _, src := range opts.Pkgs {
+			hw.writeSourcePkg(src, findPkg(pkgs, src.Types.Path()))
+		}
+	}
+
 	// Write all content to output in the correct order
-	return hw.writeTo(w)
+	if err := hw.writeTo(w); err != nil {
+		return err
+	}
+	if cxx != nil {
+		return hw.writeCXX(cxx)
+	}
+	return nil
+}
+
+func findPkg(pkgs []ssa.Package, pkgPath string) ssa.Package {
+	for _, pkg := range pkgs {
+		if pkg.Path() == pkgPath {
+			return pkg
+		}
+	}
+	return nil
 }
 
+// GenHeaderFile generates a C header declaring the exported functions of pkgs.
 func GenHeaderFile(p ssa.Program, pkgs []ssa.Package, libName, headerPath string, verbose bool) error {
+	return GenHeaderFileEx(p, pkgs, libName, headerPath, nil, verbose)
+}
+
+// GenHeaderFileEx generates a C header declaring the exported functions of
+// pkgs, and the exported types, constants and methods described by opts.
+// If opts.CXX is set, a C++ wrapper header (.hpp) is generated next to it.
+func GenHeaderFileEx(p ssa.Program, pkgs []ssa.Package, libName, headerPath string, opts *Options, verbose bool) error {
 	// Write header file
 	w, err := os.Create(headerPath)
 	if err != nil {
@@ -736,12 +856,18 @@ func GenHeaderFile(p ssa.Program, pkgs []ssa.Package, libName, headerPath string
 		fmt.Fprintf(os.Stderr, "Generated C header: %s\n", headerPath)
 	}
 
+	var cxx *bytes.Buffer
+	if opts != nil && opts.CXX {
+		cxx = new(bytes.Buffer)
+	}
+
 	headerIdent := strings.ToUpper(strings.ReplaceAll(libName, "-", "_"))
 	headerContent := fmt.Sprintf(`/* Code generated by llgo; DO NOT EDIT. */
 
 #ifndef __%s_H_
 #define __%s_H_
 
+#include <stddef.h>
 #include <stdint.h>
 #include <stdbool.h>
 
@@ -752,7 +878,11 @@ extern "C" {
 
 	w.Write([]byte(headerContent))
 
-	if err = genHeader(p, pkgs, w); err != nil {
+	var cxxw io.Writer
+	if cxx != nil {
+		cxxw = cxx
+	}
+	if err = genHeaderEx(p, pkgs, opts, w, cxxw); err != nil {
 		return fmt.Errorf("failed to generate header content for %s: %w", libName, err)
 	}
 
@@ -765,6 +895,11 @@ extern "C" {
 #endif /* __%s_H_ */
 `, headerIdent)
 
-	_, err = w.Write([]byte(footerContent))
-	return err
+	if _, err = w.Write([]byte(footerContent)); err != nil {
+		return err
+	}
+	if cxx != nil {
+		return genCXXHeaderFile(cxx.Bytes(), libName, headerPath, verbose)
+	}
+	return nil
 }
@@ -5,9 +5,12 @@ package header
 
 import (
 	"bytes"
+	"go/ast"
+	"go/parser"
 	"go/token"
 	"go/types"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -936,3 +939,235 @@ func TestGenHeaderWithInitFunction(t *testing.T) {
 		t.Errorf("genHeader() should include init function declaration with name %s, got: %s", expectedInitName, got)
 	}
 }
+
+func TestGenHeaderFileEx(t *testing.T) {
+	const pkgPath = "github.com/goplus/llgo/test_buildmode/geo"
+	const src = `package geo
+
+// Point is a 2D point.
+type Point struct {
+	X, Y float64
+}
+
+// Color is a color index.
+type Color int32
+
+// Red is the default color.
+const Red Color = 1
+
+type Label string
+
+const Home Label = "home"
+
+type Flag bool
+
+const On Flag = true
+
+const (
+	Name  = "geo"
+	Scale = 2.5
+	Big   = 1 << 40
+	Neg   = -3
+	Cplx  = 1i
+)
+
+// Mul scales the point.
+func (p *Point) Mul(f float64) { p.X *= f; p.Y *= f }
+
+func (p Point) Len() float64 { return p.X + p.Y }
+
+// Sum adds two numbers.
+//
+//export Sum
+func Sum(a, b int) int { return a + b }
+`
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "geo.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpkg, err := new(types.Config).Check(pkgPath, fset, []*ast.File{f}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prog := ssa.NewProgram(nil)
+	lpkg := prog.NewPackage("geo", pkgPath)
+	point := tpkg.Scope().Lookup("Point").Type().(*types.Named)
+	for i := 0; i < point.NumMethods(); i++ {
+		m := point.Method(i)
+		fullName, _ := methodFullName(pkgPath, m)
+		lpkg.NewFunc(fullName, m.Type().(*types.Signature), ssa.InGo)
+	}
+	lpkg.NewFunc("Sum", tpkg.Scope().Lookup("Sum").Type().(*types.Signature), ssa.InGo)
+	lpkg.SetExport(pkgPath+".Sum", "Sum")
+
+	dir := t.TempDir()
+	headerPath := filepath.Join(dir, "geo-lib.h")
+	opts := &Options{Pkgs: []SourcePkg{{Types: tpkg, Files: []*ast.File{f}}}, CXX: true}
+	if err := GenHeaderFileEx(prog, []ssa.Package{lpkg}, "geo-lib", headerPath, opts, false); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(headerPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(data)
+	for _, sub := range []string{
+		"#include <stddef.h>",
+		"// Point is a 2D point.\ntypedef struct {\n    double X;\n    double Y;\n} geo_Point;",
+		"GO_STATIC_ASSERT(sizeof(geo_Point) == 16, \"geo_Point: unexpected size\");",
+		"GO_STATIC_ASSERT(GO_ALIGNOF(geo_Point) == 8, \"geo_Point: unexpected alignment\");",
+		"GO_STATIC_ASSERT(offsetof(geo_Point, Y) == 8, \"geo_Point.Y: unexpected offset\");",
+		"// Color is a color index.\ntypedef int32_t geo_Color;",
+		"// Red is the default color.\n#define geo_Red ((geo_Color)1)",
+		"#define geo_Name \"geo\"",
+		"#define geo_Home \"home\"",
+		"#define geo_On ((geo_Flag)true)",
+		"#define geo_Scale 2.5",
+		"#define geo_Big 1099511627776LL",
+		"#define geo_Neg (-3)",
+		"/* constant " + pkgPath + ".Cplx is not exported:",
+		"// Sum adds two numbers.\nintptr_t\nSum(intptr_t a, intptr_t b);",
+		"// Mul scales the point.\nvoid\ngeo_Point_Mul(geo_Point* self, double f) GO_SYMBOL_RENAME(\"" + pkgPath + ".(*Point).Mul\")",
+		"double\ngeo_Point_Len(geo_Point self) GO_SYMBOL_RENAME(\"" + pkgPath + ".Point.Len\")",
+	} {
+		if !strings.Contains(got, sub) {
+			t.Fatalf("Generated header is missing expected content:\n%s\n==> got:\n%s", sub, got)
+		}
+	}
+
+	data, err = os.ReadFile(filepath.Join(dir, "geo-lib.hpp"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = string(data)
+	for _, sub := range []string{
+		"#include \"geo-lib.h\"",
+		"namespace geo_lib {",
+		"inline intptr_t Sum(intptr_t a, intptr_t b) { return ::Sum(a, b); }",
+		"struct Point : ::geo_Point {\n" +
+			"    void Mul(double f) { return ::geo_Point_Mul(this, f); }\n" +
+			"    double Len() { return ::geo_Point_Len(*this); }\n};",
+		"} // namespace geo_lib",
+	} {
+		if !strings.Contains(got, sub) {
+			t.Fatalf("Generated C++ header is missing expected content:\n%s\n==> got:\n%s", sub, got)
+		}
+	}
+}
+
+func TestUnsupportedTypeDiagnostic(t *testing.T) {
+	prog := ssa.NewProgram(nil)
+	hw := newCHeaderWriter(prog)
+	tuple := types.NewTuple(types.NewVar(0, nil, "", types.Typ[types.Int]))
+	params := types.NewTuple(types.NewVar(0, nil, "x", tuple))
+	sig := types.NewSignatureType(nil, nil, nil, params, nil, false)
+	_, err := hw.writeFuncDecl("Bad", "Bad", sig)
+	if err == nil || !strings.Contains(err.Error(), "unsupported type") {
+		t.Fatalf("writeFuncDecl: got error %v, want unsupported type", err)
+	}
+}
+
+func TestCXXIdent(t *testing.T) {
+	for in, want := range map[string]string{
+		"mylib":   "mylib",
+		"my-lib":  "my_lib",
+		"lib.v2":  "lib_v2",
+		"2d-geom": "_2d_geom",
+	} {
+		if got := cxxIdent(in); got != want {
+			t.Errorf("cxxIdent(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestCgoCTypeName(t *testing.T) {
+	pkg := types.NewPackage("main", "main")
+	named := func(name string, u types.Type) types.Type {
+		return types.NewNamed(types.NewTypeName(token.NoPos, pkg, name, nil), u, nil)
+	}
+	anon := named("_Ctype_struct___0", types.NewStruct(nil, nil))
+	tests := []struct {
+		typ  types.Type
+		want string
+		ok   bool
+	}{
+		{named("_Ctype_int", types.Typ[types.Int32]), "int", true},
+		{named("_Ctype_uint", types.Typ[types.Uint32]), "unsigned int", true},
+		{named("_Ctype_schar", types.Typ[types.Int8]), "signed char", true},
+		{named("_Ctype_ulonglong", types.Typ[types.Uint64]), "unsigned long long", true},
+		{named("_Ctype_struct_point", types.NewStruct(nil, nil)), "struct point", true},
+		{named("_Ctype_union_u", types.NewArray(types.Typ[types.Uint8], 8)), "union u", true},
+		{named("_Ctype_enum_color", types.Typ[types.Uint32]), "enum color", true},
+		{named("_Ctype_size_t", types.Typ[types.Uint64]), "size_t", true},
+		{types.NewAlias(types.NewTypeName(token.NoPos, pkg, "_Ctype_s4", nil), anon), "s4", true},
+		{anon, "", false},
+		{named("Foo", types.Typ[types.Int]), "", false},
+		{types.Typ[types.Int32], "", false},
+	}
+	for _, tt := range tests {
+		got, ok := cgoCTypeName(tt.typ)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("cgoCTypeName(%v) = %q, %v, want %q, %v", tt.typ, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestGenCgoExportHeader(t *testing.T) {
+	prog := ssa.NewProgram(nil)
+	prog.SetRuntime(func() *types.Package {
+		fset := token.NewFileSet()
+		imp := packages.NewImporter(fset)
+		pkg, _ := imp.Import(ssa.PkgRuntime)
+		return pkg
+	})
+
+	pkgPath := "github.com/goplus/llgo/test_cgo/main"
+	tpkg := types.NewPackage(pkgPath, "main")
+	cint := types.NewNamed(types.NewTypeName(token.NoPos, tpkg, "_Ctype_int", nil), types.Typ[types.Int32], nil)
+	cpoint := types.NewNamed(types.NewTypeName(token.NoPos, tpkg, "_Ctype_struct_point", nil),
+		types.NewStruct([]*types.Var{
+			types.NewField(token.NoPos, tpkg, "x", cint, false),
+			types.NewField(token.NoPos, tpkg, "y", cint, false),
+		}, nil), nil)
+
+	pkg := prog.NewPackage("main", pkgPath)
+	params := types.NewTuple(
+		types.NewVar(token.NoPos, nil, "p", types.NewPointer(cpoint)),
+		types.NewVar(token.NoPos, nil, "n", cint))
+	results := types.NewTuple(types.NewVar(token.NoPos, nil, "", cint))
+	pkg.NewFunc("goScale", types.NewSignatureType(nil, nil, nil, params, results, false), ssa.InGo)
+	pkg.SetExport(pkgPath+".goScale", "goScale")
+	name := types.NewTuple(types.NewVar(token.NoPos, nil, "s", types.Typ[types.String]))
+	pkg.NewFunc("goHello", types.NewSignatureType(nil, nil, nil, name, nil, false), ssa.InGo)
+	pkg.SetExport(pkgPath+".goHello", "goHello")
+
+	var buf bytes.Buffer
+	preamble := "struct point { int x; int y; };"
+	if err := GenCgoExportHeader(prog, pkg, preamble, &buf); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+	for _, sub := range []string{
+		"/* Code generated by llgo; DO NOT EDIT. */",
+		"#ifndef __CGO_EXPORT_H_",
+		preamble,
+		"typedef struct { const char *p; intptr_t n; } GoString;",
+		"void\ngoHello(GoString s);",
+		"int\ngoScale(struct point* p, int n);",
+		"#endif /* __CGO_EXPORT_H_ */",
+	} {
+		if !strings.Contains(got, sub) {
+			t.Fatalf("generated header is missing %q:\n%s", sub, got)
+		}
+	}
+	for _, sub := range []string{"main__Ctype", "typedef int32_t", "_init("} {
+		if strings.Contains(got, sub) {
+			t.Fatalf("generated header unexpectedly contains %q:\n%s", sub, got)
+		}
+	}
+	if strings.Index(got, preamble) > strings.Index(got, `extern "C"`) {
+		t.Fatalf("preamble should precede the extern \"C\" block:\n%s", got)
+	}
+}
@@ -14,96 +14,58 @@
  * limitations under the License.
  */
 
+// Package typepatch merges the types of alt packages, which patch standard
+// packages for llgo, with the types of the packages they patch. It works
+// through the exported API of go/types only, so that toolchain upgrades
+// can't break it by changing the layouts of types.Package or types.Scope.
 package typepatch
 
 import (
-	"go/token"
 	"go/types"
-	"unsafe"
+	"sync"
 )
 
-type typesPackage struct {
-	path      string
-	name      string
-	scope     *types.Scope
-	imports   []*types.Package
-	complete  bool
-	fake      bool   // scope lookup errors are silently dropped if package is fake (internal use only)
-	cgo       bool   // uses of this package will be rewritten into uses of declarations from _cgo_gotypes.go
-	goVersion string // minimum Go version required for package (by Config.GoVersion, typically from go.mod)
-}
-
-type typesScope struct {
-	parent   *types.Scope
-	children []*types.Scope
-	number   int
-	elems    map[string]types.Object // TODO(xsw): ensure offset of elems
-	pos, end token.Pos
-	comment  string
-	isFunc   bool
-}
-
-const (
-	tagPatched = 0x17
-)
+// patched holds the packages passed to Merge.
+var patched sync.Map // map[*types.Package]struct{}
 
+// IsPatched reports whether pkg is patched by an alt package, see Merge.
 func IsPatched(pkg *types.Package) bool {
 	if pkg == nil {
 		return false
 	}
-	p := (*typesPackage)(unsafe.Pointer(pkg))
-	return *(*uint8)(unsafe.Pointer(&p.complete)) == tagPatched
-}
-
-func setPatched(pkg *types.Package) {
-	p := (*typesPackage)(unsafe.Pointer(pkg))
-	*(*uint8)(unsafe.Pointer(&p.complete)) = tagPatched
-}
-
-func setScope(pkg *types.Package, scope *types.Scope) {
-	p := (*typesPackage)(unsafe.Pointer(pkg))
-	p.scope = scope
-}
-
-func getElems(scope *types.Scope) map[string]types.Object {
-	s := (*typesScope)(unsafe.Pointer(scope))
-	return s.elems
-}
-
-func setElems(scope *types.Scope, elems map[string]types.Object) {
-	s := (*typesScope)(unsafe.Pointer(scope))
-	s.elems = elems
+	_, ok := patched.Load(pkg)
+	return ok
 }
 
+// Clone returns a new package with the path, name, imports and package-level
+// objects of alt, to be merged with the package alt patches by Merge. The
+// objects keep alt as their package.
 func Clone(alt *types.Package) *types.Package {
-	ret := *alt
-	return &ret
+	ret := types.NewPackage(alt.Path(), alt.Name())
+	ret.SetImports(alt.Imports())
+	scope, altScope := ret.Scope(), alt.Scope()
+	for _, name := range altScope.Names() {
+		scope.Insert(altScope.Lookup(name))
+	}
+	if alt.Complete() {
+		ret.MarkComplete()
+	}
+	return ret
 }
 
+// Merge marks pkg patched by alt, a package returned by Clone, and adds the
+// package-level objects of pkg to alt, except the ones alt already declares
+// and the ones in skips. If skipall is set, alt replaces pkg entirely.
 func Merge(alt, pkg *types.Package, skips map[string]struct{}, skipall bool) {
-	setPatched(pkg)
+	patched.Store(pkg, struct{}{})
 	if skipall {
 		return
 	}
-
-	scope := *alt.Scope()
-	old := getElems(&scope)
-	elems := make(map[string]types.Object, len(old))
-
-	for name, o := range old {
-		elems[name] = o
-	}
-
-	setElems(&scope, elems)
-	setScope(alt, &scope)
-
-	for name, o := range getElems(pkg.Scope()) {
-		if _, ok := elems[name]; ok {
-			continue
-		}
+	scope, pkgScope := alt.Scope(), pkg.Scope()
+	for _, name := range pkgScope.Names() {
 		if _, ok := skips[name]; ok {
 			continue
 		}
-		elems[name] = o
+		scope.Insert(pkgScope.Lookup(name)) // keeps the object of alt if any
 	}
 }
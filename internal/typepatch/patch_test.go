@@ -0,0 +1,115 @@
+//go:build !llgo
+
+package typepatch
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func check(t *testing.T, path, src string) *types.Package {
+	t.Helper()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path+".go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := types.Config{Importer: importer.Default()}
+	pkg, err := conf.Check(path, fset, []*ast.File{f}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pkg
+}
+
+func TestMerge(t *testing.T) {
+	pkg := check(t, "foo", `package foo
+
+type T struct{ x int }
+
+func Old() int  { return 1 }
+func Both() int { return 1 }
+func Skip() int { return 1 }
+`)
+	alt := check(t, "foo", `package foo
+
+func Both() int { return 2 }
+func New() int  { return 2 }
+`)
+	altScopeLen := alt.Scope().Len()
+
+	patch := Clone(alt)
+	if patch.Path() != "foo" || patch.Name() != "foo" || patch == alt || !patch.Complete() {
+		t.Fatalf("Clone = %v, complete %v", patch, patch.Complete())
+	}
+	if IsPatched(pkg) || IsPatched(patch) || IsPatched(nil) {
+		t.Fatal("IsPatched before Merge")
+	}
+
+	Merge(patch, pkg, map[string]struct{}{"Skip": {}}, false)
+	if !IsPatched(pkg) {
+		t.Fatal("pkg not patched by Merge")
+	}
+	scope := patch.Scope()
+	for name, from := range map[string]*types.Package{"T": pkg, "Old": pkg, "Both": alt, "New": alt} {
+		obj := scope.Lookup(name)
+		if obj == nil || obj.Pkg() != from {
+			t.Errorf("%s = %v, want an object of %v", name, obj, from)
+		}
+	}
+	if obj := scope.Lookup("Skip"); obj != nil {
+		t.Errorf("Skip = %v, want skipped", obj)
+	}
+	if alt.Scope().Len() != altScopeLen || pkg.Scope().Lookup("New") != nil {
+		t.Error("Merge changed the scope of alt or pkg")
+	}
+	if obj := pkg.Scope().Lookup("Old"); obj.Parent() != pkg.Scope() {
+		t.Error("Merge changed the parent scope of the objects of pkg")
+	}
+}
+
+func TestMergeSkipAll(t *testing.T) {
+	pkg := check(t, "bar", "package bar\n\nfunc Old() {}\n")
+	alt := check(t, "bar", "package bar\n\nfunc New() {}\n")
+	patch := Clone(alt)
+	Merge(patch, pkg, nil, true)
+	if !IsPatched(pkg) {
+		t.Fatal("pkg not patched by Merge")
+	}
+	if patch.Scope().Lookup("Old") != nil || patch.Scope().Lookup("New") == nil {
+		t.Errorf("skipall merged %v", patch.Scope().Names())
+	}
+}
+
+// TestNoUnsafe guards against mirroring the private layouts of go/types
+// again: they change between Go releases, and a stale mirror corrupts memory
+// silently instead of failing to build.
+func TestNoUnsafe(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := parser.ParseFile(fset, file, src, parser.ImportsOnly)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, imp := range f.Imports {
+			if path, _ := strconv.Unquote(imp.Path.Value); path == "unsafe" {
+				t.Errorf("%s imports unsafe: patch go/types through its exported API", file)
+			}
+		}
+	}
+}
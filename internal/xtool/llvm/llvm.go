@@ -28,6 +28,12 @@ func GetTargetTriple(goos, goarch string) string {
 		}
 	case "wasm":
 		llvmarch = "wasm32"
+	case "loong64":
+		llvmarch = "loongarch64"
+	case "ppc64le":
+		llvmarch = "powerpc64le"
+	case "mips64le":
+		llvmarch = "mips64el"
 	default:
 		llvmarch = goarch
 	}
@@ -51,5 +57,15 @@ func GetTargetTriple(goos, goarch string) string {
 	// Target triples (which actually have four components, but are called
 	// triples for historical reasons) have the form:
 	//   arch-vendor-os-environment
-	return llvmarch + "-" + llvmvendor + "-" + llvmos
+	triple := llvmarch + "-" + llvmvendor + "-" + llvmos
+	if goos == "linux" {
+		// architectures only supported through their GNU cross toolchains
+		switch goarch {
+		case "loong64", "ppc64le", "s390x":
+			triple += "-gnu"
+		case "mips64", "mips64le":
+			triple += "-gnuabi64"
+		}
+	}
+	return triple
 }
@@ -40,12 +40,16 @@ func TestGetTargetTriple(t *testing.T) {
 
 	// Map our architecture names to clang's architecture names
 	clangArchMap := map[string][]string{
-		"x86_64":  {"x86-64", "x86_64"},
-		"i386":    {"x86", "i386"},
-		"aarch64": {"aarch64", "arm64"},
-		"arm64":   {"arm64", "aarch64"},
-		"armv7":   {"arm", "thumb"},
-		"wasm32":  {"wasm32"},
+		"x86_64":      {"x86-64", "x86_64"},
+		"i386":        {"x86", "i386"},
+		"aarch64":     {"aarch64", "arm64"},
+		"arm64":       {"arm64", "aarch64"},
+		"armv7":       {"arm", "thumb"},
+		"wasm32":      {"wasm32"},
+		"loongarch64": {"loongarch64"},
+		"powerpc64le": {"ppc64le"},
+		"mips64":      {"mips64"},
+		"s390x":       {"systemz"},
 	}
 
 	// Define a function to check if the architecture is supported by clang
@@ -142,6 +146,10 @@ func TestGetTargetTriple(t *testing.T) {
 	checkTriple(t, "linux/386", "linux", "386", "i386-unknown-linux")
 	checkTriple(t, "linux/arm64", "linux", "arm64", "aarch64-unknown-linux")
 	checkTriple(t, "linux/arm", "linux", "arm", "armv7-unknown-linux")
+	checkTriple(t, "linux/loong64", "linux", "loong64", "loongarch64-unknown-linux-gnu")
+	checkTriple(t, "linux/ppc64le", "linux", "ppc64le", "powerpc64le-unknown-linux-gnu")
+	checkTriple(t, "linux/s390x", "linux", "s390x", "s390x-unknown-linux-gnu")
+	checkTriple(t, "linux/mips64", "linux", "mips64", "mips64-unknown-linux-gnuabi64")
 	checkTriple(t, "darwin/amd64", "darwin", "amd64", "x86_64-apple-macosx")
 	checkTriple(t, "darwin/arm64", "darwin", "arm64", "arm64-apple-macosx")
 	checkTriple(t, "windows/amd64", "windows", "amd64", "x86_64-unknown-windows")
@@ -34,6 +34,24 @@ func Init()
 //go:linkname Malloc C.GC_malloc
 func Malloc(size uintptr) c.Pointer
 
+// MallocAtomic allocates memory that the collector doesn't scan for
+// pointers. The memory isn't cleared.
+//
+//go:linkname MallocAtomic C.GC_malloc_atomic
+func MallocAtomic(size uintptr) c.Pointer
+
+// MallocUncollectable allocates memory that is scanned for pointers but is
+// never collected. It must be released by Free.
+//
+//go:linkname MallocUncollectable C.GC_malloc_uncollectable
+func MallocUncollectable(size uintptr) c.Pointer
+
+// MallocAtomicUncollectable allocates memory that is neither scanned nor
+// collected. It must be released by Free.
+//
+//go:linkname MallocAtomicUncollectable C.GC_malloc_atomic_uncollectable
+func MallocAtomicUncollectable(size uintptr) c.Pointer
+
 //go:linkname Realloc C.GC_realloc
 func Realloc(ptr c.Pointer, size uintptr) c.Pointer
 
@@ -100,6 +118,26 @@ func Gcollect()
 //go:linkname GetMemoryUse C.GC_get_memory_use
 func GetMemoryUse() uintptr
 
+//go:linkname GetHeapSize C.GC_get_heap_size
+func GetHeapSize() uintptr
+
+//go:linkname GetBytesSinceGC C.GC_get_bytes_since_gc
+func GetBytesSinceGC() uintptr
+
+//go:linkname GcollectAndUnmap C.GC_gcollect_and_unmap
+func GcollectAndUnmap()
+
+// -----------------------------------------------------------------------------
+
+// SetFreeSpaceDivisor sets the divisor of the heap size that is allocated
+// between collections. Larger values collect more often; the default is 3.
+//
+//go:linkname SetFreeSpaceDivisor C.GC_set_free_space_divisor
+func SetFreeSpaceDivisor(divisor uintptr)
+
+//go:linkname GetFreeSpaceDivisor C.GC_get_free_space_divisor
+func GetFreeSpaceDivisor() uintptr
+
 // -----------------------------------------------------------------------------
 
 //go:linkname EnableIncremental C.GC_enable_incremental
@@ -118,3 +156,38 @@ func StartIncrementalCollection()
 func CollectALittle()
 
 // -----------------------------------------------------------------------------
+
+// AllowRegisterThreads lets threads not created by the collector register
+// themselves with RegisterMyThread. It must be called from the main thread
+// after Init.
+//
+//go:linkname AllowRegisterThreads C.GC_allow_register_threads
+func AllowRegisterThreads()
+
+// ThreadIsRegistered reports whether the calling thread is known to the
+// collector.
+//
+//go:linkname ThreadIsRegistered C.GC_thread_is_registered
+func ThreadIsRegistered() c.Int
+
+// StackBase is struct GC_stack_base, the cold end of a thread stack.
+type StackBase struct {
+	MemBase c.Pointer
+}
+
+//go:linkname GetStackBase C.GC_get_stack_base
+func GetStackBase(sb *StackBase) c.Int
+
+// RegisterMyThread makes the calling thread's stack and registers visible to
+// the collector. It returns 0 (GC_SUCCESS) on success.
+//
+//go:linkname RegisterMyThread C.GC_register_my_thread
+func RegisterMyThread(sb *StackBase) c.Int
+
+// UnregisterMyThread undoes RegisterMyThread. It must be called before the
+// thread exits.
+//
+//go:linkname UnregisterMyThread C.GC_unregister_my_thread
+func UnregisterMyThread() c.Int
+
+// -----------------------------------------------------------------------------
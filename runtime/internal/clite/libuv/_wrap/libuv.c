@@ -5,7 +5,6 @@ extern void llgo_runtime_timerEvent(uv_async_t* handle);
 extern void llgo_runtime_timerCallback(uv_timer_t* handle);
 extern void llgo_time_timerEvent(uv_async_t* handle);
 extern void llgo_time_timerCallback(uv_timer_t* handle);
-extern void llgo_runtime_signalCallback(uv_signal_t* handle, int signum);
 
 static void llgo_uv_async_noop(uv_async_t* handle) {
   (void)handle;
@@ -31,14 +30,6 @@ int llgo_uv_timer_start_time(uv_timer_t* timer, uint64_t timeout, uint64_t repea
   return uv_timer_start(timer, llgo_time_timerCallback, timeout, repeat);
 }
 
-int llgo_uv_signal_start_runtime(uv_signal_t* handle, int signum) {
-  return uv_signal_start(handle, llgo_runtime_signalCallback, signum);
-}
-
-int llgo_uv_signal_start_oneshot_runtime(uv_signal_t* handle, int signum) {
-  return uv_signal_start_oneshot(handle, llgo_runtime_signalCallback, signum);
-}
-
 int uv_tcp_get_io_watcher_fd (uv_tcp_t* handle) {
   return handle->io_watcher.fd;
 }
@@ -20,9 +20,3 @@ func TimerStartRuntime(timer *Timer, timeoutMs uint64, repeat uint64) c.Int
 
 //go:linkname TimerStartTime C.llgo_uv_timer_start_time
 func TimerStartTime(timer *Timer, timeoutMs uint64, repeat uint64) c.Int
-
-//go:linkname SignalStartRuntime C.llgo_uv_signal_start_runtime
-func SignalStartRuntime(handle *Signal, signum c.Int) c.Int
-
-//go:linkname SignalStartOneshotRuntime C.llgo_uv_signal_start_oneshot_runtime
-func SignalStartOneshotRuntime(handle *Signal, signum c.Int) c.Int
@@ -0,0 +1,6 @@
+package setjmp
+
+const (
+	SigjmpBufSize = 304
+	JmpBufSize    = 304
+)
@@ -0,0 +1,8 @@
+//go:build linux && (mips64 || mips64le)
+
+package setjmp
+
+const (
+	SigjmpBufSize = 304
+	JmpBufSize    = 304
+)
@@ -0,0 +1,6 @@
+package setjmp
+
+const (
+	SigjmpBufSize = 656
+	JmpBufSize    = 656
+)
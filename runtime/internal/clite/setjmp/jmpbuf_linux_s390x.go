@@ -0,0 +1,6 @@
+package setjmp
+
+const (
+	SigjmpBufSize = 280
+	JmpBufSize    = 280
+)
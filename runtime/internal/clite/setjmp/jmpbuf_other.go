@@ -1,4 +1,4 @@
-//go:build !((linux || darwin) && (amd64 || arm64)) && !baremetal
+//go:build !((linux || darwin) && (amd64 || arm64)) && !(linux && (loong64 || ppc64le || s390x || mips64 || mips64le)) && !baremetal
 
 package setjmp
 
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package reflect
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/goplus/llgo/runtime/abi"
+	"github.com/goplus/llgo/runtime/internal/runtime"
+	"github.com/goplus/llgo/runtime/internal/runtime/goarch"
+)
+
+// Function types that the compiler has emitted trampolines for are called
+// and made through them. The arguments are passed as an array of pointers to
+// their values, and the results are stored in a buffer laid out as a struct
+// of the result types. The rest of function types fall back to libffi.
+
+//go:linkname funcStubs github.com/goplus/llgo/runtime/internal/runtime.funcStubs
+var funcStubs []*runtime.FuncStub
+
+var (
+	funcStubOnce sync.Once
+	funcStubMap  map[*abi.Type]*runtime.FuncStub
+)
+
+// funcStubOf returns the trampolines of the function type t, or nil if there
+// aren't any.
+func funcStubOf(t *abi.Type) *runtime.FuncStub {
+	funcStubOnce.Do(func() {
+		funcStubMap = make(map[*abi.Type]*runtime.FuncStub, len(funcStubs))
+		for _, stub := range funcStubs {
+			funcStubMap[stub.Type] = stub
+		}
+	})
+	return funcStubMap[t]
+}
+
+// resultsSize returns the size of the buffer holding results of types out.
+func resultsSize(out []*abi.Type) (size uintptr) {
+	for _, t := range out {
+		size = align(size, uintptr(t.Align_)) + t.Size_
+	}
+	return
+}
+
+// callStub calls fn of type ft with ctx and arguments in through the call
+// trampoline of ft.
+func callStub(stub *runtime.FuncStub, op string, ft *abi.FuncType, fn, ctx unsafe.Pointer, in []Value) []Value {
+	var args []unsafe.Pointer
+	if len(in) > 0 {
+		args = make([]unsafe.Pointer, len(in))
+		for i, v := range in {
+			targ := ft.In[i]
+			v = v.assignTo("reflect.Value."+op, targ, nil)
+			if v.flag&flagIndir != 0 {
+				args[i] = v.ptr
+			} else {
+				p := new(unsafe.Pointer)
+				*p = v.ptr
+				args[i] = unsafe.Pointer(p)
+			}
+		}
+	}
+	var ret unsafe.Pointer
+	if len(ft.Out) > 0 {
+		ret = runtime.AllocZ(resultsSize(ft.Out))
+	}
+	// the trampoline takes fn in the place of a closure's ctx
+	c := struct {
+		fn  unsafe.Pointer
+		env unsafe.Pointer
+	}{stub.Call, fn}
+	call := *(*func(ctx unsafe.Pointer, args *unsafe.Pointer, ret unsafe.Pointer))(unsafe.Pointer(&c))
+	call(ctx, unsafe.SliceData(args), ret)
+	if len(ft.Out) == 0 {
+		return nil
+	}
+	out := make([]Value, len(ft.Out))
+	var off uintptr
+	for i, t := range ft.Out {
+		off = align(off, uintptr(t.Align_))
+		out[i] = Value{t, add(ret, off, "results are laid out as a struct"), flagIndir | flag(t.Kind())}
+		resolveIndirectValue(&out[i], t)
+		off += t.Size_
+	}
+	return out
+}
+
+// stubArg returns the argument of type t at ptr, which lives in the frame
+// of a closure trampoline, as a Value.
+func stubArg(ptr unsafe.Pointer, t *abi.Type) Value {
+	fl := flag(t.Kind())
+	if t.IfaceIndir() {
+		p := unsafe_New(t)
+		typedmemmove(t, p, ptr)
+		return Value{t, p, fl | flagIndir}
+	}
+	var w unsafe.Pointer
+	typedmemmove(t, unsafe.Pointer(&w), ptr)
+	return Value{t, w, fl}
+}
+
+// makeFuncStub implements MakeFunc through the closure trampoline of ftyp.
+func makeFuncStub(stub *runtime.FuncStub, ftyp *funcType, fn func([]Value) []Value) Value {
+	handler := func(args *unsafe.Pointer, ret unsafe.Pointer) {
+		ins := make([]Value, len(ftyp.In))
+		for i, t := range ftyp.In {
+			arg := *(*unsafe.Pointer)(add(unsafe.Pointer(args), uintptr(i)*goarch.PtrSize, "args is an array of pointers"))
+			ins[i] = stubArg(arg, t)
+		}
+		outs := fn(ins)
+		if len(outs) != len(ftyp.Out) {
+			panic("reflect: wrong return count from function created by MakeFunc")
+		}
+		var off uintptr
+		for i, t := range ftyp.Out {
+			v := outs[i]
+			if v.typ() == nil {
+				panic("reflect: function created by MakeFunc returned zero Value")
+			}
+			if v.flag&flagRO != 0 {
+				panic("reflect: function created by MakeFunc returned value obtained from unexported field")
+			}
+			off = align(off, uintptr(t.Align_))
+			p := add(ret, off, "results are laid out as a struct")
+			v = v.assignTo("reflect.MakeFunc", t, nil)
+			if v.flag&flagIndir != 0 {
+				typedmemmove(t, p, v.ptr)
+			} else {
+				typedmemmove(t, p, unsafe.Pointer(&v.ptr))
+			}
+			off += t.Size_
+		}
+	}
+	styp := closureOf(ftyp)
+	fv := &struct {
+		fn  unsafe.Pointer
+		env unsafe.Pointer
+	}{stub.Closure, unsafe.Pointer(&handler)}
+	return Value{styp, unsafe.Pointer(fv), flagIndir | flag(Func)}
+}
@@ -43,6 +43,9 @@ func MakeFunc(typ Type, fn func(args []Value) (results []Value)) Value {
 
 	t := typ.common()
 	ftyp := (*funcType)(unsafe.Pointer(t))
+	if stub := funcStubOf(t); stub != nil {
+		return makeFuncStub(stub, ftyp, fn)
+	}
 	sig, err := toFFISig(append([]*abi.Type{unsafePointerType}, ftyp.In...), ftyp.Out)
 	if err != nil {
 		panic(err)
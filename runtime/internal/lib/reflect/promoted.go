@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package reflect
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/goplus/llgo/runtime/abi"
+	"github.com/goplus/llgo/runtime/internal/runtime"
+)
+
+// embeddedMethod is a method of an embedded field, which StructOf promotes
+// to the struct type.
+type embeddedMethod struct {
+	name  string
+	mtyp  *abi.FuncType
+	field int            // index of the embedded field
+	ifn   unsafe.Pointer // method code taking the receiver word of the field
+	iface bool           // the field is an interface
+	index int            // method index in the interface
+	deref bool           // the receiver word of the field is its value
+}
+
+// embeddedMethods returns the exported methods of the embedded field i of
+// type ft.
+func embeddedMethods(i int, ft *abi.Type) (ms []embeddedMethod) {
+	switch Kind(ft.Kind()) {
+	case Interface:
+		ift := (*interfaceType)(unsafe.Pointer(ft))
+		for j, m := range ift.Methods {
+			if m.PkgPath() != "" {
+				// Issue 15924.
+				panic("reflect: embedded interface with unexported method(s) not implemented")
+			}
+			ms = append(ms, embeddedMethod{name: m.Name(), mtyp: m.Typ_, field: i, iface: true, index: j})
+		}
+	default:
+		// the method set of *T includes the one of T, and pointers and
+		// direct interface values are their own receiver words
+		if unt := ft.Uncommon(); unt != nil {
+			for _, m := range unt.Methods() {
+				if m.PkgPath() != "" {
+					// Issue 15924.
+					panic("reflect: embedded type with unexported method(s) not implemented")
+				}
+				ms = append(ms, embeddedMethod{name: m.Name(), mtyp: m.Mtyp_, field: i, ifn: unsafe.Pointer(m.Ifn_), deref: ft.IsDirectIface()})
+			}
+		}
+	}
+	return
+}
+
+// promotedMethodSet returns the methods of embedded fields cands that are
+// promoted to the struct type with fields fs. Like the compiler, it drops
+// ambiguous methods and ones shadowed by a field name, and sorts the rest by
+// name.
+func promotedMethodSet(fs []structField, cands []embeddedMethod) []embeddedMethod {
+	count := make(map[string]int, len(cands))
+	for _, m := range cands {
+		count[m.name]++
+	}
+	for _, f := range fs {
+		count[f.Name_] += 2
+	}
+	var ms []embeddedMethod
+	for _, m := range cands {
+		if count[m.name] == 1 {
+			ms = append(ms, m)
+		}
+	}
+	for i := 1; i < len(ms); i++ {
+		for j := i; j > 0 && ms[j].name < ms[j-1].name; j-- {
+			ms[j], ms[j-1] = ms[j-1], ms[j]
+		}
+	}
+	return ms
+}
+
+// promoteMethods returns the method table of the struct type with fields fs
+// and promoted methods ms.
+func promoteMethods(fs []structField, ms []embeddedMethod) []abi.Method {
+	direct := len(fs) == 1 && !fs[0].Typ.IfaceIndir()
+	methods := make([]abi.Method, len(ms))
+	for i, m := range ms {
+		ifn, tfn := promoteMethod(fs, direct, m)
+		methods[i] = abi.Method{
+			Name_: m.name,
+			Mtyp_: m.mtyp,
+			Ifn_:  abi.Text(ifn),
+			Tfn_:  abi.Text(tfn),
+		}
+	}
+	return methods
+}
+
+//go:linkname promotedStubs github.com/goplus/llgo/runtime/internal/runtime.promotedStubs
+var promotedStubs []*runtime.PromotedStubs
+
+var (
+	promotedStubOnce sync.Once
+	promotedStubMap  map[*abi.Type]*runtime.PromotedStubs
+)
+
+// promotedStubsOf returns the trampolines of the method type t, or nil if
+// there aren't any.
+func promotedStubsOf(t *abi.Type) *runtime.PromotedStubs {
+	promotedStubOnce.Do(func() {
+		promotedStubMap = make(map[*abi.Type]*runtime.PromotedStubs, len(promotedStubs))
+		for _, stubs := range promotedStubs {
+			promotedStubMap[stubs.Type] = stubs
+		}
+	})
+	return promotedStubMap[t]
+}
+
+// promoteMethod returns the code of the method m promoted to a struct type
+// with fields fs, which is direct if its values are their own interface data
+// words.
+//
+// A method reached at the address of the struct, or through its only field
+// if it's direct, is the method of the field itself. Other methods need their
+// receivers adjusted, which is done by one of the trampolines the compiler
+// generates for the method type. They have no code taking the struct by
+// value: Method(i).Func of the type calls them through MakeFunc.
+//
+// promoteMethod is called with structLookupCache locked, which guards the
+// trampolines.
+func promoteMethod(fs []structField, direct bool, m embeddedMethod) (ifn, tfn unsafe.Pointer) {
+	f := &fs[m.field]
+	if direct || (!m.iface && !m.deref && f.Offset == 0) {
+		ifn = m.ifn
+	}
+	if direct {
+		tfn = ifn
+	}
+	if ifn != nil {
+		return
+	}
+	if stubs := promotedStubsOf(&m.mtyp.Type); stubs != nil {
+		for i := range stubs.Stubs {
+			stub := &stubs.Stubs[i]
+			if stub.Fn != nil || stub.Mode != runtime.PromotedAddr {
+				continue // in use
+			}
+			stub.Off = f.Offset
+			switch {
+			case m.iface:
+				stub.Mode = runtime.PromotedIface
+				stub.Index = uintptr(m.index)
+			case m.deref:
+				stub.Mode = runtime.PromotedDeref
+				stub.Fn = m.ifn
+			default:
+				stub.Fn = m.ifn
+			}
+			return stub.Code, nil
+		}
+	}
+	panic("reflect.StructOf: too many promoted methods of type " + stringFor(&m.mtyp.Type))
+}
+
+// promotedMethodFunc returns the function of method i of type t, a method
+// that StructOf promoted without code taking the receiver by value.
+func promotedMethodFunc(mt Type, i int) Value {
+	return MakeFunc(mt, func(in []Value) []Value {
+		m := in[0].Method(i)
+		if mt.IsVariadic() {
+			return m.CallSlice(in[1:])
+		}
+		return m.Call(in[1:])
+	})
+}
@@ -29,7 +29,6 @@ import (
 	"sync"
 
 	"github.com/goplus/llgo/runtime/abi"
-	clite "github.com/goplus/llgo/runtime/internal/clite"
 	_ "github.com/goplus/llgo/runtime/internal/runtime"
 	"github.com/goplus/llgo/runtime/internal/runtime/goarch"
 )
@@ -306,7 +305,11 @@ func (t *rtype) Method(i int) (m Method) {
 	}
 	mt := FuncOf(in, out, ft.Variadic())
 	m.Type = mt
-	m.Func = Value{&mt.(*rtype).t, p.Tfn_, fl}
+	if p.Tfn_ != nil {
+		m.Func = Value{&mt.(*rtype).t, p.Tfn_, fl}
+	} else {
+		m.Func = promotedMethodFunc(mt, i)
+	}
 	m.Index = i
 	return m
 }
@@ -1791,15 +1794,19 @@ func isPaddedField(t Type, i int) bool {
 // The Offset and Index fields are ignored and computed as they would be
 // by the compiler.
 //
-// StructOf currently does not support promoted methods of embedded fields
-// and panics if passed unexported StructFields.
+// Methods of embedded fields are promoted to the struct type as the compiler
+// does. Promoted methods that need their receivers adjusted are implemented
+// with trampolines the compiler generates for each method type, a few of
+// them per type. StructOf panics if passed unexported StructFields, or if it
+// runs out of trampolines.
 func StructOf(fields []StructField) Type {
 	var (
 		hash       = fnv1(0, []byte("struct {")...)
 		size       uintptr
 		typalign   uint8
 		comparable = true
-		methods    []abi.Method
+		promoted   []embeddedMethod
+		embedded   []embeddedMethod
 
 		fs   = make([]structField, len(fields))
 		repr = make([]byte, 0, 64)
@@ -1844,87 +1851,7 @@ func StructOf(fields []StructField) Type {
 				}
 			}
 
-			switch Kind(f.Typ.Kind()) {
-			case Interface:
-				ift := (*interfaceType)(unsafe.Pointer(ft))
-				for _, m := range ift.Methods {
-					if m.PkgPath() != "" {
-						// TODO(sbinet).  Issue 15924.
-						panic("reflect: embedded interface with unexported method(s) not implemented")
-					}
-
-					fnStub := clite.Func(embeddedIfaceMethStub)
-					methods = append(methods, abi.Method{
-						Name_: m.Name(),
-						Mtyp_: m.Typ_,
-						Ifn_:  fnStub,
-						Tfn_:  fnStub,
-					})
-				}
-			case Pointer:
-				ptr := (*ptrType)(unsafe.Pointer(ft))
-				if unt := ptr.Uncommon(); unt != nil {
-					if i > 0 && unt.Mcount > 0 {
-						// Issue 15924.
-						panic("reflect: embedded type with methods not implemented if type is not first field")
-					}
-					if len(fields) > 1 {
-						panic("reflect: embedded type with methods not implemented if there is more than one field")
-					}
-					for _, m := range unt.Methods() {
-						if m.PkgPath() != "" {
-							// TODO(sbinet).
-							// Issue 15924.
-							panic("reflect: embedded interface with unexported method(s) not implemented")
-						}
-						methods = append(methods, abi.Method{
-							Name_: m.Name(),
-							Mtyp_: m.Mtyp_,
-							Ifn_:  m.Ifn_,
-							Tfn_:  m.Tfn_,
-						})
-					}
-				}
-				if unt := ptr.Elem.Uncommon(); unt != nil {
-					for _, m := range unt.Methods() {
-						if m.PkgPath() != "" {
-							// TODO(sbinet)
-							// Issue 15924.
-							panic("reflect: embedded interface with unexported method(s) not implemented")
-						}
-						methods = append(methods, abi.Method{
-							Name_: m.Name(),
-							Mtyp_: m.Mtyp_,
-							Ifn_:  m.Ifn_,
-							Tfn_:  m.Tfn_,
-						})
-					}
-				}
-			default:
-				if unt := ft.Uncommon(); unt != nil {
-					if i > 0 && unt.Mcount > 0 {
-						// Issue 15924.
-						panic("reflect: embedded type with methods not implemented if type is not first field")
-					}
-					if len(fields) > 1 && ft.Kind_&abi.KindDirectIface != 0 {
-						panic("reflect: embedded type with methods not implemented for non-pointer type")
-					}
-					for _, m := range unt.Methods() {
-						if m.PkgPath() != "" {
-							// TODO(sbinet)
-							// Issue 15924.
-							panic("reflect: embedded interface with unexported method(s) not implemented")
-						}
-						methods = append(methods, abi.Method{
-							Name_: m.Name(),
-							Mtyp_: m.Mtyp_,
-							Ifn_:  m.Ifn_,
-							Tfn_:  m.Tfn_,
-						})
-
-					}
-				}
-			}
+			embedded = append(embedded, embeddedMethods(i, ft)...)
 		}
 		if _, dup := fset[name]; dup && name != "_" {
 			panic("reflect.StructOf: duplicate field " + name)
@@ -1964,6 +1891,10 @@ func StructOf(fields []StructField) Type {
 		fs[i] = f
 	}
 
+	if len(embedded) > 0 {
+		promoted = promotedMethodSet(fs, embedded)
+	}
+
 	if size > 0 && lastzero == size {
 		// This is a non-zero sized struct that ends in a
 		// zero-sized field. We add an extra byte of padding,
@@ -1978,8 +1909,9 @@ func StructOf(fields []StructField) Type {
 
 	var typ *structType
 	var ut *uncommonType
+	var methods []abi.Method
 
-	if len(methods) == 0 {
+	if len(promoted) == 0 {
 		t := new(structTypeUncommon)
 		typ = &t.structType
 		ut = &t.u
@@ -1992,19 +1924,17 @@ func StructOf(fields []StructField) Type {
 		tt := New(StructOf([]StructField{
 			{Name: "S", Type: TypeOf(structType{})},
 			{Name: "U", Type: TypeOf(uncommonType{})},
-			{Name: "M", Type: ArrayOf(len(methods), TypeOf(methods[0]))},
+			{Name: "M", Type: ArrayOf(len(promoted), TypeOf(abi.Method{}))},
 		}))
 
 		typ = (*structType)(tt.Elem().Field(0).Addr().UnsafePointer())
 		ut = (*uncommonType)(tt.Elem().Field(1).Addr().UnsafePointer())
 
-		copy(tt.Elem().Field(2).Slice(0, len(methods)).Interface().([]abi.Method), methods)
+		methods = tt.Elem().Field(2).Slice(0, len(promoted)).Interface().([]abi.Method)
 	}
-	// TODO(sbinet): Once we allow embedding multiple types,
-	// methods will need to be sorted like the compiler does.
 	// TODO(sbinet): Once we allow non-exported methods, we will
 	// need to compute xcount as the number of exported methods.
-	ut.Mcount = uint16(len(methods))
+	ut.Mcount = uint16(len(promoted))
 	ut.Xcount = ut.Mcount
 	ut.Moff = uint32(unsafe.Sizeof(uncommonType{}))
 
@@ -2072,6 +2002,12 @@ func StructOf(fields []StructField) Type {
 		}
 	}
 
+	// Only a new type gets the code of its promoted methods, as the
+	// trampolines some of them take are never released.
+	if len(promoted) > 0 {
+		copy(methods, promoteMethods(fs, promoted))
+	}
+
 	typ.Str_ = str
 	if isRegularMemory(toType(&typ.Type)) {
 		typ.TFlag = abi.TFlagRegularMemory
@@ -2328,10 +2264,6 @@ func align(x, n uintptr) uintptr {
 	return (x + n - 1) &^ (n - 1)
 }
 
-func embeddedIfaceMethStub() {
-	panic("reflect: StructOf does not support methods of embedded interfaces")
-}
-
 // runtimeStructField takes a StructField value passed to StructOf and
 // returns both the corresponding internal representation, of type
 // structField, and the pkgpath value to use for this field.
@@ -2471,6 +2471,12 @@ func (v Value) call(op string, in []Value) (out []Value) {
 	if nin != len(ft.In) {
 		panic("reflect.Value.Call: wrong argument count")
 	}
+	if ioff == 1 {
+		// closures and methods take their ctx or receiver first
+		if stub := funcStubOf(&ft.Type); stub != nil {
+			return callStub(stub, op, ft, fn, *(*unsafe.Pointer)(args[0]), in)
+		}
+	}
 
 	ffiArgs := make([]*ffi.Type, 0, len(tin)+4)
 	for i := 0; i < ioff; i++ {
@@ -3,15 +3,12 @@ package runtime
 import (
 	"time"
 	_ "unsafe"
-)
 
-var (
-	llgoMaxStack   int
-	llgoGCPercent  int32 = 100
-	llgoMemLimit   int64
-	llgoMaxThreads int
+	"github.com/goplus/llgo/runtime/internal/runtime"
 )
 
+var llgoMaxThreads int
+
 //go:linkname setTraceback runtime/debug.SetTraceback
 func setTraceback(level string) {}
 
@@ -19,20 +16,22 @@ func setTraceback(level string) {}
 func readGCStats(_ *[]time.Duration) {}
 
 //go:linkname freeOSMemory runtime/debug.freeOSMemory
-func freeOSMemory() {}
+func freeOSMemory() {
+	runtime.FreeOSMemory()
+}
 
+// setMaxStack keeps the limit semantics of Go, but as goroutines don't grow
+// their stacks, the limit also caps the stacks reserved for goroutines
+// created later: see runtime.SetMaxStack.
+//
 //go:linkname setMaxStack runtime/debug.setMaxStack
 func setMaxStack(in int) (out int) {
-	out = llgoMaxStack
-	llgoMaxStack = in
-	return out
+	return runtime.SetMaxStack(in)
 }
 
 //go:linkname setGCPercent runtime/debug.setGCPercent
 func setGCPercent(in int32) (out int32) {
-	out = llgoGCPercent
-	llgoGCPercent = in
-	return out
+	return runtime.SetGCPercent(in)
 }
 
 //go:linkname setPanicOnFault runtime/debug.setPanicOnFault
@@ -47,9 +46,7 @@ func setMaxThreads(in int) (out int) {
 
 //go:linkname setMemoryLimit runtime/debug.setMemoryLimit
 func setMemoryLimit(in int64) (out int64) {
-	out = llgoMemLimit
-	llgoMemLimit = in
-	return out
+	return runtime.SetMemoryLimit(in)
 }
 
 //go:linkname runtime_debug_WriteHeapDump runtime/debug.WriteHeapDump
@@ -1,10 +1,23 @@
 package runtime
 
-// LockOSThread and UnlockOSThread are currently no-ops for llgo.
+import "github.com/goplus/llgo/runtime/internal/runtime"
+
+// LockOSThread wires the calling goroutine to its current operating system
+// thread. The calling goroutine will always execute in that thread, and no
+// other goroutine will execute in it, until the calling goroutine has made as
+// many calls to UnlockOSThread as to LockOSThread. If the calling goroutine
+// exits without unlocking the thread, the thread will be terminated.
 //
-// The standard Go runtime uses these to pin a goroutine to its current OS
-// thread. llgo does not yet support migrating goroutines between OS threads, so
-// pinning is unnecessary.
-func LockOSThread() {}
+// Each goroutine of llgo runs on a thread of its own, which it never leaves,
+// so this only keeps count of the calls.
+func LockOSThread() {
+	runtime.LockOSThread()
+}
 
-func UnlockOSThread() {}
+// UnlockOSThread undoes an earlier call to LockOSThread. If this drops the
+// number of active LockOSThread calls on the calling goroutine to zero, it
+// unwires the calling goroutine from its fixed operating system thread. If
+// there are no active LockOSThread calls, this is a no-op.
+func UnlockOSThread() {
+	runtime.UnlockOSThread()
+}
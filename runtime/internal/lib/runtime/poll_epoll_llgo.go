@@ -0,0 +1,89 @@
+//go:build linux
+
+package runtime
+
+import (
+	"unsafe"
+
+	c "github.com/goplus/llgo/runtime/internal/clite"
+	cliteos "github.com/goplus/llgo/runtime/internal/clite/os"
+	csyscall "github.com/goplus/llgo/runtime/internal/clite/syscall"
+	"github.com/goplus/llgo/runtime/internal/runtime"
+)
+
+// epoll backend of the netpoller.
+
+const (
+	_EPOLLIN       = 0x1
+	_EPOLLOUT      = 0x4
+	_EPOLLERR      = 0x8
+	_EPOLLHUP      = 0x10
+	_EPOLLRDHUP    = 0x2000
+	_EPOLLET       = 0x80000000
+	_EPOLL_CLOEXEC = 0x80000
+	_EPOLL_CTL_ADD = 1
+	_EPOLL_CTL_DEL = 2
+)
+
+//go:linkname c_epoll_create1 C.epoll_create1
+func c_epoll_create1(flags c.Int) c.Int
+
+//go:linkname c_epoll_ctl C.epoll_ctl
+func c_epoll_ctl(epfd, op, fd c.Int, ev *epollEvent) c.Int
+
+//go:linkname c_epoll_wait C.epoll_wait
+func c_epoll_wait(epfd c.Int, evs *epollEvent, maxevents, timeout c.Int) c.Int
+
+var epfd c.Int = -1
+
+func netpollinit() c.Int {
+	epfd = c_epoll_create1(_EPOLL_CLOEXEC)
+	if epfd < 0 {
+		return c.Int(cliteos.Errno())
+	}
+	return 0
+}
+
+func netpollopen(fd c.Int, pd *llgoPollDesc) c.Int {
+	var ev epollEvent
+	ev.events = _EPOLLIN | _EPOLLOUT | _EPOLLRDHUP | _EPOLLET
+	*(**llgoPollDesc)(unsafe.Pointer(&ev.data)) = pd
+	if c_epoll_ctl(epfd, _EPOLL_CTL_ADD, fd, &ev) != 0 {
+		return c.Int(cliteos.Errno())
+	}
+	return 0
+}
+
+func netpollclose(fd c.Int) {
+	var ev epollEvent
+	c_epoll_ctl(epfd, _EPOLL_CTL_DEL, fd, &ev)
+}
+
+// netpollLoop waits for events and wakes the goroutines waiting for them.
+func netpollLoop() {
+	runtime.SystemGoroutine()
+	var events [128]epollEvent
+	for {
+		n := c_epoll_wait(epfd, &events[0], c.Int(len(events)), -1)
+		if n < 0 {
+			if int(cliteos.Errno()) == int(csyscall.EINTR) {
+				continue
+			}
+			c.Fprintf(c.Stderr, c.Str("runtime: epoll_wait failed with %d\n"), cliteos.Errno())
+			c.Exit(2)
+		}
+		for i := c.Int(0); i < n; i++ {
+			ev := &events[i]
+			var mode int32
+			if ev.events&(_EPOLLIN|_EPOLLRDHUP|_EPOLLHUP|_EPOLLERR) != 0 {
+				mode += 'r'
+			}
+			if ev.events&(_EPOLLOUT|_EPOLLHUP|_EPOLLERR) != 0 {
+				mode += 'w'
+			}
+			if mode != 0 {
+				netpollready(*(**llgoPollDesc)(unsafe.Pointer(&ev.data)), mode)
+			}
+		}
+	}
+}
@@ -0,0 +1,9 @@
+//go:build linux && (amd64 || 386)
+
+package runtime
+
+// epollEvent is struct epoll_event, which is packed on x86.
+type epollEvent struct {
+	events uint32
+	data   [2]uint32
+}
@@ -0,0 +1,103 @@
+//go:build darwin
+
+package runtime
+
+import (
+	"unsafe"
+
+	c "github.com/goplus/llgo/runtime/internal/clite"
+	cliteos "github.com/goplus/llgo/runtime/internal/clite/os"
+	csyscall "github.com/goplus/llgo/runtime/internal/clite/syscall"
+	"github.com/goplus/llgo/runtime/internal/runtime"
+)
+
+// kqueue backend of the netpoller.
+
+const (
+	_EVFILT_READ  = -1
+	_EVFILT_WRITE = -2
+	_EV_ADD       = 0x1
+	_EV_DELETE    = 0x2
+	_EV_CLEAR     = 0x20
+	_EV_ERROR     = 0x4000
+	_EV_EOF       = 0x8000
+)
+
+// keventT is struct kevent.
+type keventT struct {
+	ident  uintptr
+	filter int16
+	flags  uint16
+	fflags uint32
+	data   int64
+	udata  unsafe.Pointer
+}
+
+//go:linkname c_kqueue C.kqueue
+func c_kqueue() c.Int
+
+//go:linkname c_kevent C.kevent
+func c_kevent(kq c.Int, changes *keventT, nchanges c.Int, events *keventT, nevents c.Int, timeout unsafe.Pointer) c.Int
+
+var kq c.Int = -1
+
+func netpollinit() c.Int {
+	kq = c_kqueue()
+	if kq < 0 {
+		return c.Int(cliteos.Errno())
+	}
+	setCloseOnExec(kq)
+	return 0
+}
+
+func netpollopen(fd c.Int, pd *llgoPollDesc) c.Int {
+	// Edge-triggered (EV_CLEAR) read and write filters.
+	var ev [2]keventT
+	ev[0] = keventT{ident: uintptr(fd), filter: _EVFILT_READ, flags: _EV_ADD | _EV_CLEAR, udata: unsafe.Pointer(pd)}
+	ev[1] = keventT{ident: uintptr(fd), filter: _EVFILT_WRITE, flags: _EV_ADD | _EV_CLEAR, udata: unsafe.Pointer(pd)}
+	if c_kevent(kq, &ev[0], 2, nil, 0, nil) < 0 {
+		return c.Int(cliteos.Errno())
+	}
+	return 0
+}
+
+func netpollclose(fd c.Int) {
+	// Closing the fd removes its filters; deleting them here keeps the
+	// poller from reporting events for a descriptor being reused.
+	var ev [2]keventT
+	ev[0] = keventT{ident: uintptr(fd), filter: _EVFILT_READ, flags: _EV_DELETE}
+	ev[1] = keventT{ident: uintptr(fd), filter: _EVFILT_WRITE, flags: _EV_DELETE}
+	c_kevent(kq, &ev[0], 2, nil, 0, nil)
+}
+
+// netpollLoop waits for events and wakes the goroutines waiting for them.
+func netpollLoop() {
+	runtime.SystemGoroutine()
+	var events [128]keventT
+	for {
+		n := c_kevent(kq, nil, 0, &events[0], c.Int(len(events)), nil)
+		if n < 0 {
+			if int(cliteos.Errno()) == int(csyscall.EINTR) {
+				continue
+			}
+			c.Fprintf(c.Stderr, c.Str("runtime: kevent failed with %d\n"), cliteos.Errno())
+			c.Exit(2)
+		}
+		for i := c.Int(0); i < n; i++ {
+			ev := &events[i]
+			var mode int32
+			switch ev.filter {
+			case _EVFILT_READ:
+				mode += 'r'
+			case _EVFILT_WRITE:
+				mode += 'w'
+			}
+			if ev.flags&(_EV_EOF|_EV_ERROR) != 0 {
+				mode = 'r' + 'w'
+			}
+			if mode != 0 && ev.udata != nil {
+				netpollready((*llgoPollDesc)(ev.udata), mode)
+			}
+		}
+	}
+}
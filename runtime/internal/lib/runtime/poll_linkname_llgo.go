@@ -9,19 +9,16 @@ import (
 	cliteos "github.com/goplus/llgo/runtime/internal/clite/os"
 	psync "github.com/goplus/llgo/runtime/internal/clite/pthread/sync"
 	csyscall "github.com/goplus/llgo/runtime/internal/clite/syscall"
-	latomic "github.com/goplus/llgo/runtime/internal/lib/sync/atomic"
+	"github.com/goplus/llgo/runtime/internal/runtime"
 )
 
-// Minimal runtime netpoll backing for internal/poll.
+// Runtime netpoll backing for internal/poll.
 //
-// internal/poll assumes non-blocking I/O and a working runtime poller when
-// pollDesc.init succeeds. Our previous stubs returned "success" but never
-// actually blocked or unblocked, which caused tight spins and deadlocks (e.g.
-// listener Close waiting forever because Accept loops never observed "closing").
-//
-// This implementation uses libc poll(2) and a global wake pipe to interrupt
-// poll waits on close/unblock/deadline changes. It is intentionally minimal:
-// it aims for correctness for stdlib and tests, not high-performance netpoll.
+// Every descriptor is registered once, edge-triggered, with the platform
+// poller (epoll on Linux, kqueue on Darwin). A single poller goroutine waits
+// for events and wakes the goroutines blocked on the ready descriptors
+// through their condition variables, so a wakeup only costs the waiters of
+// one descriptor. Deadlines are runtime timers on the libuv timer loop.
 
 // Must match the error codes in internal/poll/fd_poll_runtime.go.
 const (
@@ -31,51 +28,43 @@ const (
 	pollErrNotPollable = 3
 )
 
-const (
-	pollIn  int16 = 0x0001
-	pollOut int16 = 0x0004
-)
-
-type pollfd struct {
-	fd      c.Int
-	events  int16
-	revents int16
-}
-
-//go:linkname c_poll C.poll
-func c_poll(fds *pollfd, nfds uintptr, timeout c.Int) c.Int
-
 type llgoPollDesc struct {
 	fd c.Int
 
-	closing uint32
+	mu   psync.Mutex // protects the fields below
+	cond psync.Cond  // broadcast on readiness, deadline and close
+
+	closing bool
+	rready  bool // readable since the last pollReset
+	wready  bool // writable since the last pollReset
 
-	// Absolute deadlines in nanoseconds. 0 means no deadline.
+	// Absolute deadlines in nanoseconds. 0 means no deadline, -1 means
+	// the deadline expired.
 	rd int64
 	wd int64
+
+	// Deadline timers. rseq and wseq are bumped when a deadline changes or
+	// the descriptor is reused, so stale timers are ignored.
+	rt   runtimeTimer
+	wt   runtimeTimer
+	rseq uintptr
+	wseq uintptr
 }
 
 var pollOnce psync.Once
-var wakeR, wakeW c.Int
+var pollOK bool // the platform poller is available
 var pollDescMu psync.Mutex
-var pollDescRoots map[uintptr]*llgoPollDesc
+var pollDescAll []*llgoPollDesc  // all descriptors, never freed: the poller refers to them
+var pollDescFree []*llgoPollDesc // descriptors available for reuse
 
 func pollInit() {
 	pollDescMu.Init(nil)
-	pollDescRoots = make(map[uintptr]*llgoPollDesc)
-
-	var fds [2]c.Int
-	if cliteos.Pipe(&fds) != 0 {
-		// If we can't create the wake pipe, we fall back to a non-pollable mode.
+	if netpollinit() != 0 {
 		// internal/poll will then use blocking operations (with reduced features).
-		wakeR, wakeW = -1, -1
 		return
 	}
-	wakeR, wakeW = fds[0], fds[1]
-	setCloseOnExec(wakeR)
-	setCloseOnExec(wakeW)
-	setNonblock(wakeR)
-	setNonblock(wakeW)
+	pollOK = true
+	go netpollLoop()
 }
 
 func setCloseOnExec(fd c.Int) {
@@ -86,92 +75,94 @@ func setCloseOnExec(fd c.Int) {
 	_ = cliteos.Fcntl(fd, c.Int(csyscall.F_SETFD), uintptr(flags)|uintptr(csyscall.FD_CLOEXEC))
 }
 
-func setNonblock(fd c.Int) {
-	flags := cliteos.Fcntl(fd, c.Int(csyscall.F_GETFL))
-	if flags < 0 {
-		return
+func pollDescAlloc() *llgoPollDesc {
+	pollDescMu.Lock()
+	defer pollDescMu.Unlock()
+	if n := len(pollDescFree); n > 0 {
+		pd := pollDescFree[n-1]
+		pollDescFree = pollDescFree[:n-1]
+		return pd
 	}
-	_ = cliteos.Fcntl(fd, c.Int(csyscall.F_SETFL), uintptr(flags)|uintptr(csyscall.O_NONBLOCK))
+	pd := &llgoPollDesc{}
+	pd.mu.Init(nil)
+	pd.cond.Init(nil)
+	pollDescAll = append(pollDescAll, pd)
+	return pd
 }
 
-func pollRootAdd(pd *llgoPollDesc) uintptr {
-	if pd == nil {
-		return 0
-	}
-	ctx := uintptr(unsafe.Pointer(pd))
+func pollDescRelease(pd *llgoPollDesc) {
 	pollDescMu.Lock()
-	pollDescRoots[ctx] = pd
+	pollDescFree = append(pollDescFree, pd)
 	pollDescMu.Unlock()
-	return ctx
 }
 
-func pollRootDel(ctx uintptr) {
-	if ctx == 0 {
-		return
+// netpollready is called by the poller when pd is ready for mode, which is
+// 'r', 'w' or 'r'+'w'.
+func netpollready(pd *llgoPollDesc, mode int32) {
+	pd.mu.Lock()
+	if mode == 'r' || mode == 'r'+'w' {
+		pd.rready = true
 	}
-	pollDescMu.Lock()
-	delete(pollDescRoots, ctx)
-	pollDescMu.Unlock()
-}
-
-func pollWake() {
-	pollOnce.Do(pollInit)
-	if wakeW < 0 {
-		return
+	if mode == 'w' || mode == 'r'+'w' {
+		pd.wready = true
 	}
-	var b [1]byte
-	// Best-effort; ignore EAGAIN (pipe full) and other errors.
-	_ = cliteos.Write(wakeW, unsafe.Pointer(&b[0]), 1)
+	pd.cond.Broadcast()
+	pd.mu.Unlock()
 }
 
-func pollDrainWake() {
-	if wakeR < 0 {
-		return
+// pollCheckErr returns the error of a wait on pd for mode. pd.mu is held.
+func pollCheckErr(pd *llgoPollDesc, mode int) int {
+	if pd.closing {
+		return pollErrClosing
 	}
-	var buf [64]byte
-	for {
-		n := cliteos.Read(wakeR, unsafe.Pointer(&buf[0]), uintptr(len(buf)))
-		if n <= 0 {
-			// Either EAGAIN (empty) or an error; both are fine.
-			return
-		}
+	if mode == 'r' && pollExpired(pd.rd) || mode == 'w' && pollExpired(pd.wd) {
+		return pollErrTimeout
 	}
+	return pollNoError
 }
 
-func pollDeadline(pd *llgoPollDesc, mode int) int64 {
-	switch mode {
-	case 'r':
-		return latomic.LoadInt64(&pd.rd)
-	case 'w':
-		return latomic.LoadInt64(&pd.wd)
-	default:
-		rd := latomic.LoadInt64(&pd.rd)
-		wd := latomic.LoadInt64(&pd.wd)
-		if rd == 0 {
-			return wd
-		}
-		if wd == 0 || rd < wd {
-			return rd
-		}
-		return wd
+func pollExpired(deadline int64) bool {
+	return deadline < 0 || deadline > 0 && deadline <= runtimeNano()
+}
+
+// pollSetDeadline sets the deadline of one mode of pd, arming its timer.
+// pd.mu is held.
+func pollSetDeadline(pd *llgoPollDesc, d *int64, t *runtimeTimer, seq *uintptr, bit uintptr, deadline int64) {
+	old := *d
+	*d = deadline
+	*seq++
+	if deadline > 0 {
+		resetRuntimeTimer(t, deadline, 0, timerFunc(pollDeadlineExpired), pd, *seq<<1|bit)
+	} else if old > 0 {
+		stopRuntimeTimer(t)
 	}
 }
 
-func pollTimeoutMs(deadline int64) (c.Int, int) {
-	if deadline == 0 {
-		return -1, pollNoError
+// pollStopTimers stops the deadline timers of pd. pd.mu is held.
+func pollStopTimers(pd *llgoPollDesc) {
+	if pd.rd > 0 {
+		stopRuntimeTimer(&pd.rt)
 	}
-	now := runtimeNano()
-	remain := deadline - now
-	if remain <= 0 {
-		return 0, pollErrTimeout
+	if pd.wd > 0 {
+		stopRuntimeTimer(&pd.wt)
 	}
-	// poll expects milliseconds. Round up to avoid premature timeout.
-	ms := (remain + 1e6 - 1) / 1e6
-	if ms > int64(^uint32(0)>>1) { // int32 max-ish
-		ms = int64(^uint32(0) >> 1)
+	pd.rseq++
+	pd.wseq++
+}
+
+// pollDeadlineExpired is the callback of deadline timers.
+func pollDeadlineExpired(arg any, seq uintptr) {
+	pd := arg.(*llgoPollDesc)
+	pd.mu.Lock()
+	if seq&1 == 0 {
+		if seq>>1 == pd.rseq {
+			pd.rd = -1
+		}
+	} else if seq>>1 == pd.wseq {
+		pd.wd = -1
 	}
-	return c.Int(ms), pollNoError
+	pd.cond.Broadcast()
+	pd.mu.Unlock()
 }
 
 //go:linkname poll_runtime_pollServerInit internal/poll.runtime_pollServerInit
@@ -182,23 +173,39 @@ func poll_runtime_pollServerInit() {
 //go:linkname poll_runtime_pollOpen internal/poll.runtime_pollOpen
 func poll_runtime_pollOpen(fd uintptr) (uintptr, int) {
 	pollOnce.Do(pollInit)
-	if wakeR < 0 {
+	if !pollOK {
 		return 0, int(csyscall.EOPNOTSUPP)
 	}
-	pd := &llgoPollDesc{fd: c.Int(fd)}
-	return pollRootAdd(pd), 0
+	pd := pollDescAlloc()
+	pd.mu.Lock()
+	pd.fd = c.Int(fd)
+	pd.closing = false
+	pd.rready, pd.wready = false, false
+	pd.rd, pd.wd = 0, 0
+	pd.rseq++
+	pd.wseq++
+	pd.mu.Unlock()
+	if errno := netpollopen(pd.fd, pd); errno != 0 {
+		pollDescRelease(pd)
+		return 0, int(errno)
+	}
+	return uintptr(unsafe.Pointer(pd)), 0
 }
 
 //go:linkname poll_runtime_pollClose internal/poll.runtime_pollClose
 func poll_runtime_pollClose(ctx uintptr) {
-	pollOnce.Do(pollInit)
 	if ctx == 0 {
 		return
 	}
 	pd := (*llgoPollDesc)(unsafe.Pointer(ctx))
-	latomic.StoreUint32(&pd.closing, 1)
-	pollWake()
-	pollRootDel(ctx)
+	netpollclose(pd.fd)
+	pd.mu.Lock()
+	pd.closing = true
+	pollStopTimers(pd)
+	pd.rd, pd.wd = 0, 0
+	pd.cond.Broadcast()
+	pd.mu.Unlock()
+	pollDescRelease(pd)
 }
 
 //go:linkname poll_runtime_pollWait internal/poll.runtime_pollWait
@@ -207,78 +214,28 @@ func poll_runtime_pollWait(ctx uintptr, mode int) int {
 		return pollErrNotPollable
 	}
 	pd := (*llgoPollDesc)(unsafe.Pointer(ctx))
-	if latomic.LoadUint32(&pd.closing) != 0 {
-		return pollErrClosing
-	}
-	pollOnce.Do(pollInit)
-	if wakeR < 0 {
-		return pollErrNotPollable
-	}
-
-	ev := pollIn
-	if mode == 'w' {
-		ev = pollOut
-	}
-	const maxWaitMs c.Int = 1000 // Ensure close/unblock is observed even if wake pipe misses.
+	pd.mu.Lock()
+	defer pd.mu.Unlock()
 	for {
-		if latomic.LoadUint32(&pd.closing) != 0 {
-			return pollErrClosing
-		}
-
-		timeout, derr := pollTimeoutMs(pollDeadline(pd, mode))
-		if derr != pollNoError {
-			return derr
+		if err := pollCheckErr(pd, mode); err != pollNoError {
+			return err
 		}
-		// Avoid indefinite poll waits so close/unblock is always observed in
-		// bounded time, even on platforms where closing the fd doesn't wake poll.
-		allowTimeout := true
-		if timeout < 0 || timeout > maxWaitMs {
-			timeout = maxWaitMs
-			allowTimeout = false
-		}
-
-		var fds [2]pollfd
-		fds[0] = pollfd{fd: pd.fd, events: ev}
-		fds[1] = pollfd{fd: wakeR, events: pollIn}
-
-		n := c_poll(&fds[0], 2, timeout)
-		if n < 0 {
-			errno := cliteos.Errno()
-			if int(errno) == int(csyscall.EINTR) {
-				continue
-			}
-			// Treat unexpected poll errors as readiness; syscall will return the real error.
+		// The fd is ready (or has an error/hangup); let the caller retry the syscall.
+		if mode == 'r' && pd.rready {
+			pd.rready = false
 			return pollNoError
 		}
-		if n == 0 {
-			if allowTimeout {
-				return pollErrTimeout
-			}
-			continue
-		}
-
-		// Wake pipe readable: drain and re-check state/deadline.
-		if fds[1].revents != 0 {
-			pollDrainWake()
-			if latomic.LoadUint32(&pd.closing) != 0 {
-				return pollErrClosing
-			}
-			continue
-		}
-
-		// The fd is ready (or has an error/hangup); let the caller retry the syscall.
-		if fds[0].revents != 0 {
-			if latomic.LoadUint32(&pd.closing) != 0 {
-				return pollErrClosing
-			}
+		if mode == 'w' && pd.wready {
+			pd.wready = false
 			return pollNoError
 		}
+		runtime.CondWait(&pd.cond, &pd.mu, runtime.WaitReasonIOWait)
 	}
 }
 
 //go:linkname poll_runtime_pollWaitCanceled internal/poll.runtime_pollWaitCanceled
 func poll_runtime_pollWaitCanceled(ctx uintptr, mode int) {
-	// No-op: our poller doesn't track per-wait cancellation state.
+	// No-op: only used on Windows.
 }
 
 //go:linkname poll_runtime_pollReset internal/poll.runtime_pollReset
@@ -287,11 +244,17 @@ func poll_runtime_pollReset(ctx uintptr, mode int) int {
 		return pollErrNotPollable
 	}
 	pd := (*llgoPollDesc)(unsafe.Pointer(ctx))
-	if latomic.LoadUint32(&pd.closing) != 0 {
-		return pollErrClosing
+	pd.mu.Lock()
+	defer pd.mu.Unlock()
+	err := pollCheckErr(pd, mode)
+	if err == pollNoError {
+		if mode == 'r' {
+			pd.rready = false
+		} else if mode == 'w' {
+			pd.wready = false
+		}
 	}
-	_, derr := pollTimeoutMs(pollDeadline(pd, mode))
-	return derr
+	return err
 }
 
 //go:linkname poll_runtime_pollSetDeadline internal/poll.runtime_pollSetDeadline
@@ -301,22 +264,31 @@ func poll_runtime_pollSetDeadline(ctx uintptr, d int64, mode int) {
 	}
 	pd := (*llgoPollDesc)(unsafe.Pointer(ctx))
 
-	var abs int64
-	if d != 0 {
+	// d is relative; a negative d means the deadline already expired.
+	abs := d
+	if d > 0 {
 		abs = runtimeNano() + d
+		if abs <= 0 {
+			abs = 1<<63 - 1 // overflow: no deadline in practice
+		}
+	} else if d < 0 {
+		abs = -1
 	}
 
-	switch mode {
-	case 'r':
-		latomic.StoreInt64(&pd.rd, abs)
-	case 'w':
-		latomic.StoreInt64(&pd.wd, abs)
-	default:
-		latomic.StoreInt64(&pd.rd, abs)
-		latomic.StoreInt64(&pd.wd, abs)
+	pd.mu.Lock()
+	if pd.closing {
+		pd.mu.Unlock()
+		return
+	}
+	if mode == 'r' || mode == 'r'+'w' {
+		pollSetDeadline(pd, &pd.rd, &pd.rt, &pd.rseq, 0, abs)
+	}
+	if mode == 'w' || mode == 'r'+'w' {
+		pollSetDeadline(pd, &pd.wd, &pd.wt, &pd.wseq, 1, abs)
 	}
-	// Wake any poll waiters to re-evaluate deadlines.
-	pollWake()
+	// Wake any waiters to re-evaluate deadlines.
+	pd.cond.Broadcast()
+	pd.mu.Unlock()
 }
 
 //go:linkname poll_runtime_pollUnblock internal/poll.runtime_pollUnblock
@@ -325,8 +297,11 @@ func poll_runtime_pollUnblock(ctx uintptr) {
 		return
 	}
 	pd := (*llgoPollDesc)(unsafe.Pointer(ctx))
-	latomic.StoreUint32(&pd.closing, 1)
-	pollWake()
+	pd.mu.Lock()
+	pd.closing = true
+	pollStopTimers(pd)
+	pd.cond.Broadcast()
+	pd.mu.Unlock()
 }
 
 //go:linkname poll_runtime_isPollServerDescriptor internal/poll.runtime_isPollServerDescriptor
@@ -20,6 +20,7 @@ import (
 	"unsafe"
 
 	c "github.com/goplus/llgo/runtime/internal/clite"
+	cliteos "github.com/goplus/llgo/runtime/internal/clite/os"
 	"github.com/goplus/llgo/runtime/internal/runtime"
 )
 
@@ -38,10 +39,43 @@ func Version() string {
 	return buildVersion
 }
 
+func init() {
+	if v := cliteos.Getenv(c.Str("GOMAXPROCS")); v != nil {
+		if n := int(c.Atoi(v)); n > 0 {
+			runtime.SetMaxProcs(n)
+		}
+	}
+}
+
+// GOMAXPROCS sets the maximum number of goroutines that can be executing
+// simultaneously and returns the previous setting. It defaults to the value
+// of runtime.NumCPU. If n < 1, it does not change the current setting.
+// Goroutines blocked in channel operations, synchronization, system calls or
+// calls to C don't count.
+//
+// Unlike Go, llgo doesn't preempt goroutines: under the limit, one that loops
+// without blocking or calling Gosched keeps others from running in its place,
+// and spinning until another goroutine makes progress may hang. So llgo only
+// enforces the limit once it is set, by this function or the GOMAXPROCS
+// environment variable: until then, goroutines all run at once.
 func GOMAXPROCS(n int) int {
+	if old := runtime.SetMaxProcs(n); old > 0 {
+		return old
+	}
+	return NumCPU()
+}
+
+// NumCPU returns the number of logical CPUs usable by the current process.
+func NumCPU() int {
 	return int(c_maxprocs())
 }
 
+// Gosched yields the processor, allowing other goroutines to run. It does not
+// suspend the current goroutine, so execution resumes automatically.
+func Gosched() {
+	runtime.Gosched()
+}
+
 func Goexit() {
 	runtime.Goexit()
 }
@@ -7,6 +7,7 @@ import (
 
 	psync "github.com/goplus/llgo/runtime/internal/clite/pthread/sync"
 	latomic "github.com/goplus/llgo/runtime/internal/lib/sync/atomic"
+	"github.com/goplus/llgo/runtime/internal/runtime"
 )
 
 // Minimal semaphore + notify list support for stdlib sync on llgo/darwin.
@@ -41,7 +42,7 @@ func getSemaState(addr *uint32) *semaState {
 	return st
 }
 
-func semaAcquire(addr *uint32) {
+func semaAcquire(addr *uint32, reason runtime.WaitReason) {
 	for {
 		v := latomic.LoadUint32(addr)
 		if v != 0 && latomic.CompareAndSwapUint32(addr, v, v-1) {
@@ -56,7 +57,7 @@ func semaAcquire(addr *uint32) {
 				return
 			}
 			st.waiters++
-			st.cond.Wait(&st.mu)
+			runtime.CondWait(&st.cond, &st.mu, reason)
 			st.waiters--
 		}
 	}
@@ -76,12 +77,12 @@ func semaRelease(addr *uint32) {
 //
 //go:linkname sync_runtime_Semacquire sync.runtime_Semacquire
 func sync_runtime_Semacquire(addr *uint32) {
-	semaAcquire(addr)
+	semaAcquire(addr, runtime.WaitReasonSemacquire)
 }
 
 //go:linkname poll_runtime_Semacquire internal/poll.runtime_Semacquire
 func poll_runtime_Semacquire(addr *uint32) {
-	semaAcquire(addr)
+	semaAcquire(addr, runtime.WaitReasonSemacquire)
 }
 
 //go:linkname sync_runtime_Semrelease sync.runtime_Semrelease
@@ -91,22 +92,22 @@ func sync_runtime_Semrelease(addr *uint32, _ bool, _ int) {
 
 //go:linkname sync_runtime_SemacquireRWMutexR sync.runtime_SemacquireRWMutexR
 func sync_runtime_SemacquireRWMutexR(addr *uint32, _ bool, _ int) {
-	semaAcquire(addr)
+	semaAcquire(addr, runtime.WaitReasonSyncRWMutexRLock)
 }
 
 //go:linkname sync_runtime_SemacquireRWMutex sync.runtime_SemacquireRWMutex
 func sync_runtime_SemacquireRWMutex(addr *uint32, _ bool, _ int) {
-	semaAcquire(addr)
+	semaAcquire(addr, runtime.WaitReasonSyncRWMutexLock)
 }
 
 //go:linkname sync_runtime_SemacquireWaitGroup sync.runtime_SemacquireWaitGroup
 func sync_runtime_SemacquireWaitGroup(addr *uint32, _ bool) {
-	semaAcquire(addr)
+	semaAcquire(addr, runtime.WaitReasonSyncWaitGroupWait)
 }
 
 // runtime_SemacquireMutex is used by internal/sync via linkname.
 func runtime_SemacquireMutex(addr *uint32, _ bool, _ int) {
-	semaAcquire(addr)
+	semaAcquire(addr, runtime.WaitReasonSyncMutexLock)
 }
 
 // sync_runtime_SemacquireMutex is used by older stdlib sync implementations.
@@ -218,7 +219,7 @@ func sync_runtime_notifyListWait(l *notifyList, t uint32) {
 	st := getNotifyState(l)
 	st.mu.Lock()
 	for latomic.LoadUint32(&l.notify) == t {
-		st.cond.Wait(&st.mu)
+		runtime.CondWait(&st.cond, &st.mu, runtime.WaitReasonSyncCondWait)
 	}
 	st.mu.Unlock()
 }
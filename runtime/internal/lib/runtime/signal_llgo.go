@@ -2,19 +2,18 @@ package runtime
 
 import (
 	c "github.com/goplus/llgo/runtime/internal/clite"
-	"github.com/goplus/llgo/runtime/internal/clite/libuv"
 	psync "github.com/goplus/llgo/runtime/internal/clite/pthread/sync"
 	latomic "github.com/goplus/llgo/runtime/internal/lib/sync/atomic"
+	"github.com/goplus/llgo/runtime/internal/runtime"
 )
 
-// Minimal signal support for stdlib os/signal on llgo/darwin.
+// Signal support for stdlib os/signal.
+//
+// The handler of the llgo runtime writes the signals os/signal asked for to
+// a pipe. A dedicated delivery thread reads them from the pipe and queues the
+// ones still wanted for signal_recv.
 
-type sigState struct {
-	handle  libuv.Signal
-	inited  bool
-	active  bool
-	ignored bool
-}
+const maxSignal = 65
 
 var (
 	sigInitState uint32
@@ -22,7 +21,7 @@ var (
 	sigMu     psync.Mutex
 	sigCond   psync.Cond
 	sigQueue  []uint32
-	sigStates map[uint32]*sigState
+	sigWanted [maxSignal]bool
 )
 
 func ensureSignalInit() {
@@ -38,10 +37,10 @@ func ensureSignalInit() {
 			return
 		case sigInitUninit:
 			if latomic.CompareAndSwapUint32(&sigInitState, sigInitUninit, sigInitBusy) {
-				ensureTimerLoop()
 				sigMu.Init(nil)
 				sigCond.Init(nil)
-				sigStates = make(map[uint32]*sigState)
+				runtime.SignalInit()
+				go signalDelivery()
 				latomic.StoreUint32(&sigInitState, sigInitDone)
 				return
 			}
@@ -50,115 +49,60 @@ func ensureSignalInit() {
 	}
 }
 
-//export llgo_runtime_signalCallback
-func llgo_runtime_signalCallback(handle *libuv.Signal, signum c.Int) {
-	signalCallback(handle, signum)
-}
-
-func signalCallback(handle *libuv.Signal, signum c.Int) {
-	sig := uint32(signum)
-	sigMu.Lock()
-	st := sigStates[sig]
-	if st == nil || !st.active || st.ignored {
+// signalDelivery is the delivery thread.
+func signalDelivery() {
+	runtime.SystemGoroutine()
+	for {
+		sig := runtime.SignalRead()
+		sigMu.Lock()
+		if sig < maxSignal && sigWanted[sig] {
+			sigQueue = append(sigQueue, sig)
+			sigCond.Signal()
+		}
 		sigMu.Unlock()
-		return
 	}
-	sigQueue = append(sigQueue, sig)
-	sigCond.Signal()
-	sigMu.Unlock()
-}
-
-func ensureSignalState(sig uint32) *sigState {
-	st := sigStates[sig]
-	if st == nil {
-		st = &sigState{}
-		sigStates[sig] = st
-	}
-	return st
-}
-
-func startSignalWatcher(sig uint32, st *sigState) {
-	if !st.inited {
-		submitTimerWork(func() bool {
-			checkUV("uv_signal_init", int(libuv.SignalInit(timerLoop, &st.handle)))
-			return true
-		})
-		st.inited = true
-	}
-	submitTimerWork(func() bool {
-		checkUV("uv_signal_start", int(libuv.SignalStartRuntime(&st.handle, c.Int(sig))))
-		return true
-	})
 }
 
 // signal_enable enables Go signal delivery for sig.
 func signal_enable(sig uint32) {
+	if sig >= maxSignal {
+		return
+	}
 	ensureSignalInit()
-	var st *sigState
-	var doStart bool
 	sigMu.Lock()
-	st = ensureSignalState(sig)
-	st.ignored = false
-	if !st.active {
-		st.active = true
-		doStart = true
-	}
+	sigWanted[sig] = true
+	runtime.SignalEnable(sig)
 	sigMu.Unlock()
-	if doStart {
-		startSignalWatcher(sig, st)
-	}
 }
 
 // signal_disable disables Go signal delivery for sig.
 func signal_disable(sig uint32) {
-	ensureSignalInit()
-	var st *sigState
-	var doStop bool
-	sigMu.Lock()
-	st = sigStates[sig]
-	if st == nil {
-		sigMu.Unlock()
+	if sig >= maxSignal {
 		return
 	}
-	if st.active && !st.ignored {
-		st.active = false
-		doStop = true
-	}
+	ensureSignalInit()
+	sigMu.Lock()
+	sigWanted[sig] = false
+	runtime.SignalDisable(sig)
 	sigMu.Unlock()
-	if doStop {
-		submitTimerWork(func() bool {
-			checkUV("uv_signal_stop", int(st.handle.Stop()))
-			return true
-		})
-	}
 }
 
 // signal_ignore causes sig to be ignored (do not deliver to Go).
 func signal_ignore(sig uint32) {
+	if sig >= maxSignal {
+		return
+	}
 	ensureSignalInit()
-	var st *sigState
-	var doStart bool
 	sigMu.Lock()
-	st = ensureSignalState(sig)
-	st.ignored = true
-	if !st.active {
-		st.active = true
-		doStart = true
-	}
+	sigWanted[sig] = false
+	runtime.SignalIgnore(sig)
 	sigMu.Unlock()
-	if doStart {
-		startSignalWatcher(sig, st)
-	}
 }
 
-// signal_ignored reports whether sig is being ignored.
+// signal_ignored reports whether sig is being ignored, by signal_ignore or
+// since the program started.
 func signal_ignored(sig uint32) bool {
-	ensureSignalInit()
-	sigMu.Lock()
-	st := sigStates[sig]
-	ignored := st != nil && st.ignored
-	sigMu.Unlock()
-	return ignored
+	return runtime.SignalIgnored(sig)
 }
 
 // signal_recv receives the next queued signal.
@@ -166,7 +110,7 @@ func signal_recv() uint32 {
 	ensureSignalInit()
 	sigMu.Lock()
 	for len(sigQueue) == 0 {
-		sigCond.Wait(&sigMu)
+		runtime.CondWait(&sigCond, &sigMu, runtime.WaitReasonSignalRecv)
 	}
 	sig := sigQueue[0]
 	sigQueue = sigQueue[1:]
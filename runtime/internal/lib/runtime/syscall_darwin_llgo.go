@@ -36,37 +36,49 @@ func llgo_rawSyscall6(fn, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2, err uintptr)
 
 //go:linkname syscall_syscall syscall.syscall
 func syscall_syscall(fn, a1, a2, a3 uintptr) (r1, r2, err uintptr) {
+	entersyscall()
 	r1, r2, err = llgo_syscall(fn, a1, a2, a3)
+	exitsyscall()
 	return normalizeSyscallErr(r1, r2, err)
 }
 
 //go:linkname syscall_syscall6 syscall.syscall6
 func syscall_syscall6(fn, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2, err uintptr) {
+	entersyscall()
 	r1, r2, err = llgo_syscall6(fn, a1, a2, a3, a4, a5, a6)
+	exitsyscall()
 	return normalizeSyscallErr(r1, r2, err)
 }
 
 //go:linkname syscall_syscall6X syscall.syscall6X
 func syscall_syscall6X(fn, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2, err uintptr) {
+	entersyscall()
 	r1, r2, err = llgo_syscall6X(fn, a1, a2, a3, a4, a5, a6)
+	exitsyscall()
 	return normalizeSyscallErr(r1, r2, err)
 }
 
 //go:linkname syscall_syscallPtr syscall.syscallPtr
 func syscall_syscallPtr(fn, a1, a2, a3 uintptr) (r1, r2, err uintptr) {
+	entersyscall()
 	r1, r2, err = llgo_syscallPtr(fn, a1, a2, a3)
+	exitsyscall()
 	return normalizeSyscallErr(r1, r2, err)
 }
 
 //go:linkname syscall_syscallX syscall.syscallX
 func syscall_syscallX(fn, a1, a2, a3 uintptr) (r1, r2, err uintptr) {
+	entersyscall()
 	r1, r2, err = llgo_syscall(fn, a1, a2, a3)
+	exitsyscall()
 	return normalizeSyscallErr(r1, r2, err)
 }
 
 //go:linkname syscall_syscall9 syscall.syscall9
 func syscall_syscall9(fn, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr) (r1, r2, err uintptr) {
+	entersyscall()
 	r1, r2, err = llgo_syscall9(fn, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+	exitsyscall()
 	return normalizeSyscallErr(r1, r2, err)
 }
 
@@ -1,6 +1,13 @@
 package runtime
 
-// entersyscall/exitsyscall are stubs for llgo to satisfy syscall hooks.
-func entersyscall() {}
+import "github.com/goplus/llgo/runtime/internal/runtime"
 
-func exitsyscall() {}
+// entersyscall/exitsyscall give up the proc of the goroutine while it is in
+// a system call that may block, as GOMAXPROCS only bounds running goroutines.
+func entersyscall() {
+	runtime.EnterBlocking()
+}
+
+func exitsyscall() {
+	runtime.ExitBlocking()
+}
@@ -12,6 +12,7 @@ import (
 	psync "github.com/goplus/llgo/runtime/internal/clite/pthread/sync"
 	ct "github.com/goplus/llgo/runtime/internal/clite/time"
 	latomic "github.com/goplus/llgo/runtime/internal/lib/sync/atomic"
+	"github.com/goplus/llgo/runtime/internal/runtime"
 )
 
 // Minimal time/timer support for stdlib time on llgo.
@@ -77,6 +78,7 @@ func ensureTimerLoop() {
 		}
 		timerDebugMsg("AsyncInit timerEvent ok")
 		go func() {
+			runtime.SystemGoroutine()
 			timerDebugMsg("Loop.Run begin")
 			if code := timerLoop.Run(libuv.RUN_DEFAULT); code != 0 {
 				panic(uvError("libuv loop", int(code)))
@@ -330,6 +332,10 @@ func fireRuntimeTimer(st *timerState, r *runtimeTimer, now int64) bool {
 	st.mu.Lock()
 	st.running = false
 	st.mu.Unlock()
+	if period <= 0 {
+		// not pending anymore once f woke up whoever waits for it
+		runtime.AddTimers(-1)
+	}
 	return period > 0
 }
 
@@ -345,8 +351,12 @@ func startTimer(r *runtimeTimer) {
 		st.inited = true
 		needInit = true
 	}
+	wasActive := st.active
 	st.active = true
 	st.mu.Unlock()
+	if !wasActive {
+		runtime.AddTimers(1)
+	}
 	submitTimerWork(func() bool {
 		if needInit {
 			checkUV("uv_timer_init", int(libuv.InitTimer(timerLoop, &st.timer)))
@@ -369,6 +379,9 @@ func stopTimer(r *runtimeTimer) bool {
 	wasInited := st.inited
 	st.active = false
 	st.mu.Unlock()
+	if wasActive {
+		runtime.AddTimers(-1)
+	}
 	if wasInited {
 		submitTimerWork(func() bool {
 			checkUV("uv_timer_stop", int(st.timer.Stop()))
@@ -417,6 +430,9 @@ func resetRuntimeTimer(r *runtimeTimer, when, period int64, f func(any, uintptr)
 	r.seq = seq
 	st.active = true
 	st.mu.Unlock()
+	if !wasActive {
+		runtime.AddTimers(1)
+	}
 
 	submitTimerWork(func() bool {
 		if needInit {
@@ -430,6 +446,11 @@ func resetRuntimeTimer(r *runtimeTimer, when, period int64, f func(any, uintptr)
 	return wasActive
 }
 
+// timerFunc adapts f to the callback type of runtimeTimer.
+func timerFunc(f func(arg any, seq uintptr)) func(any, uintptr) {
+	return f
+}
+
 func timerDelayMillis(when int64) uint64 {
 	now := runtimeNano()
 	if when <= now {
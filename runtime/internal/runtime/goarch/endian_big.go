@@ -1,5 +1,5 @@
-//go:build 386 || amd64 || arm || arm64 || ppc64le || mips64le || mipsle || riscv64 || wasm
-// +build 386 amd64 arm arm64 ppc64le mips64le mipsle riscv64 wasm
+//go:build ppc64 || s390x || mips || mips64
+// +build ppc64 s390x mips mips64
 
 package goarch
 
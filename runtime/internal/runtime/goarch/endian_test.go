@@ -0,0 +1,41 @@
+package goarch
+
+import (
+	"go/build"
+	"testing"
+	"unsafe"
+)
+
+func TestEndian(t *testing.T) {
+	x := uint16(1)
+	little := *(*byte)(unsafe.Pointer(&x)) == 1
+	if LittleEndian != little || BigEndian == little {
+		t.Fatalf("BigEndian = %v, LittleEndian = %v on a little endian (%v) host", BigEndian, LittleEndian, little)
+	}
+}
+
+// TestEndianFiles checks which of endian_big.go and endian_little.go each
+// arch builds, here and in clite/goarch, as the host only tests its own arch.
+func TestEndianFiles(t *testing.T) {
+	arches := map[string]bool{ // arch -> big endian
+		"386": false, "amd64": false, "arm": false, "arm64": false,
+		"loong64": false, "mips64le": false, "mipsle": false, "ppc64le": false,
+		"riscv64": false, "wasm": false,
+		"mips": true, "mips64": true, "ppc64": true, "s390x": true,
+	}
+	for _, dir := range []string{".", "../../clite/goarch"} {
+		for arch, big := range arches {
+			ctxt := build.Default
+			ctxt.GOOS, ctxt.GOARCH = "linux", arch
+			for file, want := range map[string]bool{"endian_big.go": big, "endian_little.go": !big} {
+				got, err := ctxt.MatchFile(dir, file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != want {
+					t.Errorf("%s/%s on %s: match = %v, want %v", dir, file, arch, got, want)
+				}
+			}
+		}
+	}
+}
@@ -218,8 +218,8 @@ func panicSlice3CU(x uint, y int)
 func panicSliceConvert(x int, y int)
 */
 
-var divideError error
-var overflowError error
+var divideError = error(errorString("integer divide by zero"))
+var overflowError = error(errorString("integer overflow"))
 
 var shiftError = error(errorString("negative shift amount"))
 
@@ -228,14 +228,10 @@ func Panicshift() {
 	panic(shiftError)
 }
 
-var divideError = error(errorString("integer divide by zero"))
-
 func Panicdivide() {
 	panic(divideError)
 }
 
-var overflowError = error(errorString("integer overflow"))
-
 func Panicoverflow() {
 	panic(overflowError)
 }
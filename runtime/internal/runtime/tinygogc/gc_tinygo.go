@@ -17,11 +17,13 @@
  * limitations under the License.
  */
 
-// Package tinygogc implements a conservative mark-and-sweep garbage collector
-// for baremetal environments where the standard Go runtime and bdwgc are unavailable.
+// Package tinygogc implements a mark-and-sweep garbage collector for baremetal
+// environments where the standard Go runtime and bdwgc are unavailable.
 //
 // This implementation is based on TinyGo's GC and is designed for resource-constrained
-// embedded systems. It uses a block-based allocator with conservative pointer scanning.
+// embedded systems. It uses a block-based allocator. Roots and objects of unknown
+// layout are scanned conservatively; objects allocated with their type are scanned
+// precisely using the pointer bitmap in abi.Type.GCData.
 //
 // Build tags:
 //   - baremetal: Enables this GC for baremetal targets
@@ -30,11 +32,13 @@
 // Memory Layout:
 // The heap is divided into fixed-size blocks (32 bytes on 64-bit). Metadata is stored
 // at the end of the heap, using 2 bits per block to track state (free/head/tail/mark).
+// Each object starts with a one-word header describing its layout.
 package tinygogc
 
 import (
 	"unsafe"
 
+	"github.com/goplus/llgo/runtime/abi"
 	c "github.com/goplus/llgo/runtime/internal/clite"
 )
 
@@ -82,6 +86,10 @@ var (
 
 	gcMutex  mutex // gcMutex protects GC related variables
 	isGCInit bool  // isGCInit indicates GC initialization state
+
+	gcPercent   int32   = 100         // heap growth that triggers a collection, negative if disabled
+	memoryLimit uintptr = ^uintptr(0) // heap size that triggers a collection regardless of gcPercent
+	nextGC      uintptr               // heap size that triggers the next collection, 0 if not computed yet
 )
 
 // Some globals + constants for the entire GC.
@@ -92,12 +100,22 @@ const (
 	stateBits          = 2 // how many bits a block state takes (see blockState type)
 	blocksPerStateByte = 8 / stateBits
 	markStackSize      = 8 * unsafe.Sizeof((*int)(nil)) // number of to-be-marked blocks to queue before forcing a rescan
+	wordSize           = unsafe.Sizeof(heapStart)
+	maxAlign           = 2 * wordSize // alignment of max_align_t: 8 on 32-bit and 16 on 64-bit targets
+	headerSize         = maxAlign     // size of the layout header of an object, padded to keep the object aligned
+)
+
+// The layout header of an object is one of the following values, or else the
+// *abi.Type of the elements of the object.
+const (
+	layoutUnknown uintptr = 0 // scanned conservatively
+	layoutNoScan  uintptr = 1 // holds no pointers, not scanned
 )
 
 // this function MUST be initalized first, which means it's required to be initalized before runtime
 func initGC() {
 	// reserve 2K blocks for libc internal malloc, we cannot wrap those internal functions
-	heapStart = uintptr(unsafe.Pointer(&_heapStart)) + 2048
+	heapStart = align(uintptr(unsafe.Pointer(&_heapStart)) + 2048)
 	heapEnd = uintptr(unsafe.Pointer(&_heapEnd))
 	globalsStart = uintptr(unsafe.Pointer(&_globals_start))
 	globalsEnd = uintptr(unsafe.Pointer(&_globals_end))
@@ -110,6 +128,12 @@ func initGC() {
 	c.Memset(metadataStart, 0, metadataSize)
 }
 
+// align rounds ptr up to maxAlign, so that blocks and the objects after
+// their layout header are suitably aligned for any type.
+func align(ptr uintptr) uintptr {
+	return (ptr + maxAlign - 1) &^ (maxAlign - 1)
+}
+
 func lazyInit() {
 	if !isGCInit {
 		initGC()
@@ -117,6 +141,57 @@ func lazyInit() {
 	}
 }
 
+// SetGCPercent sets the growth of the heap, in percent of the heap in use
+// after the last collection, that triggers the next collection. A negative
+// percentage disables collection until the memory limit is reached.
+func SetGCPercent(percent int32) {
+	lock(&gcMutex)
+	gcPercent = percent
+	nextGC = 0
+	unlock(&gcMutex)
+}
+
+// SetMemoryLimit sets the heap size that triggers a collection, regardless
+// of the GC percentage.
+func SetMemoryLimit(limit uintptr) {
+	lock(&gcMutex)
+	memoryLimit = limit
+	nextGC = 0
+	unlock(&gcMutex)
+}
+
+// heapAlloc returns the number of bytes in allocated blocks.
+func heapAlloc() uintptr {
+	return uintptr(gcTotalBlocks-gcFreedBlocks) * bytesPerBlock
+}
+
+// gcEnabled reports whether collections are triggered by allocation.
+func gcEnabled() bool {
+	return gcPercent >= 0 || memoryLimit != ^uintptr(0)
+}
+
+// updateNextGC computes the heap size that triggers the next collection
+// from the heap in use. Like Go's heap minimum, the heap may grow to a
+// quarter of its capacity, scaled by the GC percentage, before the first
+// collection.
+func updateNextGC(live uintptr) {
+	next := memoryLimit
+	if gcPercent >= 0 {
+		percent := uintptr(gcPercent)
+		goal := live + live/100*percent
+		if minGoal := (uintptr(metadataStart) - heapStart) / 4 / 100 * percent; goal < minGoal {
+			goal = minGoal
+		}
+		if goal < next {
+			next = goal
+		}
+	}
+	if next == 0 {
+		next = 1
+	}
+	nextGC = next
+}
+
 func gcPanic(s *c.Char) {
 	c.Printf(c.Str("%s"), s)
 	c.Exit(2)
@@ -243,15 +318,54 @@ func isOnHeap(ptr uintptr) bool {
 }
 
 func isPointer(ptr uintptr) bool {
-	// TODO: implement precise GC
 	return isOnHeap(ptr)
 }
 
+// pointerAt reports whether the word at offset off of an object whose
+// elements are of type t may hold a pointer. The offset is relative to the
+// object, which starts headerSize bytes after its first block.
+func pointerAt(t *abi.Type, off uintptr) bool {
+	if off%wordSize != 0 {
+		return false
+	}
+	off %= t.Size_
+	if off >= t.PtrBytes {
+		return false
+	}
+	w := off / wordSize
+	return *(*byte)(unsafe.Add(unsafe.Pointer(t.GCData), w/8))>>(w%8)&1 != 0
+}
+
+// Alloc allocates zero-initialized memory that is scanned conservatively.
+func Alloc(size uintptr) unsafe.Pointer {
+	return alloc(size, layoutUnknown)
+}
+
+// AllocNoScan allocates zero-initialized memory for objects without
+// pointers. The memory isn't scanned.
+func AllocNoScan(size uintptr) unsafe.Pointer {
+	return alloc(size, layoutNoScan)
+}
+
+// AllocTyped allocates zero-initialized memory for size bytes of objects of
+// type t. The memory is scanned precisely if t has a pointer bitmap.
+func AllocTyped(size uintptr, t *abi.Type) unsafe.Pointer {
+	switch {
+	case t == nil:
+		return alloc(size, layoutUnknown)
+	case t.PtrBytes == 0:
+		return alloc(size, layoutNoScan)
+	case t.GCData == nil:
+		return alloc(size, layoutUnknown)
+	}
+	return alloc(size, uintptr(unsafe.Pointer(t)))
+}
+
 // alloc tries to find some free space on the heap, possibly doing a garbage
 // collection cycle if needed. If no space is free, it panics.
 //
 //go:noinline
-func Alloc(size uintptr) unsafe.Pointer {
+func alloc(size uintptr, layout uintptr) unsafe.Pointer {
 	if size == 0 {
 		return unsafe.Pointer(&zeroSizedAlloc)
 	}
@@ -261,7 +375,16 @@ func Alloc(size uintptr) unsafe.Pointer {
 	gcTotalAlloc += uint64(size)
 	gcMallocs++
 
-	neededBlocks := (size + (bytesPerBlock - 1)) / bytesPerBlock
+	neededBlocks := (size + headerSize + (bytesPerBlock - 1)) / bytesPerBlock
+
+	// Collect if the heap grew past the goal set by the GC percentage or
+	// the memory limit.
+	if nextGC == 0 {
+		updateNextGC(heapAlloc())
+	}
+	if gcEnabled() && heapAlloc()+neededBlocks*bytesPerBlock > nextGC {
+		gc()
+	}
 	gcTotalBlocks += uint64(neededBlocks)
 
 	// Continue looping until a run of free blocks has been found that fits the
@@ -278,6 +401,9 @@ func Alloc(size uintptr) unsafe.Pointer {
 				// could be found. Run a garbage collection cycle to reclaim
 				// free memory and try again.
 				heapScanCount = 2
+				if !gcEnabled() {
+					gcPanic(c.Str("out of memory"))
+				}
 				freeBytes := gc()
 				heapSize := uintptr(metadataStart) - heapStart
 				if freeBytes < heapSize/3 {
@@ -337,14 +463,16 @@ func Alloc(size uintptr) unsafe.Pointer {
 				gcSetState(i, blockStateTail)
 			}
 			unlock(&gcMutex)
-			// Return a pointer to this allocation.
-			return c.Memset(gcPointerOf(thisAlloc), 0, size)
+			// Write the header and return a pointer to the object after it.
+			ptr := c.Memset(gcPointerOf(thisAlloc), 0, size+headerSize)
+			*(*uintptr)(ptr) = layout
+			return unsafe.Add(ptr, headerSize)
 		}
 	}
 }
 
 func Realloc(ptr unsafe.Pointer, size uintptr) unsafe.Pointer {
-	if ptr == nil {
+	if ptr == nil || ptr == unsafe.Pointer(&zeroSizedAlloc) {
 		return Alloc(size)
 	}
 	lock(&gcMutex)
@@ -361,7 +489,8 @@ func Realloc(ptr unsafe.Pointer, size uintptr) unsafe.Pointer {
 		return ptr
 	}
 
-	newAlloc := Alloc(size)
+	layout := *(*uintptr)(unsafe.Pointer(ptrAddress - headerSize))
+	newAlloc := alloc(size, layout)
 	c.Memcpy(newAlloc, ptr, oldSize)
 	free(ptr)
 
@@ -402,6 +531,7 @@ func gc() (freeBytes uintptr) {
 	// the next collection cycle.
 	freeBytes = sweep()
 
+	updateNextGC(heapAlloc())
 	return
 }
 
@@ -436,7 +566,23 @@ func startMark(root uintptr) {
 
 		start, end := gcAddressOf(block), gcAddressOf(gcFindNext(block))
 
+		// Read the layout from the object header.
+		var typ *abi.Type
+		switch layout := *(*uintptr)(unsafe.Pointer(start)); layout {
+		case layoutNoScan:
+			continue
+		case layoutUnknown:
+		default:
+			typ = (*abi.Type)(unsafe.Pointer(layout))
+		}
+		start += headerSize
+
 		for addr := start; addr != end; addr += unsafe.Alignof(addr) {
+			if typ != nil && !pointerAt(typ, addr-start) {
+				// The word can't hold a pointer.
+				continue
+			}
+
 			// Load the word.
 			word := *(*uintptr)(unsafe.Pointer(addr))
 
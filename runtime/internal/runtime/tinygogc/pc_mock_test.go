@@ -6,6 +6,7 @@ import (
 	"testing"
 	"unsafe"
 
+	"github.com/goplus/llgo/runtime/abi"
 	c "github.com/goplus/llgo/runtime/internal/clite"
 )
 
@@ -85,7 +86,7 @@ func (env *mockGCEnv) setupMockGC() {
 
 	// Apply initGC's logic with our mock memory layout
 	// This is the same logic as initGC() but with our mock addresses
-	heapStart = env.heapStart + 2048 // reserve 2K blocks like initGC does
+	heapStart = align(env.heapStart + 2048) // reserve 2K blocks like initGC does
 	heapEnd = env.heapEnd
 	globalsStart = env.globalsStart
 	globalsEnd = env.globalsEnd
@@ -110,6 +111,7 @@ func (env *mockGCEnv) setupMockGC() {
 	gcFrees = 0
 	gcFreedBlocks = 0
 	markStackOverflow = false
+	nextGC = 0
 }
 
 // restoreOriginalGC restores the original GC state
@@ -602,3 +604,78 @@ func TestMockGCCircularReferences(t *testing.T) {
 		t.Error("Failed to allocate after freeing circular references")
 	}
 }
+
+func TestMockGCPreciseScan(t *testing.T) {
+	env := createMockGCEnv()
+	env.setupMockGC()
+	defer env.restoreOriginalGC()
+
+	// Only data[1] of a testObject holds a pointer.
+	mask := byte(0x02)
+	typ := &abi.Type{
+		Size_:    unsafe.Sizeof(testObject{}),
+		PtrBytes: 2 * unsafe.Sizeof(uintptr(0)),
+		GCData:   &mask,
+	}
+
+	root := (*testObject)(AllocTyped(unsafe.Sizeof(testObject{}), typ))
+	kept := AllocNoScan(16)
+	dropped := AllocNoScan(16)
+	root.data[0] = uintptr(dropped) // looks like a pointer, but isn't one
+	root.data[1] = uintptr(kept)
+
+	// Pointer-free objects aren't scanned.
+	noscan := (*testObject)(AllocNoScan(unsafe.Sizeof(testObject{})))
+	hidden := Alloc(16)
+	noscan.data[0] = uintptr(hidden)
+
+	env.enableMockMode()
+	env.addRoot(unsafe.Pointer(root))
+	env.addRoot(unsafe.Pointer(noscan))
+	env.runMockGC()
+
+	tests := []struct {
+		name string
+		ptr  unsafe.Pointer
+		want uint8
+	}{
+		{"root", unsafe.Pointer(root), blockStateHead},
+		{"kept", kept, blockStateHead},
+		{"dropped", dropped, blockStateFree},
+		{"noscan", unsafe.Pointer(noscan), blockStateHead},
+		{"hidden", hidden, blockStateFree},
+	}
+	for _, tt := range tests {
+		if state := gcStateOf(blockFromAddr(uintptr(tt.ptr))); state != tt.want {
+			t.Errorf("%s has state %d, expected %d", tt.name, state, tt.want)
+		}
+	}
+}
+
+func TestMockGCAlignment(t *testing.T) {
+	env := createMockGCEnv()
+	env.setupMockGC()
+	defer env.restoreOriginalGC()
+
+	for size := uintptr(1); size <= 3*bytesPerBlock; size++ {
+		if ptr := uintptr(Alloc(size)); ptr%maxAlign != 0 {
+			t.Fatalf("Alloc(%d) = %x, not aligned to %d", size, ptr, maxAlign)
+		}
+	}
+
+	// Realloc keeps the layout of the object it grows.
+	mask := byte(0x02)
+	typ := &abi.Type{
+		Size_:    unsafe.Sizeof(testObject{}),
+		PtrBytes: 2 * unsafe.Sizeof(uintptr(0)),
+		GCData:   &mask,
+	}
+	obj := AllocTyped(unsafe.Sizeof(testObject{}), typ)
+	grown := Realloc(obj, 4*unsafe.Sizeof(testObject{}))
+	if uintptr(grown)%maxAlign != 0 {
+		t.Fatalf("Realloc = %x, not aligned to %d", uintptr(grown), maxAlign)
+	}
+	if layout := *(*uintptr)(unsafe.Add(grown, -int(headerSize))); layout != uintptr(unsafe.Pointer(typ)) {
+		t.Errorf("Realloc lost the layout: got %x, want %p", layout, typ)
+	}
+}
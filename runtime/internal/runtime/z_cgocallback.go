@@ -0,0 +1,65 @@
+//go:build !nogc && !baremetal
+
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	c "github.com/goplus/llgo/runtime/internal/clite"
+	"github.com/goplus/llgo/runtime/internal/clite/bdwgc"
+	"github.com/goplus/llgo/runtime/internal/clite/pthread"
+)
+
+// cgoKey marks the threads CgoCallback has seen: cgoAttached for threads it
+// registered with the collector, cgoNative for threads the collector already
+// knew.
+var cgoKey pthread.Key
+
+var cgoAttached, cgoNative byte
+
+func init() {
+	bdwgc.Init()
+	bdwgc.AllowRegisterThreads()
+	cgoKey.Create(cgoDetach)
+}
+
+// CgoCallback is called on entry to functions exported to C. C code may call
+// them on threads it created itself; the collector has to scan the stacks of
+// those threads before they may hold Go pointers.
+func CgoCallback() {
+	cgoThread()
+	if cgoKey.Get() != nil {
+		return
+	}
+	if bdwgc.ThreadIsRegistered() != 0 {
+		cgoKey.Set(c.Pointer(&cgoNative))
+		return
+	}
+	var sb bdwgc.StackBase
+	if bdwgc.GetStackBase(&sb) != 0 || bdwgc.RegisterMyThread(&sb) != 0 {
+		fatal("cgo callback: cannot register thread with the collector")
+		c.Exit(2)
+	}
+	cgoKey.Set(c.Pointer(&cgoAttached))
+}
+
+// cgoDetach unregisters threads attached by CgoCallback as they exit.
+func cgoDetach(mark c.Pointer) {
+	if mark == c.Pointer(&cgoAttached) {
+		bdwgc.UnregisterMyThread()
+	}
+}
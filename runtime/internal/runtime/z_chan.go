@@ -112,12 +112,15 @@ func ChanTrySend(p *Chan, v unsafe.Pointer, eltSize int) bool {
 }
 
 func ChanSend(p *Chan, v unsafe.Pointer, eltSize int) bool {
+	if p == nil {
+		BlockForever(WaitReasonChanSendNilChan)
+	}
 	n := p.cap
 	p.mutex.Lock()
 	if n == 0 {
 		for p.getp != chanHasRecv && !p.close {
 			p.sends++
-			p.cond.Wait(&p.mutex)
+			CondWait(&p.cond, &p.mutex, WaitReasonChanSend)
 			p.sends--
 		}
 		if p.close {
@@ -130,7 +133,7 @@ func ChanSend(p *Chan, v unsafe.Pointer, eltSize int) bool {
 		p.getp = chanNoSendRecv
 	} else {
 		for p.len == n {
-			p.cond.Wait(&p.mutex)
+			CondWait(&p.cond, &p.mutex, WaitReasonChanSend)
 		}
 		if p.close {
 			p.mutex.Unlock()
@@ -175,7 +178,7 @@ func ChanTryRecv(p *Chan, v unsafe.Pointer, eltSize int) (recvOK bool, tryOK boo
 	if n == 0 {
 		p.mutex.Lock()
 		for p.getp == chanHasRecv && !p.close {
-			p.cond.Wait(&p.mutex)
+			CondWait(&p.cond, &p.mutex, WaitReasonChanReceive)
 		}
 		recvOK = !p.close
 		tryOK = recvOK
@@ -187,11 +190,14 @@ func ChanTryRecv(p *Chan, v unsafe.Pointer, eltSize int) (recvOK bool, tryOK boo
 }
 
 func ChanRecv(p *Chan, v unsafe.Pointer, eltSize int) (recvOK bool) {
+	if p == nil {
+		BlockForever(WaitReasonChanReceiveNilChan)
+	}
 	n := p.cap
 	p.mutex.Lock()
 	if n == 0 {
 		for p.getp == chanHasRecv && !p.close {
-			p.cond.Wait(&p.mutex)
+			CondWait(&p.cond, &p.mutex, WaitReasonChanReceive)
 		}
 		if p.close {
 			p.mutex.Unlock()
@@ -205,7 +211,7 @@ func ChanRecv(p *Chan, v unsafe.Pointer, eltSize int) (recvOK bool) {
 				p.mutex.Unlock()
 				return false
 			}
-			p.cond.Wait(&p.mutex)
+			CondWait(&p.cond, &p.mutex, WaitReasonChanReceive)
 		}
 		if v != nil {
 			c.Memcpy(v, c.Advance(p.data, p.getp*eltSize), uintptr(eltSize))
@@ -219,7 +225,7 @@ func ChanRecv(p *Chan, v unsafe.Pointer, eltSize int) (recvOK bool) {
 	if n == 0 {
 		p.mutex.Lock()
 		for p.getp == chanHasRecv && !p.close {
-			p.cond.Wait(&p.mutex)
+			CondWait(&p.cond, &p.mutex, WaitReasonChanReceive)
 		}
 		recvOK = !p.close
 		p.mutex.Unlock()
@@ -255,10 +261,10 @@ func (p *selectOp) notify() {
 	p.cond.Signal()
 }
 
-func (p *selectOp) wait() {
+func (p *selectOp) wait(reason WaitReason) {
 	p.mutex.Lock()
 	if !p.sem {
-		p.cond.Wait(&p.mutex)
+		CondWait(&p.cond, &p.mutex, reason)
 	}
 	p.sem = false
 	p.mutex.Unlock()
@@ -299,18 +305,20 @@ func TrySelect(ops ...ChanOp) (isel int, recvOK, tryOK bool) {
 func Select(ops ...ChanOp) (isel int, recvOK bool) {
 	selOp := new(selectOp) // TODO(xsw): use c.AllocaNew[selectOp]()
 	selOp.init()
+	reason := WaitReasonSelectNoCases
 	for _, op := range ops {
 		if op.C == nil {
 			continue
 		}
 		prepareSelect(op.C, selOp, op.Send)
+		reason = WaitReasonSelect
 	}
 	var tryOK bool
 	for {
 		if isel, recvOK, tryOK = TrySelect(ops...); tryOK {
 			break
 		}
-		selOp.wait()
+		selOp.wait(reason)
 	}
 	for _, op := range ops {
 		if op.C == nil {
@@ -0,0 +1,89 @@
+//go:build (linux || darwin) && !baremetal
+
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package runtime
+
+import (
+	c "github.com/goplus/llgo/runtime/internal/clite"
+	"github.com/goplus/llgo/runtime/internal/clite/pthread"
+	"github.com/goplus/llgo/runtime/internal/clite/pthread/sync"
+)
+
+// deadlockDelay is how long goroutines must stay all asleep to be deadlocked,
+// in nanoseconds. A goroutine woken up is counted as asleep until its thread
+// runs again, which the delay leaves time for.
+const deadlockDelay = 100 * 1000 * 1000
+
+var deadlock struct {
+	cond    sync.Cond
+	started bool
+}
+
+func init() {
+	deadlock.cond.Init(nil)
+}
+
+// allAsleep reports whether the goroutines are all asleep with no timer
+// pending, nor a thread of C code that could call Go code to wake them up.
+// It's called with sched.mu held.
+func allAsleep() bool {
+	return sched.gcount > 0 && sched.asleep == sched.gcount && sched.timers == 0 && sched.cgo == 0
+}
+
+// checkDead wakes up the deadlock detector if the goroutines are all asleep.
+// It's called with sched.mu held. The host of a c-archive or c-shared library
+// may call into it at any time, so libraries never deadlock.
+func checkDead() {
+	if !allAsleep() || isLibrary() {
+		return
+	}
+	if !deadlock.started {
+		deadlock.started = true
+		var th pthread.Thread
+		if pthread.Create(&th, nil, deadlockDetector, nil) != 0 {
+			return
+		}
+	}
+	deadlock.cond.Signal()
+}
+
+// deadlockDetector runs on a thread of its own, and dies with a fatal error
+// when the goroutines stay all asleep for deadlockDelay.
+func deadlockDetector(c.Pointer) c.Pointer {
+	sched.mu.Lock()
+	for {
+		for !allAsleep() {
+			deadlock.cond.Wait(&sched.mu)
+		}
+		naps := sched.naps
+		for allAsleep() && sched.naps == naps {
+			if timedWait(&deadlock.cond, &sched.mu, deadlockDelay) && allAsleep() && sched.naps == naps {
+				printDeadlock()
+				c.Exit(2)
+			}
+		}
+	}
+}
+
+func printDeadlock() {
+	fatal("all goroutines are asleep - deadlock!")
+	for mp := sched.allm; mp != nil; mp = mp.next {
+		if mp.wait != WaitReasonZero {
+			print("\ngoroutine ", mp.goid, " [", mp.wait.String(), "]:\n")
+		}
+	}
+}
@@ -31,6 +31,23 @@ func (e errorString) Error() string {
 	return "runtime error: " + string(e)
 }
 
+// An errorAddressString is a runtime error raised by a fault at an address.
+type errorAddressString struct {
+	msg  string
+	addr uintptr
+}
+
+func (e errorAddressString) RuntimeError() {}
+
+func (e errorAddressString) Error() string {
+	return "runtime error: " + e.msg
+}
+
+// Addr returns the address of the fault.
+func (e errorAddressString) Addr() uintptr {
+	return e.addr
+}
+
 type plainError string
 
 func (e plainError) Error() string {
@@ -55,9 +72,12 @@ func AssertIndexRange(b bool) {
 	}
 }
 
+// AssertDivideByZero panics if b, which the compiler checks before every
+// integer division and modulo whose divisor may be zero: LLVM leaves the
+// result of dividing by zero undefined, and not all arches trap on it.
 func AssertDivideByZero(b bool) {
 	if b {
-		panic(errorString("integer divide by zero").Error())
+		panic(divideError)
 	}
 }
 
@@ -28,15 +28,132 @@ import (
 
 // AllocU allocates uninitialized memory.
 func AllocU(size uintptr) unsafe.Pointer {
+	checkMemoryLimit(size)
 	return bdwgc.Malloc(size)
 }
 
 // AllocZ allocates zero-initialized memory.
 func AllocZ(size uintptr) unsafe.Pointer {
+	checkMemoryLimit(size)
 	ret := bdwgc.Malloc(size)
 	return c.Memset(ret, 0, size)
 }
 
+// allocZTyped allocates zero-initialized memory for objects of type t. bdwgc
+// scans the memory conservatively.
+func allocZTyped(size uintptr, t *Type) unsafe.Pointer {
+	return AllocZ(size)
+}
+
+// AllocUNoScan allocates uninitialized memory for objects without pointers.
+// The GC doesn't scan the memory.
+func AllocUNoScan(size uintptr) unsafe.Pointer {
+	checkMemoryLimit(size)
+	return bdwgc.MallocAtomic(size)
+}
+
+// AllocZNoScan allocates zero-initialized memory for objects without pointers.
+// The GC doesn't scan the memory.
+func AllocZNoScan(size uintptr) unsafe.Pointer {
+	checkMemoryLimit(size)
+	ret := bdwgc.MallocAtomic(size)
+	return c.Memset(ret, 0, size)
+}
+
+// AllocUncollectable allocates uninitialized memory that the GC scans but
+// never collects. It must be released by FreeUncollectable.
+func AllocUncollectable(size uintptr) unsafe.Pointer {
+	return bdwgc.MallocUncollectable(size)
+}
+
+// FreeUncollectable frees memory allocated by AllocUncollectable.
+func FreeUncollectable(ptr unsafe.Pointer) {
+	bdwgc.Free(ptr)
+}
+
+// FreeOSMemory collects garbage and returns unused memory to the OS.
+func FreeOSMemory() {
+	bdwgc.GcollectAndUnmap()
+}
+
+// bdwgc allocates heapsize/divisor bytes between collections, 3 by default,
+// which corresponds to GOGC=100.
+const (
+	defaultFreeSpaceDivisor = 3
+	maxFreeSpaceDivisor     = 1 << 10
+)
+
+var (
+	gcOff     bool              // collection is disabled by setGCPolicy
+	softLimit uintptr = noLimit // memory limit in bytes, noLimit if none
+)
+
+const (
+	noLimit = ^uintptr(0)
+
+	// minLimitGCBytes is the least allocated between two collections that
+	// the memory limit forces, so that a live heap above the limit doesn't
+	// make every allocation collect.
+	minLimitGCBytes = 64 << 10
+)
+
+// setGCPolicy maps the GC percentage onto the free space divisor of bdwgc.
+// As in Go, the memory limit is a soft one: allocations collect garbage more
+// often as the heap approaches it, but never fail because of it.
+func setGCPolicy(percent int32, limit int64) {
+	off := percent < 0 && limit == maxMemoryLimit
+	if off != gcOff {
+		if off {
+			bdwgc.Disable()
+		} else {
+			bdwgc.Enable()
+		}
+		gcOff = off
+	}
+
+	divisor := uintptr(1) // collect as rarely as possible if only limited
+	switch {
+	case percent == 0:
+		divisor = maxFreeSpaceDivisor
+	case percent > 0:
+		p := uintptr(percent)
+		divisor = (defaultFreeSpaceDivisor*100 + p/2) / p
+		if divisor < 1 {
+			divisor = 1
+		} else if divisor > maxFreeSpaceDivisor {
+			divisor = maxFreeSpaceDivisor
+		}
+	}
+	bdwgc.SetFreeSpaceDivisor(divisor)
+
+	softLimit = noLimit
+	if limit != maxMemoryLimit && uint64(limit) < uint64(noLimit) {
+		softLimit = uintptr(limit)
+	}
+}
+
+// checkMemoryLimit collects garbage if allocating size more bytes would take
+// the memory in use past the memory limit. While the live heap itself is
+// above the limit, it collects at most once per 1/16 of the memory in use
+// (and minLimitGCBytes) allocated, which bounds the time spent collecting.
+func checkMemoryLimit(size uintptr) {
+	limit := softLimit
+	if limit == noLimit {
+		return
+	}
+	inUse := bdwgc.GetMemoryUse()
+	if inUse+size <= limit {
+		return
+	}
+	step := inUse / 16
+	if step < minLimitGCBytes {
+		step = minLimitGCBytes
+	}
+	if bdwgc.GetBytesSinceGC()+size >= step {
+		bdwgc.Gcollect()
+	}
+}
+
 type entry struct {
 	fn   func()         // cleanup func
 	prev unsafe.Pointer // prev cleanup func ptr
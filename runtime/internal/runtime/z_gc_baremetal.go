@@ -21,6 +21,7 @@ package runtime
 import (
 	"unsafe"
 
+	c "github.com/goplus/llgo/runtime/internal/clite"
 	"github.com/goplus/llgo/runtime/internal/runtime/tinygogc"
 )
 
@@ -34,6 +35,51 @@ func AllocZ(size uintptr) unsafe.Pointer {
 	return tinygogc.Alloc(size)
 }
 
+// AllocUNoScan allocates uninitialized memory for objects without pointers.
+// The GC doesn't scan the memory.
+func AllocUNoScan(size uintptr) unsafe.Pointer {
+	return tinygogc.AllocNoScan(size)
+}
+
+// AllocZNoScan allocates zero-initialized memory for objects without pointers.
+// The GC doesn't scan the memory.
+func AllocZNoScan(size uintptr) unsafe.Pointer {
+	return tinygogc.AllocNoScan(size)
+}
+
+// allocZTyped allocates zero-initialized memory for objects of type t, which
+// tinygogc scans precisely using t.GCData.
+func allocZTyped(size uintptr, t *Type) unsafe.Pointer {
+	return tinygogc.AllocTyped(size, t)
+}
+
+// AllocUncollectable allocates uninitialized memory outside of the GC heap.
+// It must be released by FreeUncollectable.
+func AllocUncollectable(size uintptr) unsafe.Pointer {
+	return c.Malloc(size)
+}
+
+// FreeUncollectable frees memory allocated by AllocUncollectable.
+func FreeUncollectable(ptr unsafe.Pointer) {
+	c.Free(ptr)
+}
+
+// FreeOSMemory collects garbage. The heap of baremetal targets is never
+// returned to the system.
+func FreeOSMemory() {
+	tinygogc.GC()
+}
+
+// setGCPolicy sets the heap sizes that trigger tinygogc collections.
+func setGCPolicy(percent int32, limit int64) {
+	tinygogc.SetGCPercent(percent)
+	maxHeap := ^uintptr(0) // no limit
+	if limit != maxMemoryLimit && uint64(limit) < uint64(maxHeap) {
+		maxHeap = uintptr(limit)
+	}
+	tinygogc.SetMemoryLimit(maxHeap)
+}
+
 // AddCleanupPtr is not implemented in baremetal builds because tinygogc
 // does not support finalizers. Cleanup functions will never be called.
 //
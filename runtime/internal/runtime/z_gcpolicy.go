@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	_ "unsafe"
+
+	c "github.com/goplus/llgo/runtime/internal/clite"
+)
+
+const maxMemoryLimit = 1<<63 - 1 // no memory limit, as in Go
+
+var (
+	gcPercent   int32 = 100            // GOGC, negative if collection is off
+	memoryLimit int64 = maxMemoryLimit // GOMEMLIMIT in bytes
+)
+
+//go:linkname getenv C.getenv
+func getenv(name *c.Char) *c.Char
+
+func init() {
+	if v := getenv(c.Str("GOGC")); v != nil {
+		gcPercent = parseGOGC(c.GoString(v))
+	}
+	if v := getenv(c.Str("GOMEMLIMIT")); v != nil {
+		s := c.GoString(v)
+		limit, ok := parseByteCount(s)
+		if !ok {
+			print("GOMEMLIMIT=", s, "\n")
+			panic(errorString("malformed GOMEMLIMIT; see `go doc runtime/debug.SetMemoryLimit`"))
+		}
+		memoryLimit = limit
+	}
+	setGCPolicy(gcPercent, memoryLimit)
+}
+
+// SetGCPercent sets the garbage collection target percentage and returns the
+// previous setting. A negative percentage disables garbage collection.
+func SetGCPercent(percent int32) int32 {
+	if percent < 0 {
+		percent = -1
+	}
+	old := gcPercent
+	gcPercent = percent
+	setGCPolicy(gcPercent, memoryLimit)
+	return old
+}
+
+// SetMemoryLimit sets the memory limit of the heap and returns the previous
+// limit. A negative limit doesn't change the limit.
+func SetMemoryLimit(limit int64) int64 {
+	old := memoryLimit
+	if limit >= 0 {
+		memoryLimit = limit
+		setGCPolicy(gcPercent, memoryLimit)
+	}
+	return old
+}
+
+// parseGOGC parses the value of GOGC. Invalid values mean the default.
+func parseGOGC(s string) int32 {
+	if s == "off" {
+		return -1
+	}
+	if n, ok := atoi32(s); ok {
+		return n
+	}
+	return 100
+}
+
+func atoi32(s string) (int32, bool) {
+	neg := false
+	if s != "" && s[0] == '-' {
+		neg, s = true, s[1:]
+	}
+	if s == "" {
+		return 0, false
+	}
+	var n int64
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return 0, false
+		}
+		n = n*10 + int64(s[i]-'0')
+		if n > 1<<31-1 {
+			return 0, false
+		}
+	}
+	if neg {
+		n = -n
+	}
+	return int32(n), true
+}
+
+// parseByteCount parses a byte count with an optional unit suffix (B, KiB,
+// MiB, GiB or TiB), as GOMEMLIMIT accepts. "off" means no limit.
+func parseByteCount(s string) (int64, bool) {
+	if s == "off" {
+		return maxMemoryLimit, true
+	}
+	if s == "" {
+		return 0, false
+	}
+	unit := int64(1)
+	if s[len(s)-1] == 'B' {
+		s = s[:len(s)-1]
+		if n := len(s); n >= 2 && s[n-1] == 'i' {
+			switch s[n-2] {
+			case 'K':
+				unit = 1 << 10
+			case 'M':
+				unit = 1 << 20
+			case 'G':
+				unit = 1 << 30
+			case 'T':
+				unit = 1 << 40
+			default:
+				return 0, false
+			}
+			s = s[:n-2]
+		}
+	}
+	if s == "" {
+		return 0, false
+	}
+	var n int64
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return 0, false
+		}
+		d := int64(s[i] - '0')
+		if n > (maxMemoryLimit-d)/10 {
+			return 0, false
+		}
+		n = n*10 + d
+	}
+	if n > maxMemoryLimit/unit {
+		return 0, false
+	}
+	return n * unit, true
+}
@@ -36,6 +36,39 @@ func AllocZ(size uintptr) unsafe.Pointer {
 	return c.Memset(ret, 0, size)
 }
 
+// allocZTyped allocates zero-initialized memory for objects of type t.
+func allocZTyped(size uintptr, t *Type) unsafe.Pointer {
+	return AllocZ(size)
+}
+
+// AllocUNoScan allocates uninitialized memory for objects without pointers.
+func AllocUNoScan(size uintptr) unsafe.Pointer {
+	return c.Malloc(size)
+}
+
+// AllocZNoScan allocates zero-initialized memory for objects without pointers.
+func AllocZNoScan(size uintptr) unsafe.Pointer {
+	ret := c.Malloc(size)
+	return c.Memset(ret, 0, size)
+}
+
+// AllocUncollectable allocates uninitialized memory. It must be released by
+// FreeUncollectable.
+func AllocUncollectable(size uintptr) unsafe.Pointer {
+	return c.Malloc(size)
+}
+
+// FreeUncollectable frees memory allocated by AllocUncollectable.
+func FreeUncollectable(ptr unsafe.Pointer) {
+	c.Free(ptr)
+}
+
+// FreeOSMemory does nothing when GC is disabled.
+func FreeOSMemory() {}
+
+// setGCPolicy does nothing when GC is disabled.
+func setGCPolicy(percent int32, limit int64) {}
+
 // AddCleanupPtr is not implemented when GC is disabled.
 // Cleanup functions will never be called.
 func AddCleanupPtr(ptr unsafe.Pointer, cleanup func()) (cancel func()) {
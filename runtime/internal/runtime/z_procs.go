@@ -0,0 +1,402 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+
+	c "github.com/goplus/llgo/runtime/internal/clite"
+	"github.com/goplus/llgo/runtime/internal/clite/pthread"
+	"github.com/goplus/llgo/runtime/internal/clite/pthread/sync"
+	"github.com/goplus/llgo/runtime/internal/clite/time"
+)
+
+// Each goroutine runs on a thread of its own. A goroutine thread runs Go code
+// only while it holds one of the GOMAXPROCS procs, and gives it up while it is
+// blocked: in channel operations, semaphores of package sync, system calls,
+// calls to C and the like. Threads the runtime didn't start as goroutines, and
+// the system goroutines of the runtime, hold no proc.
+//
+// Procs are unbounded until GOMAXPROCS is set, by the environment variable or
+// runtime.GOMAXPROCS, as threads aren't preempted: a goroutine that loops
+// without blocking or calling Gosched keeps its proc, and threads waiting for
+// one wait until it gives it up. With unbounded procs, a goroutine spinning
+// until another one makes progress doesn't hang.
+//
+// Goroutines waiting in CondWait for other goroutines are asleep. When all of
+// them are, with no timer pending that could wake them up, the program is
+// deadlocked: see checkDead.
+
+// m is the scheduling state of a goroutine thread.
+type m struct {
+	held    bool       // holds a proc
+	reheld  bool       // gets a proc again on the outermost ExitBlocking
+	wait    WaitReason // why the goroutine is asleep, if it is
+	blocked int32      // nesting of EnterBlocking
+	locked  int32      // nesting of LockOSThread
+	goid    uint64
+	prev    *m // in sched.allm
+	next    *m
+}
+
+var sched struct {
+	mu      sync.Mutex
+	cond    sync.Cond
+	max     int32 // GOMAXPROCS, 0 if unbounded
+	running int32 // procs held, including the ones handed off
+	waiting int32 // threads waiting for a proc
+	handoff int32 // procs handed off to waiting threads
+
+	allm   *m     // goroutine threads, oldest first
+	lastm  *m     // the last of allm
+	gcount int32  // goroutines, including the ones being started
+	cgo    int32  // threads of C code that called Go code, see cgoThread
+	asleep int32  // goroutines asleep in CondWait
+	timers int32  // pending timers
+	naps   uint64 // times gcount, asleep or timers changed
+	goid   uint64 // the last goroutine ID
+}
+
+var mKey, cgoThreadKey pthread.Key
+
+func init() {
+	sched.mu.Init(nil)
+	sched.cond.Init(nil)
+	mKey.Create(freeM)
+	cgoThreadKey.Create(freeCgoThread)
+	addGoroutines(1)
+	startM() // the main goroutine
+}
+
+// addGoroutines adds n to the number of goroutines, which counts goroutines
+// from before their threads start.
+func addGoroutines(n int32) {
+	sched.mu.Lock()
+	sched.gcount += n
+	sched.naps++
+	if n < 0 {
+		checkDead()
+	}
+	sched.mu.Unlock()
+}
+
+// startM makes the current thread a goroutine thread holding a proc.
+func startM() {
+	mp := (*m)(c.Calloc(1, unsafe.Sizeof(m{})))
+	mKey.Set(c.Pointer(mp))
+	sched.mu.Lock()
+	sched.goid++
+	mp.goid = sched.goid
+	mp.prev = sched.lastm
+	if mp.prev != nil {
+		mp.prev.next = mp
+	} else {
+		sched.allm = mp
+	}
+	sched.lastm = mp
+	sched.mu.Unlock()
+	acquireProc(mp)
+}
+
+// freeM releases the proc of a goroutine thread that is exiting.
+func freeM(ptr c.Pointer) {
+	mp := (*m)(ptr)
+	if mp.held {
+		releaseProc(mp)
+	}
+	sched.mu.Lock()
+	if mp.prev != nil {
+		mp.prev.next = mp.next
+	} else {
+		sched.allm = mp.next
+	}
+	if mp.next != nil {
+		mp.next.prev = mp.prev
+	} else {
+		sched.lastm = mp.prev
+	}
+	sched.gcount--
+	sched.naps++
+	checkDead()
+	sched.mu.Unlock()
+	c.Free(ptr)
+}
+
+func getm() *m {
+	return (*m)(mKey.Get())
+}
+
+// cgoThread counts the current thread, if it's a thread of C code calling Go
+// code for the first time, among the threads that may wake up goroutines
+// until they exit. Goroutines waiting for them aren't deadlocked.
+func cgoThread() {
+	if cgoThreadKey.Get() != nil || getm() != nil {
+		return
+	}
+	cgoThreadKey.Set(c.Pointer(&sched))
+	sched.mu.Lock()
+	sched.cgo++
+	sched.naps++
+	sched.mu.Unlock()
+}
+
+// freeCgoThread uncounts a thread of C code that is exiting.
+func freeCgoThread(c.Pointer) {
+	sched.mu.Lock()
+	sched.cgo--
+	sched.naps++
+	checkDead()
+	sched.mu.Unlock()
+}
+
+func acquireProc(mp *m) {
+	sched.mu.Lock()
+	if sched.max == 0 || (sched.running < sched.max && sched.waiting == 0) {
+		sched.running++
+	} else {
+		sched.waiting++
+		for sched.handoff == 0 {
+			sched.cond.Wait(&sched.mu)
+		}
+		sched.handoff--
+		sched.waiting--
+	}
+	sched.mu.Unlock()
+	mp.held = true
+}
+
+func releaseProc(mp *m) {
+	mp.held = false
+	sched.mu.Lock()
+	if sched.waiting > sched.handoff && (sched.max == 0 || sched.running <= sched.max) {
+		sched.handoff++
+		sched.cond.Broadcast()
+	} else {
+		sched.running--
+	}
+	sched.mu.Unlock()
+}
+
+// timedWait waits on cond for at most ns nanoseconds, and reports whether it
+// timed out.
+func timedWait(cond *sync.Cond, mutex *sync.Mutex, ns int64) bool {
+	var ts time.Timespec
+	time.ClockGettime(time.CLOCK_REALTIME, &ts)
+	nsec := int64(ts.Nsec) + ns
+	ts.Sec += time.TimeT(nsec / 1e9)
+	ts.Nsec = c.Long(nsec % 1e9)
+	return cond.TimedWait(mutex, &ts) != 0
+}
+
+// SetMaxProcs sets GOMAXPROCS to n if it's positive, and returns the
+// previous setting.
+func SetMaxProcs(n int) int {
+	sched.mu.Lock()
+	old := int(sched.max)
+	if n > 0 {
+		sched.max = int32(n)
+		for sched.running < sched.max && sched.waiting > sched.handoff {
+			sched.running++
+			sched.handoff++
+		}
+		sched.cond.Broadcast()
+	}
+	sched.mu.Unlock()
+	return old
+}
+
+// EnterBlocking gives up the proc of the current goroutine before it blocks,
+// or calls C code that may, which ExitBlocking gets back. Calls may nest.
+func EnterBlocking() {
+	mp := getm()
+	if mp == nil {
+		return
+	}
+	mp.blocked++
+	if mp.blocked == 1 && mp.held {
+		mp.reheld = true
+		releaseProc(mp)
+	}
+}
+
+// ExitBlocking gets back the proc EnterBlocking gave up.
+func ExitBlocking() {
+	mp := getm()
+	if mp == nil || mp.blocked == 0 {
+		return
+	}
+	mp.blocked--
+	if mp.blocked == 0 && mp.reheld {
+		mp.reheld = false
+		acquireProc(mp)
+	}
+}
+
+// WaitReason tells what a goroutine waits for in CondWait.
+type WaitReason uint8
+
+const (
+	WaitReasonZero WaitReason = iota // not waiting
+	WaitReasonIOWait
+	WaitReasonSignalRecv
+
+	// Goroutines waiting for the following are asleep: only other goroutines
+	// wake them up.
+	WaitReasonChanReceive
+	WaitReasonChanSend
+	WaitReasonChanReceiveNilChan
+	WaitReasonChanSendNilChan
+	WaitReasonSelect
+	WaitReasonSelectNoCases
+	WaitReasonSemacquire
+	WaitReasonSyncMutexLock
+	WaitReasonSyncRWMutexRLock
+	WaitReasonSyncRWMutexLock
+	WaitReasonSyncWaitGroupWait
+	WaitReasonSyncCondWait
+)
+
+var waitReasonStrings = [...]string{
+	WaitReasonZero:               "",
+	WaitReasonIOWait:             "IO wait",
+	WaitReasonSignalRecv:         "signal receive",
+	WaitReasonChanReceive:        "chan receive",
+	WaitReasonChanSend:           "chan send",
+	WaitReasonChanReceiveNilChan: "chan receive (nil chan)",
+	WaitReasonChanSendNilChan:    "chan send (nil chan)",
+	WaitReasonSelect:             "select",
+	WaitReasonSelectNoCases:      "select (no cases)",
+	WaitReasonSemacquire:         "semacquire",
+	WaitReasonSyncMutexLock:      "sync.Mutex.Lock",
+	WaitReasonSyncRWMutexRLock:   "sync.RWMutex.RLock",
+	WaitReasonSyncRWMutexLock:    "sync.RWMutex.Lock",
+	WaitReasonSyncWaitGroupWait:  "sync.WaitGroup.Wait",
+	WaitReasonSyncCondWait:       "sync.Cond.Wait",
+}
+
+func (w WaitReason) String() string {
+	if int(w) < len(waitReasonStrings) {
+		return waitReasonStrings[w]
+	}
+	return "unknown wait reason"
+}
+
+func (w WaitReason) asleep() bool {
+	return w >= WaitReasonChanReceive
+}
+
+// CondWait waits on cond like cond.Wait(mutex), without holding a proc. Like
+// cond.Wait, it may return spuriously.
+func CondWait(cond *sync.Cond, mutex *sync.Mutex, reason WaitReason) {
+	mp := getm()
+	if mp == nil || !mp.held {
+		cond.Wait(mutex)
+		return
+	}
+	releaseProc(mp)
+	if reason.asleep() {
+		setWait(mp, reason)
+	}
+	cond.Wait(mutex)
+	// getting the proc back may block: don't hold mutex meanwhile
+	mutex.Unlock()
+	if reason.asleep() {
+		setWait(mp, WaitReasonZero)
+	}
+	acquireProc(mp)
+	mutex.Lock()
+}
+
+func setWait(mp *m, reason WaitReason) {
+	sched.mu.Lock()
+	mp.wait = reason
+	if reason != WaitReasonZero {
+		sched.asleep++
+		checkDead()
+	} else {
+		sched.asleep--
+	}
+	sched.naps++
+	sched.mu.Unlock()
+}
+
+// BlockForever blocks the current goroutine for good, asleep for reason.
+func BlockForever(reason WaitReason) {
+	var mutex sync.Mutex
+	var cond sync.Cond
+	mutex.Init(nil)
+	cond.Init(nil)
+	mutex.Lock()
+	for {
+		CondWait(&cond, &mutex, reason)
+	}
+}
+
+// AddTimers adds n to the number of pending timers. Goroutines that are all
+// asleep aren't deadlocked while a timer is pending, as it may wake them up.
+func AddTimers(n int) {
+	sched.mu.Lock()
+	sched.timers += int32(n)
+	sched.naps++
+	if n < 0 {
+		checkDead()
+	}
+	sched.mu.Unlock()
+}
+
+// Gosched yields the proc of the current goroutine to the threads waiting for
+// one, if any.
+func Gosched() {
+	mp := getm()
+	if mp == nil || !mp.held {
+		return
+	}
+	releaseProc(mp)
+	acquireProc(mp)
+}
+
+// SystemGoroutine makes the current goroutine a system one, which runs
+// without a proc.
+func SystemGoroutine() {
+	if mp := getm(); mp != nil {
+		mKey.Set(nil)
+		freeM(c.Pointer(mp))
+	}
+}
+
+// LockOSThread wires the current goroutine to its thread. Goroutines never
+// leave their threads, so it only counts the calls for UnlockOSThread and
+// LockedOSThread.
+func LockOSThread() {
+	if mp := getm(); mp != nil {
+		mp.locked++
+	}
+}
+
+// UnlockOSThread undoes a call to LockOSThread, if any.
+func UnlockOSThread() {
+	if mp := getm(); mp != nil && mp.locked > 0 {
+		mp.locked--
+	}
+}
+
+// LockedOSThread reports whether the current goroutine is wired to its
+// thread.
+func LockedOSThread() bool {
+	mp := getm()
+	return mp != nil && mp.locked > 0
+}
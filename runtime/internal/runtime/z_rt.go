@@ -93,12 +93,22 @@ func stringTracef(fp c.FilePtr, format *c.Char, s String) {
 
 // New allocates memory and initializes it to zero.
 func New(t *Type) unsafe.Pointer {
-	return AllocZ(t.Size_)
+	return AllocZTyped(t.Size_, t)
 }
 
 // NewArray allocates memory for an array and initializes it to zero.
 func NewArray(t *Type, n int) unsafe.Pointer {
-	return AllocZ(uintptr(n) * t.Size_)
+	return AllocZTyped(uintptr(n)*t.Size_, t)
+}
+
+// AllocZTyped allocates zero-initialized memory for size bytes of objects of
+// type t. Memory of pointer-free types isn't scanned by the GC, and a precise
+// GC scans other memory using the pointer bitmap of t.
+func AllocZTyped(size uintptr, t *Type) unsafe.Pointer {
+	if t.PtrBytes == 0 {
+		return AllocZNoScan(size)
+	}
+	return allocZTyped(size, t)
 }
 
 // -----------------------------------------------------------------------------
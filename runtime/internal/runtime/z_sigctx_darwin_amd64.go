@@ -0,0 +1,39 @@
+//go:build !baremetal
+
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+
+	c "github.com/goplus/llgo/runtime/internal/clite"
+)
+
+// indexes of registers in uc_mcontext->__ss
+const (
+	_REG_RDX = 3
+	_REG_RDI = 4
+	_REG_RSI = 5
+	_REG_RSP = 7
+	_REG_RIP = 16
+)
+
+func newSigctxt(ctx c.Pointer) sigctxt {
+	mctx := *(*unsafe.Pointer)(unsafe.Add(ctx, 48)) // uc_mcontext
+	return sigctxt{unsafe.Add(mctx, 16)}            // __ss
+}
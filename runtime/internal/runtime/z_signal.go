@@ -1,4 +1,4 @@
-//go:build !wasm && !baremetal
+//go:build (linux || darwin) && !baremetal
 
 /*
  * Copyright (c) 2024 The XGo Authors (xgo.dev). All rights reserved.
@@ -19,8 +19,9 @@
 package runtime
 
 import (
+	"unsafe"
+
 	c "github.com/goplus/llgo/runtime/internal/clite"
-	"github.com/goplus/llgo/runtime/internal/clite/signal"
 )
 
 const (
@@ -29,20 +30,244 @@ const (
 	// introduce dependencies on errors and internal/reflectlite packages that cause
 	// linking issues in c-shared and c-archive build modes.
 	SIGSEGV = c.Int(0xb)
+
+	_SIG_DFL = 0
+	_SIG_IGN = 1
+
+	_F_SETFL = 4
 )
 
+//llgo:type C
+type sigHandler func(sig c.Int, info *siginfo, ctx c.Pointer)
+
+//llgo:type C
+type sigHandler1 func(sig c.Int)
+
+//llgo:type C
+type sigpanicFunc func(sig, code c.Int, addr uintptr)
+
+//go:linkname sigaction C.sigaction
+func sigaction(sig c.Int, act, old *sigactiont) c.Int
+
+//go:linkname pipe C.pipe
+func pipe(fds *[2]c.Int) c.Int
+
+//go:linkname fcntl C.fcntl
+func fcntl(fd c.Int, cmd c.Int, __llgo_va_list ...any) c.Int
+
+//go:linkname read C.read
+func read(fd c.Int, buf c.Pointer, count uintptr) int
+
+//go:linkname write C.write
+func write(fd c.Int, buf c.Pointer, count uintptr) int
+
 // This file contains platform-specific runtime initialization for non-wasm targets.
-// The SIGSEGV signal handler enables Go-style panic recovery for nil pointer dereferences
-// instead of immediate process termination.
+//
+// Synchronous signals raised by faults (SIGSEGV, SIGBUS and SIGFPE) are turned
+// into Go-style run-time panics instead of terminating the process: the handler
+// makes the faulting thread call sigpanic, which panics outside of the signal
+// context. Running out of a goroutine stack is a fatal error reported from the
+// handler, which runs on the alternate signal stack of the thread for that.
+//
+// Other signals are only caught once os/signal asks for them. Their handler
+// writes them to a pipe, which a dedicated thread reads them from to hand them
+// over to os/signal.
+//
+// In c-archive and c-shared builds, the host owns signals: faults the runtime
+// can't recover from are forwarded to the handler the host installed before.
 //
 // For wasm platform compatibility, signal handling is excluded via build tags.
 // See PR #1059 for wasm platform requirements.
 func init() {
-	signal.Signal(SIGSEGV, func(v c.Int) {
-		if v == SIGSEGV {
-			panic(errorString("invalid memory address or nil pointer dereference"))
+	for _, sig := range [...]c.Int{SIGSEGV, _SIGBUS, _SIGFPE} {
+		var act sigactiont
+		act.handler = sigHandlerPC(sigfault)
+		act.flags = _SA_SIGINFO | _SA_ONSTACK | _SA_NODEFER
+		sigaction(sig, &act, &sigFwd[sig])
+	}
+}
+
+var (
+	// sigFwd holds the actions of the synchronous signals from before the
+	// runtime installed its handler.
+	sigFwd [_NSIG]sigactiont
+
+	// sigOrig holds the actions of signals from before os/signal asked for
+	// them, sigSaved tells whether they are set.
+	sigOrig  [_NSIG]sigactiont
+	sigSaved [_NSIG]bool
+
+	// sigNotify tells whether os/signal wants the signal.
+	sigNotify [_NSIG]bool
+
+	sigPipe [2]c.Int
+)
+
+func sigHandlerPC(fn sigHandler) uintptr {
+	return *(*uintptr)(unsafe.Pointer(&fn))
+}
+
+func sigpanicPC() uintptr {
+	fn := sigpanicFunc(sigpanic)
+	return *(*uintptr)(unsafe.Pointer(&fn))
+}
+
+// isLibrary reports whether the program is a c-archive or c-shared library,
+// whose host doesn't start it by the main function of llgo.
+func isLibrary() bool {
+	return c.Argc == 0
+}
+
+func isSyncSignal(sig c.Int) bool {
+	return sig == SIGSEGV || sig == _SIGBUS || sig == _SIGFPE
+}
+
+// sigfault handles the synchronous signals.
+func sigfault(sig c.Int, info *siginfo, ctx c.Pointer) {
+	if info.fromUser() && sigNotify[sig] {
+		sigsend(sig)
+		return
+	}
+	if sig != _SIGFPE {
+		if limit, ok := stackOverflow(info.addr); ok {
+			print("runtime: goroutine stack exceeds ", limit, "-byte limit\n")
+			fatal("stack overflow")
+			c.Exit(2)
 		}
-		var buf [20]byte
-		panic(errorString("unexpected signal value: " + string(itoa(buf[:], uint64(v)))))
-	})
+	}
+	// without a defer frame on the thread, the panic can't be recovered
+	if isLibrary() && c.GoDeferData() == nil && sigforward(sig, info, ctx) {
+		return
+	}
+	if !injectSigpanic(ctx, sig, info.code, info.addr) {
+		sigpanic(sig, info.code, info.addr)
+	}
+}
+
+// sigforward calls the handler the host installed for sig, if any.
+func sigforward(sig c.Int, info *siginfo, ctx c.Pointer) bool {
+	fwd := &sigFwd[sig]
+	switch fwd.handler {
+	case _SIG_DFL, _SIG_IGN:
+		return false
+	}
+	if fwd.flags&_SA_SIGINFO != 0 {
+		(*(*sigHandler)(unsafe.Pointer(&fwd.handler)))(sig, info, ctx)
+	} else {
+		(*(*sigHandler1)(unsafe.Pointer(&fwd.handler)))(sig)
+	}
+	return true
+}
+
+// sigpanic turns the synchronous signal sig into a run-time panic. Integer
+// division by zero is checked by compiled code, so SIGFPE only backs it up,
+// for code that divides without the check.
+func sigpanic(sig, code c.Int, addr uintptr) {
+	if sig == _SIGFPE {
+		switch code {
+		case _FPE_INTDIV:
+			panic(divideError)
+		case _FPE_INTOVF:
+			panic(overflowError)
+		}
+		panic(errorString("floating point error"))
+	}
+	panic(errorAddressString{msg: "invalid memory address or nil pointer dereference", addr: addr})
+}
+
+// sighandler handles the signals os/signal asked for.
+func sighandler(sig c.Int, info *siginfo, ctx c.Pointer) {
+	sigsend(sig)
+}
+
+// sigsend writes sig to the signal pipe. It drops the signal if the pipe is
+// full, as the delivery thread is far behind.
+func sigsend(sig c.Int) {
+	errno := *errnoLocation()
+	buf := (*byte)(c.Alloca(1))
+	*buf = byte(sig)
+	write(sigPipe[1], c.Pointer(buf), 1)
+	*errnoLocation() = errno
+}
+
+// SignalInit creates the pipe signals are delivered through.
+func SignalInit() {
+	if pipe(&sigPipe) != 0 {
+		fatal("runtime: cannot create signal pipe")
+		c.Exit(2)
+	}
+	fcntl(sigPipe[1], _F_SETFL, c.Int(_O_NONBLOCK))
+}
+
+// SignalRead blocks until a signal os/signal asked for arrives, and returns
+// it.
+func SignalRead() uint32 {
+	buf := (*byte)(c.Alloca(1))
+	for read(sigPipe[0], c.Pointer(buf), 1) != 1 {
+	}
+	return uint32(*buf)
+}
+
+func saveSignal(sig c.Int) {
+	if !sigSaved[sig] {
+		sigaction(sig, nil, &sigOrig[sig])
+		sigSaved[sig] = true
+	}
+}
+
+// SignalEnable starts delivering sig to os/signal. Synchronous signals are
+// delivered only if they are sent by kill or the like.
+func SignalEnable(sig uint32) {
+	if sig >= _NSIG {
+		return
+	}
+	s := c.Int(sig)
+	sigNotify[s] = true
+	if isSyncSignal(s) {
+		return
+	}
+	saveSignal(s)
+	var act sigactiont
+	act.handler = sigHandlerPC(sighandler)
+	act.flags = _SA_SIGINFO | _SA_ONSTACK | _SA_RESTART
+	sigaction(s, &act, nil)
+}
+
+// SignalDisable stops delivering sig to os/signal, and restores the action
+// it had before.
+func SignalDisable(sig uint32) {
+	if sig >= _NSIG {
+		return
+	}
+	s := c.Int(sig)
+	sigNotify[s] = false
+	if sigSaved[s] {
+		sigaction(s, &sigOrig[s], nil)
+	}
+}
+
+// SignalIgnore ignores sig.
+func SignalIgnore(sig uint32) {
+	if sig >= _NSIG {
+		return
+	}
+	s := c.Int(sig)
+	sigNotify[s] = false
+	if isSyncSignal(s) {
+		return
+	}
+	saveSignal(s)
+	var act sigactiont
+	act.handler = _SIG_IGN
+	sigaction(s, &act, nil)
+}
+
+// SignalIgnored reports whether sig is ignored.
+func SignalIgnored(sig uint32) bool {
+	if sig >= _NSIG {
+		return false
+	}
+	var act sigactiont
+	sigaction(c.Int(sig), nil, &act)
+	return act.handler == _SIG_IGN
 }
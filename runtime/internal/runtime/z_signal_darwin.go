@@ -0,0 +1,80 @@
+//go:build darwin && !baremetal
+
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	_ "unsafe"
+
+	c "github.com/goplus/llgo/runtime/internal/clite"
+)
+
+const (
+	_SIGBUS = 10
+	_SIGFPE = 8
+	_NSIG   = 32
+
+	_SA_ONSTACK = 0x1
+	_SA_RESTART = 0x2
+	_SA_NODEFER = 0x10
+	_SA_SIGINFO = 0x40
+
+	_SS_DISABLE = 4
+
+	_FPE_INTDIV = 7
+	_FPE_INTOVF = 8
+
+	_O_NONBLOCK = 0x4
+)
+
+const rlimInfinity = 1<<63 - 1
+
+type sigactiont struct {
+	handler uintptr
+	mask    uint32
+	flags   c.Int
+}
+
+type siginfo struct {
+	signo  c.Int
+	errno  c.Int
+	code   c.Int
+	pid    c.Int
+	uid    uint32
+	status c.Int
+	addr   uintptr
+}
+
+// fromUser reports whether the signal was sent by kill or the like rather
+// than raised by a fault.
+func (info *siginfo) fromUser() bool {
+	return info.code == 0x10001 // SI_USER
+}
+
+type stackt struct {
+	sp    c.Pointer
+	size  uintptr
+	flags c.Int
+}
+
+type rlimit struct {
+	cur, max uint64
+}
+
+//go:linkname errnoLocation C.__error
+func errnoLocation() *c.Int
@@ -0,0 +1,78 @@
+//go:build linux && !baremetal
+
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	_ "unsafe"
+
+	c "github.com/goplus/llgo/runtime/internal/clite"
+)
+
+const (
+	_SIGBUS = 7
+	_SIGFPE = 8
+	_NSIG   = 65
+
+	_SA_SIGINFO = 0x4
+	_SA_ONSTACK = 0x08000000
+	_SA_RESTART = 0x10000000
+	_SA_NODEFER = 0x40000000
+
+	_SS_DISABLE = 2
+
+	_FPE_INTDIV = 1
+	_FPE_INTOVF = 2
+
+	_O_NONBLOCK = 0x800
+)
+
+const rlimInfinity = ^uintptr(0)
+
+type sigactiont struct {
+	handler  uintptr
+	mask     [128]byte
+	flags    c.Int
+	restorer c.Pointer
+}
+
+type siginfo struct {
+	signo c.Int
+	errno c.Int
+	code  c.Int
+	addr  uintptr
+}
+
+// fromUser reports whether the signal was sent by kill, sigqueue or the
+// like rather than raised by a fault.
+func (info *siginfo) fromUser() bool {
+	return info.code <= 0 // SI_USER, SI_QUEUE, SI_TKILL, ...
+}
+
+type stackt struct {
+	sp    c.Pointer
+	flags c.Int
+	size  uintptr
+}
+
+type rlimit struct {
+	cur, max uintptr
+}
+
+//go:linkname errnoLocation C.__errno_location
+func errnoLocation() *c.Int
@@ -0,0 +1,55 @@
+//go:build (linux || darwin) && !baremetal
+
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+
+	c "github.com/goplus/llgo/runtime/internal/clite"
+)
+
+// indexes of registers after x0
+const (
+	_REG_LR = 30
+	_REG_SP = 31
+	_REG_PC = 32
+)
+
+// sigctxt gives access to the registers saved in the context of a signal.
+type sigctxt struct {
+	regs unsafe.Pointer
+}
+
+func (ctx sigctxt) reg(i int) *uintptr {
+	return (*uintptr)(unsafe.Add(ctx.regs, i*8))
+}
+
+// injectSigpanic makes the thread that got the signal call sigpanic(sig,
+// code, addr) once the handler returns, as if the faulting instruction did.
+// The frame of the faulting function, and its red zone, are left behind.
+func injectSigpanic(ctx c.Pointer, sig, code c.Int, addr uintptr) bool {
+	r := newSigctxt(ctx)
+	*r.reg(_REG_SP) = (*r.reg(_REG_SP) - 128) &^ 15
+	*r.reg(_REG_LR) = *r.reg(_REG_PC)
+	*r.reg(_REG_PC) = sigpanicPC()
+	*r.reg(0) = uintptr(sig)
+	*r.reg(1) = uintptr(code)
+	*r.reg(2) = addr
+	return true
+}
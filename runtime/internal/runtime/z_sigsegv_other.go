@@ -0,0 +1,40 @@
+//go:build !linux && !darwin && !wasm && !baremetal
+
+/*
+ * Copyright (c) 2024 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	c "github.com/goplus/llgo/runtime/internal/clite"
+	"github.com/goplus/llgo/runtime/internal/clite/signal"
+)
+
+// SIGSEGV is signal number 11 on all Unix-like systems.
+const SIGSEGV = c.Int(0xb)
+
+// On targets without the signal handling of z_signal.go, a SIGSEGV handler
+// still turns nil pointer dereferences into Go-style panics instead of
+// terminating the process.
+func init() {
+	signal.Signal(SIGSEGV, func(v c.Int) {
+		if v == SIGSEGV {
+			panic(errorString("invalid memory address or nil pointer dereference"))
+		}
+		var buf [20]byte
+		panic(errorString("unexpected signal value: " + string(itoa(buf[:], uint64(v)))))
+	})
+}
@@ -49,22 +49,40 @@ func NewSlice3(base unsafe.Pointer, eltSize, cap, i, j, k int) (s Slice) {
 
 // SliceAppend append elem data and returns a slice.
 func SliceAppend(src Slice, data unsafe.Pointer, num, etSize int) Slice {
+	return sliceAppend(src, data, num, etSize, false)
+}
+
+// SliceAppendNoScan is like SliceAppend, for elements without pointers.
+func SliceAppendNoScan(src Slice, data unsafe.Pointer, num, etSize int) Slice {
+	return sliceAppend(src, data, num, etSize, true)
+}
+
+func sliceAppend(src Slice, data unsafe.Pointer, num, etSize int, noscan bool) Slice {
 	if etSize == 0 {
 		return src
 	}
 	oldLen := src.len
-	src = GrowSlice(src, num, etSize)
+	src = growSlice(src, num, etSize, noscan)
 	c.Memcpy(c.Advance(src.data, oldLen*etSize), data, uintptr(num*etSize))
 	return src
 }
 
 // GrowSlice grows slice and returns the grown slice.
 func GrowSlice(src Slice, num, etSize int) Slice {
+	return growSlice(src, num, etSize, false)
+}
+
+func growSlice(src Slice, num, etSize int, noscan bool) Slice {
 	oldLen := src.len
 	newLen := oldLen + num
 	if newLen > src.cap {
 		newCap := nextslicecap(newLen, src.cap)
-		p := AllocZ(uintptr(newCap * etSize))
+		var p unsafe.Pointer
+		if noscan {
+			p = AllocZNoScan(uintptr(newCap * etSize))
+		} else {
+			p = AllocZ(uintptr(newCap * etSize))
+		}
 		if oldLen != 0 {
 			c.Memcpy(p, src.data, uintptr(oldLen*etSize))
 		}
@@ -123,6 +141,21 @@ func SliceCopy(dst Slice, data unsafe.Pointer, num int, etSize int) int {
 }
 
 func MakeSlice(len, cap int, etSize int) Slice {
+	return Slice{AllocZ(makeSliceSize(len, cap, etSize)), len, cap}
+}
+
+// MakeSliceNoScan is like MakeSlice, for elements without pointers.
+func MakeSliceNoScan(len, cap int, etSize int) Slice {
+	return Slice{AllocZNoScan(makeSliceSize(len, cap, etSize)), len, cap}
+}
+
+// MakeSliceTyped is like MakeSlice, allocating the elements with their type
+// so that a precise GC can scan them.
+func MakeSliceTyped(len, cap int, et *Type) Slice {
+	return Slice{AllocZTyped(makeSliceSize(len, cap, int(et.Size_)), et), len, cap}
+}
+
+func makeSliceSize(len, cap int, etSize int) uintptr {
 	mem, overflow := math.MulUintptr(uintptr(etSize), uintptr(cap))
 	if overflow || mem > maxAlloc || len < 0 || len > cap {
 		mem, overflow := math.MulUintptr(uintptr(etSize), uintptr(len))
@@ -131,7 +164,7 @@ func MakeSlice(len, cap int, etSize int) Slice {
 		}
 		panicmakeslicecap()
 	}
-	return Slice{AllocZ(mem), len, cap}
+	return mem
 }
 
 func panicmakeslicelen() {
@@ -0,0 +1,153 @@
+//go:build (linux || darwin) && !baremetal
+
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+
+	c "github.com/goplus/llgo/runtime/internal/clite"
+	"github.com/goplus/llgo/runtime/internal/clite/pthread"
+)
+
+// Each goroutine runs on a thread of its own, whose stack is followed by a
+// guard page. Running out of the stack faults on the guard page, and the
+// SIGSEGV handler tells it from other faults by the stack bounds the thread
+// recorded. The handler runs on an alternate signal stack, since there is no
+// room left on the stack of the thread.
+
+const (
+	altStackSize = 64 << 10
+
+	// stackGuardSlop is how far below the end of a stack faults are taken
+	// as running out of it: frames larger than the guard page skip over it,
+	// and Linux keeps a gap below the stack of the main thread.
+	stackGuardSlop = 1 << 20
+
+	// stackTopSlop covers the part of a stack above where its bounds are
+	// recorded: the thread descriptor, TLS, and arguments and environment
+	// of the process.
+	stackTopSlop = 256 << 10
+
+	_RLIMIT_STACK = 3
+)
+
+// stackInfo records the stack of a thread.
+type stackInfo struct {
+	lo, hi uintptr // 0 if the bounds are unknown
+	limit  int
+	alt    c.Pointer // the alternate signal stack
+}
+
+var stackKey pthread.Key
+
+//go:linkname sigaltstack C.sigaltstack
+func sigaltstack(ss, old *stackt) c.Int
+
+//go:linkname getrlimit C.getrlimit
+func getrlimit(resource c.Int, rlp *rlimit) c.Int
+
+func init() {
+	stackKey.Create(freeStack)
+	if v := getenv(c.Str("LLGO_STACK_SIZE")); v != nil {
+		s := c.GoString(v)
+		n, ok := parseByteCount(s)
+		if !ok || n <= 0 {
+			print("LLGO_STACK_SIZE=", s, "\n")
+			panic(errorString("malformed LLGO_STACK_SIZE; see `go doc runtime/debug.SetMaxStack`"))
+		}
+		stackSize = roundStackSize(int(n))
+	}
+	var rl rlimit
+	limit := 0
+	if getrlimit(_RLIMIT_STACK, &rl) == 0 && rl.cur != rlimInfinity {
+		limit = int(rl.cur)
+	}
+	enterStack(limit)
+}
+
+// enterStack records the stack of the current thread, which is limit bytes
+// if known, and sets up its alternate signal stack.
+func enterStack(limit int) {
+	info := (*stackInfo)(c.Malloc(unsafe.Sizeof(stackInfo{})))
+	*info = stackInfo{limit: limit, alt: c.Malloc(altStackSize)}
+	if limit > 0 {
+		info.hi = uintptr(c.Alloca(1))
+		info.lo = info.hi - uintptr(limit)
+	}
+	ss := stackt{sp: info.alt, size: altStackSize}
+	sigaltstack(&ss, nil)
+	stackKey.Set(c.Pointer(info))
+}
+
+// freeStack disables and frees the alternate signal stack of a thread
+// that is exiting.
+func freeStack(ptr c.Pointer) {
+	info := (*stackInfo)(ptr)
+	ss := stackt{flags: _SS_DISABLE}
+	sigaltstack(&ss, nil)
+	c.Free(info.alt)
+	c.Free(ptr)
+}
+
+// stackOverflow reports whether a fault at addr ran out of the stack of
+// the current thread, and returns the stack size.
+func stackOverflow(addr uintptr) (limit int, ok bool) {
+	info := (*stackInfo)(stackKey.Get())
+	if info == nil || info.lo == 0 {
+		return
+	}
+	return info.limit, addr+stackGuardSlop >= info.lo && addr < info.lo+stackTopSlop
+}
+
+// goroutineStart is the argument of goroutineEntry.
+type goroutineStart struct {
+	routine pthread.RoutineFunc
+	arg     c.Pointer
+	limit   int
+}
+
+// pthreadAttr is large enough to hold a pthread_attr_t of any supported
+// system.
+type pthreadAttr [16]uint64
+
+func createGoroutine(th *pthread.Thread, routine pthread.RoutineFunc, arg c.Pointer) c.Int {
+	var buf pthreadAttr
+	attr := (*pthread.Attr)(unsafe.Pointer(&buf))
+	attr.Init()
+	limit := goroutineStackSize()
+	attr.SetStackSize(uintptr(limit))
+	// allocated by the GC, which pthread.Create keeps alive until the
+	// thread starts
+	start := &goroutineStart{routine, arg, limit}
+	addGoroutines(1)
+	ret := pthread.Create(th, attr, goroutineEntry, c.Pointer(start))
+	if ret != 0 {
+		addGoroutines(-1)
+	}
+	attr.Destroy()
+	return ret
+}
+
+func goroutineEntry(arg c.Pointer) c.Pointer {
+	start := (*goroutineStart)(arg)
+	routine, rarg := start.routine, start.arg
+	enterStack(start.limit)
+	startM()
+	return routine(rarg)
+}
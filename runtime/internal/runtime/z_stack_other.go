@@ -0,0 +1,31 @@
+//go:build (!linux && !darwin) || baremetal
+
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	c "github.com/goplus/llgo/runtime/internal/clite"
+	"github.com/goplus/llgo/runtime/internal/clite/pthread"
+)
+
+// createGoroutine starts goroutines with the default thread stacks, as
+// stack sizes and overflow detection aren't supported on these targets.
+// GOMAXPROCS doesn't bound them either.
+func createGoroutine(th *pthread.Thread, routine pthread.RoutineFunc, arg c.Pointer) c.Int {
+	return pthread.Create(th, nil, routine, arg)
+}
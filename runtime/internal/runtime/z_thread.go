@@ -21,8 +21,74 @@ import (
 
 	c "github.com/goplus/llgo/runtime/internal/clite"
 	"github.com/goplus/llgo/runtime/internal/clite/pthread"
+	"github.com/goplus/llgo/runtime/internal/runtime/goarch"
 )
 
+const (
+	// defaultStackSize is the largest stack goroutines get unless changed by
+	// LLGO_STACK_SIZE: 2 MiB on 64-bit systems and 1 MiB on 32-bit ones, a
+	// quarter of the usual 8 MiB thread stack.
+	defaultStackSize = 256 << 10 * goarch.PtrSize
+
+	// defaultMaxStack is the initial SetMaxStack limit, as in Go: 1 GB on
+	// 64-bit systems and 250 MB on 32-bit ones.
+	defaultMaxStack = 250000000 * (goarch.PtrSize / 4) * (goarch.PtrSize / 4)
+
+	minStackSize  = 64 << 10
+	maxStackSize  = 1 << (28 + goarch.PtrSize/4) // 1 GiB on 64-bit systems
+	stackPageSize = 16 << 10                     // the largest page size of supported systems
+)
+
+var (
+	// maxStack is the limit of the stack of a goroutine set by SetMaxStack.
+	maxStack = defaultMaxStack
+
+	// stackSize is the largest stack reserved for a goroutine, in bytes.
+	stackSize = defaultStackSize
+)
+
+// SetMaxStack sets the limit of the stack of a goroutine and returns the
+// previous one, as runtime/debug.SetMaxStack does.
+//
+// Unlike Go, whose goroutine stacks grow up to the limit, llgo runs each
+// goroutine on a thread whose stack is reserved when the goroutine starts.
+// Goroutines created from now on reserve the smaller of the limit and
+// LLGO_STACK_SIZE (2 MiB by default on 64-bit systems), rounded and clamped
+// to what threads of the platform support. A goroutine running out of its
+// stack dies with a "stack overflow" fatal error. Running goroutines keep
+// their stacks.
+func SetMaxStack(bytes int) int {
+	old := maxStack
+	maxStack = bytes
+	return old
+}
+
+// goroutineStackSize returns the size of the stack to reserve for a new
+// goroutine.
+func goroutineStackSize() int {
+	if maxStack < stackSize {
+		return roundStackSize(maxStack)
+	}
+	return stackSize
+}
+
+// roundStackSize rounds the stack size n up to a multiple of the page size
+// and clamps it to the supported range.
+func roundStackSize(n int) int {
+	if n < minStackSize {
+		return minStackSize
+	}
+	if n > maxStackSize {
+		return maxStackSize
+	}
+	return (n + stackPageSize - 1) &^ (stackPageSize - 1)
+}
+
+// CreateThread starts a thread running routine(arg). Threads without attr
+// are goroutines, which get a stack of the size goroutineStackSize returns.
 func CreateThread(th *pthread.Thread, attr *pthread.Attr, routine pthread.RoutineFunc, arg c.Pointer) c.Int {
+	if attr == nil {
+		return createGoroutine(th, routine, arg)
+	}
 	return pthread.Create(th, attr, routine, arg)
 }
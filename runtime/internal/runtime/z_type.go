@@ -17,6 +17,8 @@
 package runtime
 
 import (
+	"unsafe"
+
 	"github.com/goplus/llgo/runtime/abi"
 )
 
@@ -32,4 +34,56 @@ func isDirectIface(t *_type) bool {
 // runtime type list initialized by compiler
 var typelist []*abi.Type
 
+// FuncStub holds the trampolines the compiler generates for a function type,
+// so reflect can call and make functions of the type without libffi.
+type FuncStub struct {
+	Type *abi.Type
+
+	// Call is a func(fn, ctx unsafe.Pointer, args *unsafe.Pointer, ret
+	// unsafe.Pointer) that calls fn(ctx, *args[0], ..., *args[n-1]) and
+	// stores its results into ret.
+	Call unsafe.Pointer
+
+	// Closure is the code of the closures reflect.MakeFunc returns, whose
+	// ctx points to a func(args *unsafe.Pointer, ret unsafe.Pointer).
+	Closure unsafe.Pointer
+}
+
+// function stub list initialized by compiler
+var funcStubs []*FuncStub
+
+// NumPromotedStubs is the number of trampolines the compiler generates for
+// a method type, which is how many methods of the type reflect.StructOf can
+// promote from embedded fields whose receivers need adjusting.
+const NumPromotedStubs = 4
+
+// Modes of a PromotedStub.
+const (
+	PromotedAddr  = iota // the receiver is the address of the embedded field
+	PromotedDeref        // the receiver is the word stored in the embedded field
+	PromotedIface        // the embedded field is an interface
+)
+
+// PromotedStub is a trampoline reflect.StructOf uses as the code of a method
+// promoted from an embedded field. Called with the address of a struct, it
+// calls Fn with the receiver found at offset Off of the struct as Mode says,
+// or method Index of the interface at Off if Mode is PromotedIface.
+type PromotedStub struct {
+	Code  unsafe.Pointer
+	Fn    unsafe.Pointer
+	Off   uintptr
+	Mode  uintptr
+	Index uintptr
+}
+
+// PromotedStubs holds the trampolines the compiler generates for a method
+// type.
+type PromotedStubs struct {
+	Type  *abi.Type
+	Stubs [NumPromotedStubs]PromotedStub
+}
+
+// promoted method stub list initialized by compiler
+var promotedStubs []*PromotedStubs
+
 // -----------------------------------------------------------------------------
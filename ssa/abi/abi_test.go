@@ -4,6 +4,7 @@
 package abi_test
 
 import (
+	"bytes"
 	"go/types"
 	"reflect"
 	"runtime"
@@ -202,6 +203,107 @@ func TestScope(t *testing.T) {
 	}
 }
 
+func TestGCMask(t *testing.T) {
+	b := newBuilder("main")
+	pkg := types.NewPackage("", "main")
+	ptr := types.NewPointer(types.Typ[types.Int])
+	tests := []struct {
+		typ  types.Type
+		want []byte
+	}{
+		{types.Typ[types.Int], nil},
+		{types.NewArray(types.Typ[types.Byte], 64), nil},
+		{ptr, []byte{0x01}},
+		{types.Typ[types.String], []byte{0x01}},
+		{types.NewSlice(types.Typ[types.Int]), []byte{0x01}},
+		{types.NewInterface(nil, nil), []byte{0x03}},
+		{types.NewStruct([]*types.Var{
+			types.NewField(0, pkg, "n", types.Typ[types.Int], false),
+			types.NewField(0, pkg, "p", ptr, false),
+			types.NewField(0, pkg, "m", types.Typ[types.Int], false),
+			types.NewField(0, pkg, "s", types.Typ[types.String], false),
+		}, nil), []byte{0x0a}},
+		{types.NewArray(types.NewStruct([]*types.Var{
+			types.NewField(0, pkg, "n", types.Typ[types.Int], false),
+			types.NewField(0, pkg, "p", ptr, false),
+		}, nil), 5), []byte{0xaa, 0x02}},
+	}
+	for _, tt := range tests {
+		if got := b.GCMask(tt.typ); !bytes.Equal(got, tt.want) {
+			t.Errorf("GCMask(%v) = %x, want %x", tt.typ, got, tt.want)
+		}
+	}
+
+	// llgo lays out a func value as a closure {fn, env}
+	b = abi.New("main", unsafe.Sizeof(0), closureSizes{types.SizesFor("gc", runtime.GOARCH)})
+	word := unsafe.Sizeof(0)
+	fn := types.NewSignatureType(nil, nil, nil, nil, nil, false)
+	closures := []struct {
+		typ      types.Type
+		want     []byte
+		size     uintptr
+		ptrBytes uintptr
+	}{
+		{fn, []byte{0x03}, 2 * word, 2 * word},
+		{types.NewStruct([]*types.Var{
+			types.NewField(0, pkg, "x", types.Typ[types.Int], false),
+			types.NewField(0, pkg, "f", fn, false),
+		}, nil), []byte{0x06}, 3 * word, 3 * word},
+		{types.NewArray(fn, 2), []byte{0x0f}, 4 * word, 4 * word},
+		{types.NewArray(fn, 8), []byte{0xff, 0xff}, 16 * word, 16 * word},
+	}
+	for _, tt := range closures {
+		if got := b.GCMask(tt.typ); !bytes.Equal(got, tt.want) {
+			t.Errorf("GCMask(%v) = %x, want %x", tt.typ, got, tt.want)
+		}
+		if got := b.Size(tt.typ); got != tt.size {
+			t.Errorf("Size(%v) = %d, want %d", tt.typ, got, tt.size)
+		}
+		if got := b.PtrBytes(tt.typ); got != tt.ptrBytes {
+			t.Errorf("PtrBytes(%v) = %d, want %d", tt.typ, got, tt.ptrBytes)
+		}
+	}
+}
+
+// closureSizes gives a func value two words, as llgo's program sizes do.
+type closureSizes struct {
+	types.Sizes
+}
+
+func (s closureSizes) Sizeof(t types.Type) int64 {
+	switch t := t.Underlying().(type) {
+	case *types.Signature:
+		return 2 * s.Sizes.Sizeof(t)
+	case *types.Array:
+		return t.Len() * s.Sizeof(t.Elem())
+	case *types.Struct:
+		n := t.NumFields()
+		if n == 0 {
+			return 0
+		}
+		fields := make([]*types.Var, n)
+		for i := range fields {
+			fields[i] = t.Field(i)
+		}
+		size := s.Offsetsof(fields)[n-1] + s.Sizeof(fields[n-1].Type())
+		align := s.Alignof(t)
+		return (size + align - 1) / align * align
+	}
+	return s.Sizes.Sizeof(t)
+}
+
+func (s closureSizes) Offsetsof(fields []*types.Var) []int64 {
+	offsets := make([]int64, len(fields))
+	var off int64
+	for i, f := range fields {
+		align := s.Alignof(f.Type())
+		off = (off + align - 1) / align * align
+		offsets[i] = off
+		off += s.Sizeof(f.Type())
+	}
+	return offsets
+}
+
 func newBuilder(pkg string) *abi.Builder {
 	return abi.New(pkg, unsafe.Sizeof(0), types.SizesFor("gc", runtime.GOARCH))
 }
@@ -345,7 +345,8 @@ func (b *Builder) PtrBytes(t types.Type) uintptr {
 	case *types.Slice:
 		return b.PtrSize
 	case *types.Signature:
-		return b.PtrSize
+		// a closure is {fn, env}, both may point to the heap
+		return b.Size(t)
 	case *types.Interface:
 		return 2 * b.PtrSize
 	case *types.Struct:
@@ -377,11 +378,76 @@ func (b *Builder) PtrBytes(t types.Type) uintptr {
 	case *types.Chan:
 		return b.PtrSize
 	case *types.Named:
+		if _, ok := t.Underlying().(*types.Signature); ok {
+			return b.Size(t) // a C function type is a single word
+		}
 		return b.PtrBytes(t.Underlying())
 	}
 	panic("unsupported ptrbytes: " + t.String())
 }
 
+// GCMask returns the pointer bitmap of t: bit i (least significant bit
+// first) is set if the i-th word of t may hold a pointer. The bitmap covers
+// all Size(t)/PtrSize words of t; it is nil if t holds no pointers.
+func (b *Builder) GCMask(t types.Type) []byte {
+	if b.PtrBytes(t) == 0 {
+		return nil
+	}
+	words := (b.Size(t) + b.PtrSize - 1) / b.PtrSize
+	mask := make([]byte, (words+7)/8)
+	b.gcMask(mask, t, 0)
+	return mask
+}
+
+func (b *Builder) gcMask(mask []byte, t types.Type, off uintptr) {
+	setWords := func(n uintptr) {
+		for i := uintptr(0); i < n; i++ {
+			w := off/b.PtrSize + i
+			mask[w/8] |= 1 << (w % 8)
+		}
+	}
+	switch t := types.Unalias(t).(type) {
+	case *types.Basic:
+		switch t.Kind() {
+		case types.String, types.UnsafePointer:
+			setWords(1)
+		}
+	case *types.Pointer, *types.Slice, *types.Map, *types.Chan:
+		setWords(1)
+	case *types.Signature:
+		setWords(b.PtrBytes(t) / b.PtrSize)
+	case *types.Interface:
+		setWords(2)
+	case *types.Struct:
+		n := t.NumFields()
+		fields := make([]*types.Var, n)
+		for i := 0; i < n; i++ {
+			fields[i] = t.Field(i)
+		}
+		offsets := b.Sizes.Offsetsof(fields)
+		for i, f := range fields {
+			if b.PtrBytes(f.Type()) != 0 {
+				b.gcMask(mask, f.Type(), off+uintptr(offsets[i]))
+			}
+		}
+	case *types.Array:
+		elem := t.Elem()
+		if b.PtrBytes(elem) == 0 {
+			return
+		}
+		size := b.Size(elem)
+		for i := int64(0); i < t.Len(); i++ {
+			b.gcMask(mask, elem, off+uintptr(i)*size)
+		}
+	case *types.Named:
+		if _, ok := t.Underlying().(*types.Signature); ok {
+			setWords(b.PtrBytes(t) / b.PtrSize)
+			return
+		}
+		b.gcMask(mask, t.Underlying(), off)
+	}
+}
+
 func (b *Builder) Size(t types.Type) uintptr {
 	switch t := types.Unalias(t).(type) {
 	case *types.Basic:
@@ -414,7 +480,8 @@ func (b *Builder) Size(t types.Type) uintptr {
 	case *types.Slice:
 		return 3 * b.PtrSize
 	case *types.Signature:
-		return b.PtrSize
+		// b.Sizes knows whether a function is a closure or a C function
+		return uintptr(b.Sizes.Sizeof(t))
 	case *types.Interface:
 		return 2 * b.PtrSize
 	case *types.Struct:
@@ -422,10 +489,13 @@ func (b *Builder) Size(t types.Type) uintptr {
 	case *types.Map:
 		return b.PtrSize
 	case *types.Array:
-		return uintptr(t.Len()) * b.Size(t.Elem())
+		return uintptr(b.Sizes.Sizeof(t))
 	case *types.Chan:
 		return b.PtrSize
 	case *types.Named:
+		if _, ok := t.Underlying().(*types.Signature); ok {
+			return uintptr(b.Sizes.Sizeof(t))
+		}
 		return b.Size(t.Underlying())
 	}
 	panic("unsupported size: " + t.String())
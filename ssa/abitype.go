@@ -106,7 +106,13 @@ func (b Builder) abiCommonFields(t types.Type, name string, hasUncommon bool) (f
 	}
 	fields = append(fields, equal.impl)
 	// GCData     *byte
-	fields = append(fields, prog.Nil(prog.Pointer(prog.Byte())).impl)
+	gcdata := prog.Nil(prog.Pointer(prog.Byte())).impl
+	if prog.gcData {
+		if mask := ab.GCMask(t); mask != nil {
+			gcdata = pkg.createGlobalStr(string(mask))
+		}
+	}
+	fields = append(fields, gcdata)
 	// Str_       string
 	fields = append(fields, b.Str(ab.Str(t)).impl)
 	// PtrToThis_ *Type
@@ -204,7 +210,13 @@ func (b Builder) abiInterfaceImethods(t *types.Interface, name string) llvm.Valu
 			}
 			values = append(values, b.Str(name).impl)
 			ftyp := funcType(prog, f.Type())
-			values = append(values, b.abiType(ftyp).impl)
+			mtyp := b.abiType(ftyp)
+			if prog.reflectStubs && token.IsExported(name) {
+				// reflect.StructOf may promote the method from an embedded interface
+				mtypName, _ := b.Pkg.abi.TypeName(ftyp)
+				b.Pkg.abiPromotedStubs(ftyp.(*types.Signature), mtypName, mtyp)
+			}
+			values = append(values, mtyp.impl)
 			fields[i] = llvm.ConstNamedStruct(ft.ll, values)
 		}
 		atyp := prog.rawType(types.NewArray(ft.RawType(), int64(n)))
@@ -431,7 +443,13 @@ func (b Builder) abiUncommonMethods(t types.Type, mset *types.MethodSet) llvm.Va
 		var values []llvm.Value
 		values = append(values, name)
 		ftyp := funcType(prog, m.Type())
-		values = append(values, b.abiType(ftyp).impl)
+		mtyp := b.abiType(ftyp)
+		if prog.reflectStubs && token.IsExported(mName) {
+			// reflect.StructOf may promote the method from an embedded field
+			mtypName, _ := b.Pkg.abi.TypeName(ftyp)
+			b.Pkg.abiPromotedStubs(ftyp.(*types.Signature), mtypName, mtyp)
+		}
+		values = append(values, mtyp.impl)
 		values = append(values, ifn)
 		values = append(values, tfn)
 		fields[i] = llvm.ConstNamedStruct(ft.ll, values)
@@ -510,7 +528,14 @@ func (b Builder) abiType(t types.Type) Expr {
 		g.impl.SetGlobalConstant(true)
 		g.impl.SetLinkage(llvm.WeakODRLinkage)
 		prog.abiSymbol[name] = g.Type
+		if sig, ok := types.Unalias(t).(*types.Signature); ok && prog.reflectStubs {
+			pkg.abiFuncStub(sig, name, abiTypePtr(prog, g))
+		}
 	}
+	return abiTypePtr(prog, g)
+}
+
+func abiTypePtr(prog Program, g Global) Expr {
 	return Expr{llvm.ConstGEP(g.impl.GlobalValueType(), g.impl, []llvm.Value{
 		llvm.ConstInt(prog.Int32().ll, 0, false),
 		llvm.ConstInt(prog.Int32().ll, 0, false),
@@ -0,0 +1,261 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+import (
+	"fmt"
+	"go/types"
+	"log"
+	"runtime"
+	"strings"
+
+	"github.com/goplus/llvm"
+)
+
+// -----------------------------------------------------------------------------
+
+// AsmOperand describes an operand of an inline assembly statement.
+type AsmOperand struct {
+	Name   string // operand name, used in error messages
+	Value  Expr   // input value, or address of a memory operand
+	Type   Type   // type of an output operand
+	Memory bool   // operand is the memory Value points to
+	Early  bool   // output is written before all inputs are read
+}
+
+// asmArch returns the architecture inline assembly is generated for.
+func (p *Target) asmArch() string {
+	if p.LLVMTarget != "" {
+		arch, _, _ := strings.Cut(p.LLVMTarget, "-")
+		switch {
+		case arch == "aarch64", arch == "arm64":
+			return "arm64"
+		case strings.HasPrefix(arch, "arm"), strings.HasPrefix(arch, "thumb"):
+			return "arm"
+		case arch == "x86_64":
+			return "amd64"
+		case arch == "i386", arch == "i686":
+			return "386"
+		case strings.HasPrefix(arch, "wasm"):
+			return "wasm"
+		}
+		return arch // avr, riscv32, xtensa, ...
+	}
+	if p.GOARCH == "" {
+		return runtime.GOARCH
+	}
+	return p.GOARCH
+}
+
+// asmRegister returns the register constraint of an operand of type t. vec
+// is the LLVM vector type the operand is passed as, if t is an array.
+func (p Program) asmRegister(arch string, t Type) (constraint string, vec llvm.Type, err error) {
+	switch u := t.RawType().Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsInteger != 0, u.Kind() == types.UnsafePointer:
+			return "r", vec, nil
+		case u.Info()&types.IsFloat != 0:
+			constraint = asmFloatRegister(arch, u.Kind() == types.Float32)
+			if constraint == "" {
+				err = fmt.Errorf("%v operands are not supported on %s: no floating-point registers", u, arch)
+			}
+			return
+		}
+	case *types.Pointer:
+		return "r", vec, nil
+	case *types.Array:
+		elem, ok := u.Elem().Underlying().(*types.Basic)
+		if !ok || elem.Info()&types.IsNumeric == 0 || elem.Info()&types.IsComplex != 0 {
+			return "", vec, fmt.Errorf("vector operand type %v must be an array of integers or floats", t.RawType())
+		}
+		size := p.SizeOf(t)
+		constraint = asmVectorRegister(arch, size)
+		if constraint == "" {
+			return "", vec, fmt.Errorf("%d-byte vector operands are not supported on %s", size, arch)
+		}
+		vec = llvm.VectorType(p.rawType(u.Elem()).ll, int(u.Len()))
+		return
+	}
+	return "", vec, fmt.Errorf("unsupported operand type %v", t.RawType())
+}
+
+func asmFloatRegister(arch string, single bool) string {
+	switch arch {
+	case "amd64", "386":
+		return "x"
+	case "arm64":
+		return "w"
+	case "arm":
+		if single {
+			return "t"
+		}
+		return "w"
+	case "riscv64", "riscv32", "loong64", "ppc64le", "s390x", "mips64", "mips64le":
+		return "f"
+	}
+	return ""
+}
+
+func asmVectorRegister(arch string, size uint64) string {
+	switch arch {
+	case "amd64":
+		if size == 16 || size == 32 {
+			return "x"
+		}
+	case "386":
+		if size == 16 {
+			return "x"
+		}
+	case "arm64", "arm":
+		if size == 8 || size == 16 {
+			return "w"
+		}
+	case "ppc64le", "s390x":
+		if size == 16 {
+			return "v"
+		}
+	}
+	return ""
+}
+
+// asmConvert converts v between an array type and a vector type of the same
+// size, through memory. The memory is allocated in the entry block, so that
+// a conversion in a loop doesn't grow the stack.
+func (b Builder) asmConvert(v llvm.Value, from, to llvm.Type) llvm.Value {
+	entry := b.Prog.ctx.NewBuilder()
+	defer entry.Dispose()
+	blk := b.Func.blks[0].first
+	if first := blk.FirstInstruction(); first.IsNil() {
+		entry.SetInsertPointAtEnd(blk)
+	} else {
+		entry.SetInsertPointBefore(first)
+	}
+	ptr := llvm.CreateAlloca(entry, from)
+	b.impl.CreateStore(v, ptr)
+	return llvm.CreateLoad(b.impl, to, ptr)
+}
+
+// InlineAsmEx generates an inline assembly statement with typed operands.
+// The statement refers to outputs[i] as ${i} and to inputs[j] as
+// ${len(outputs)+j}. clobbers lists the registers the statement modifies
+// besides its outputs, "memory" and "cc" included. It returns the values
+// of outputs, or an error if an operand can't be passed on the target.
+func (b Builder) InlineAsmEx(instruction string, outputs, inputs []AsmOperand, clobbers []string) (rets []Expr, err error) {
+	prog := b.Prog
+	arch := prog.target.asmArch()
+	if arch == "wasm" && len(outputs)+len(inputs)+len(clobbers) > 0 {
+		return nil, fmt.Errorf("inline assembly operands are not supported on %s", arch)
+	}
+	n := len(outputs)
+	constraints := make([]string, 0, n+len(inputs)+len(clobbers))
+	outTypes := make([]llvm.Type, n)
+	outVecs := make([]llvm.Type, n)
+	for i, o := range outputs {
+		if o.Memory {
+			return nil, fmt.Errorf("output %s can't be a memory operand", o.Name)
+		}
+		c, vec, err := prog.asmRegister(arch, o.Type)
+		if err != nil {
+			return nil, fmt.Errorf("output %s: %w", o.Name, err)
+		}
+		if o.Early {
+			c = "&" + c
+		}
+		constraints = append(constraints, "="+c)
+		outTypes[i], outVecs[i] = o.Type.ll, vec
+		if !vec.IsNil() {
+			outTypes[i] = vec
+		}
+	}
+
+	typs := make([]llvm.Type, len(inputs))
+	vals := make([]llvm.Value, len(inputs))
+	elems := make([]llvm.Type, len(inputs)) // element types of memory operands
+	for i, in := range inputs {
+		typs[i], vals[i] = in.Value.Type.ll, in.Value.impl
+		if in.Memory {
+			switch t := in.Value.Type.RawType().Underlying().(type) {
+			case *types.Pointer:
+				elems[i] = prog.rawType(t.Elem()).ll
+			case *types.Basic:
+				if t.Kind() != types.UnsafePointer {
+					return nil, fmt.Errorf("memory operand %s must be a pointer", in.Name)
+				}
+				elems[i] = prog.tyInt8()
+			default:
+				return nil, fmt.Errorf("memory operand %s must be a pointer", in.Name)
+			}
+			constraints = append(constraints, "*m")
+			continue
+		}
+		c, vec, err := prog.asmRegister(arch, in.Value.Type)
+		if err != nil {
+			return nil, fmt.Errorf("input %s: %w", in.Name, err)
+		}
+		if !vec.IsNil() {
+			vals[i] = b.asmConvert(vals[i], typs[i], vec)
+			typs[i] = vec
+		}
+		constraints = append(constraints, c)
+	}
+
+	for _, c := range clobbers {
+		if c == "" || strings.ContainsAny(c, "{},~= \t") {
+			return nil, fmt.Errorf("invalid clobber %q", c)
+		}
+		constraints = append(constraints, "~{"+c+"}")
+	}
+
+	var retType llvm.Type
+	switch n {
+	case 0:
+		retType = prog.tyVoid()
+	case 1:
+		retType = outTypes[0]
+	default:
+		retType = prog.ctx.StructType(outTypes, false)
+	}
+	constraintStr := strings.Join(constraints, ",")
+	if debugInstr {
+		log.Printf("InlineAsmEx %q, constraints: %q\n", instruction, constraintStr)
+	}
+	ftype := llvm.FunctionType(retType, typs, false)
+	asm := llvm.InlineAsm(ftype, instruction, constraintStr, true, false, llvm.InlineAsmDialectATT, false)
+	call := b.impl.CreateCall(ftype, asm, vals, "")
+	for i, elem := range elems {
+		if !elem.IsNil() {
+			attr := prog.ctx.CreateTypeAttribute(llvm.AttributeKindID("elementtype"), elem)
+			call.AddCallSiteAttribute(i+1, attr)
+		}
+	}
+
+	rets = make([]Expr, n)
+	for i, o := range outputs {
+		v := call
+		if n > 1 {
+			v = llvm.CreateExtractValue(b.impl, call, i)
+		}
+		if !outVecs[i].IsNil() {
+			v = b.asmConvert(v, outVecs[i], o.Type.ll)
+		}
+		rets[i] = Expr{v, o.Type}
+	}
+	return rets, nil
+}
+
+// -----------------------------------------------------------------------------
@@ -428,7 +428,14 @@ func (b Builder) MakeSlice(t Type, len, cap Expr) (ret Expr) {
 	len = b.fitIntSize(len)
 	cap = b.fitIntSize(cap)
 	telem := prog.Index(t)
-	ret = b.InlineCall(b.Pkg.rtFunc("MakeSlice"), len, cap, prog.IntVal(prog.SizeOf(telem), prog.Int()))
+	switch {
+	case b.pointerFree(telem):
+		ret = b.InlineCall(b.Pkg.rtFunc("MakeSliceNoScan"), len, cap, prog.IntVal(prog.SizeOf(telem), prog.Int()))
+	case prog.gcData:
+		ret = b.InlineCall(b.Pkg.rtFunc("MakeSliceTyped"), len, cap, b.abiType(telem.raw.Type))
+	default:
+		ret = b.InlineCall(b.Pkg.rtFunc("MakeSlice"), len, cap, prog.IntVal(prog.SizeOf(telem), prog.Int()))
+	}
 	ret.Type = t
 	return
 }
@@ -298,18 +298,6 @@ func (b Builder) InlineAsm(instruction string) {
 	b.impl.CreateCall(typ, asm, nil, "")
 }
 
-func (b Builder) InlineAsmFull(instruction, constraints string, retType Type, exprs []Expr) Expr {
-	typs := make([]llvm.Type, len(exprs))
-	vals := make([]llvm.Value, len(exprs))
-	for i, expr := range exprs {
-		typs[i], vals[i] = expr.Type.ll, expr.impl
-	}
-
-	ftype := llvm.FunctionType(retType.ll, typs, false)
-	asm := llvm.InlineAsm(ftype, instruction, constraints, true, false, llvm.InlineAsmDialectATT, false)
-	return Expr{b.impl.CreateCall(ftype, asm, vals, ""), retType}
-}
-
 // GoString returns a Go string
 func (b Builder) GoString(v Expr) Expr {
 	fn := b.Pkg.rtFunc("GoString")
@@ -1257,23 +1245,27 @@ func (b Builder) BuiltinCall(fn string, args ...Expr) (ret Expr) {
 			src := args[0]
 			if src.kind == vkSlice {
 				elem := args[1]
+				fnAppend := b.Pkg.rtFunc("SliceAppend")
+				if b.pointerFree(b.Prog.Index(src.Type)) {
+					fnAppend = b.Pkg.rtFunc("SliceAppendNoScan")
+				}
 				switch elem.kind {
 				case vkSlice:
 					etSize := b.Prog.SizeOf(b.Prog.Elem(elem.Type))
 					ret.Type = src.Type
-					ret.impl = b.InlineCall(b.Pkg.rtFunc("SliceAppend"),
+					ret.impl = b.InlineCall(fnAppend,
 						src, b.SliceData(elem), b.SliceLen(elem), b.Prog.Val(int(etSize))).impl
 					return
 				case vkString:
 					etSize := b.Prog.SizeOf(b.Prog.Byte())
 					ret.Type = src.Type
-					ret.impl = b.InlineCall(b.Pkg.rtFunc("SliceAppend"),
+					ret.impl = b.InlineCall(fnAppend,
 						src, b.StringData(elem), b.StringLen(elem), b.Prog.Val(int(etSize))).impl
 					return
 				default:
 					etSize := b.Prog.SizeOf(elem.Type)
 					ret.Type = src.Type
-					ret.impl = b.InlineCall(b.Pkg.rtFunc("SliceAppend"),
+					ret.impl = b.InlineCall(fnAppend,
 						src, elem, b.Const(constant.MakeInt64(1), b.Prog.Int()), b.Prog.Val(int(etSize))).impl
 					return
 				}
@@ -43,6 +43,37 @@ func (b Builder) pthreadCreate(pp, attr, routine, arg Expr) Expr {
 
 // -----------------------------------------------------------------------------
 
+// SetCgoCallbacks sets whether functions exported to C start with a
+// CgoCallback, for C code to call them on threads of its own.
+func (p Program) SetCgoCallbacks(enable bool) {
+	p.cgoCallbacks = enable
+}
+
+// CgoCallbacks reports whether functions exported to C start with a
+// CgoCallback.
+func (p Program) CgoCallbacks() bool {
+	return p.cgoCallbacks
+}
+
+// CgoCallback attaches the current thread to the runtime, unless it already
+// is, for the function exported to C being built to run on it.
+func (b Builder) CgoCallback() {
+	b.Call(b.Pkg.rtFunc("CgoCallback"))
+}
+
+// EnterBlocking gives up the proc of the current goroutine before a call to C
+// code, which may block.
+func (b Builder) EnterBlocking() {
+	b.Call(b.Pkg.rtFunc("EnterBlocking"))
+}
+
+// ExitBlocking gets back the proc EnterBlocking gave up.
+func (b Builder) ExitBlocking() {
+	b.Call(b.Pkg.rtFunc("ExitBlocking"))
+}
+
+// -----------------------------------------------------------------------------
+
 // The Go instruction creates a new goroutine and calls the specified
 // function within it.
 //
@@ -102,7 +133,7 @@ func (p Package) routine(t Type, fn Expr, buildCall func(Builder, Expr, ...Expr)
 		args[i] = b.getField(data, i+offset)
 	}
 	buildCall(b, fn, args...)
-	b.free(param)
+	b.aggregateFree(t, param)
 	b.Return(prog.Nil(prog.VoidPtr()))
 	return routine.Expr
 }
@@ -42,14 +42,35 @@ func (b Builder) aggregateAlloca(t Type, flds ...llvm.Value) llvm.Value {
 	return ptr
 }
 
+// aggregateMalloc allocates memory that the GC never collects, and must be
+// released by aggregateFree. Aggregates with pointers are still scanned.
 func (b Builder) aggregateMalloc(t Type, flds ...llvm.Value) llvm.Value {
 	prog := b.Prog
-	size := prog.SizeOf(t)
-	ptr := b.malloc(prog.IntVal(size, prog.Uintptr())).impl
+	size := prog.IntVal(prog.SizeOf(t), prog.Uintptr())
+	var ptr llvm.Value
+	if b.pointerFree(t) {
+		ptr = b.malloc(size).impl
+	} else {
+		ptr = b.InlineCall(b.Pkg.rtFunc("AllocUncollectable"), size).impl
+	}
 	aggregateInit(b.impl, ptr, t.ll, flds...)
 	return ptr
 }
 
+func (b Builder) aggregateFree(t Type, ptr Expr) {
+	if b.pointerFree(t) {
+		b.free(ptr)
+	} else {
+		b.InlineCall(b.Pkg.rtFunc("FreeUncollectable"), ptr)
+	}
+}
+
+// pointerFree reports whether values of type t contain no pointers, so that
+// the GC doesn't need to scan their memory.
+func (b Builder) pointerFree(t Type) bool {
+	return b.Pkg.abi.PtrBytes(t.raw.Type) == 0
+}
+
 // Aggregate constructs an aggregate value from Expr fields.
 func (b Builder) Aggregate(t Type, flds ...Expr) Expr {
 	vals := make([]llvm.Value, len(flds))
@@ -118,7 +139,11 @@ func (b Builder) Alloc(elem Type, heap bool) (ret Expr) {
 	pkg := b.Pkg
 	size := SizeOf(prog, elem)
 	if heap {
-		ret = b.InlineCall(pkg.rtFunc("AllocZ"), size)
+		if prog.gcData && !b.pointerFree(elem) {
+			ret = b.InlineCall(pkg.rtFunc("AllocZTyped"), size, b.abiType(elem.raw.Type))
+		} else {
+			ret = b.InlineCall(pkg.rtFunc("AllocZ"), size)
+		}
 	} else {
 		ret = Expr{llvm.CreateAlloca(b.impl, elem.ll), prog.VoidPtr()}
 		ret.impl = b.zeroinit(ret, size).impl
@@ -127,6 +152,26 @@ func (b Builder) Alloc(elem Type, heap bool) (ret Expr) {
 	return
 }
 
+// MakeArray allocates a zero-initialized array of type t on the heap, as the
+// backing array of a slice. Arrays without pointers aren't scanned by the GC.
+func (b Builder) MakeArray(t Type) (ret Expr) {
+	if debugInstr {
+		log.Printf("MakeArray %v\n", t.RawType())
+	}
+	prog := b.Prog
+	size := SizeOf(prog, t)
+	switch {
+	case b.pointerFree(t):
+		ret = b.InlineCall(b.Pkg.rtFunc("AllocZNoScan"), size)
+	case prog.gcData:
+		ret = b.InlineCall(b.Pkg.rtFunc("AllocZTyped"), size, b.abiType(t.raw.Type))
+	default:
+		ret = b.InlineCall(b.Pkg.rtFunc("AllocZ"), size)
+	}
+	ret.Type = prog.Pointer(t)
+	return
+}
+
 // AllocU allocates uninitialized space for n*sizeof(elem) bytes.
 func (b Builder) AllocU(elem Type, n ...int64) (ret Expr) {
 	prog := b.Prog
@@ -182,6 +182,7 @@ type aProgram struct {
 	callNoArgs   *types.Signature
 	callOneArg   *types.Signature
 	callFOArgs   *types.Signature
+	callArgs     *types.Signature
 	loadPyModS   *types.Signature
 	getAttrStr   *types.Signature
 	pyUniStr     *types.Signature
@@ -217,6 +218,10 @@ type aProgram struct {
 	ptrSize int
 
 	is32Bits bool
+	gcData   bool // emit pointer bitmaps in abi.Type.GCData
+
+	reflectStubs bool // emit reflect call and MakeFunc trampolines of func types
+	cgoCallbacks bool // attach threads to the runtime in functions exported to C
 }
 
 // A Program presents a program.
@@ -309,6 +314,13 @@ func (p Program) SetRuntime(runtime any) {
 	}
 }
 
+// SetGCData sets whether to emit the pointer bitmaps of types in the GCData
+// field of their ABI types and to allocate heap objects with their types,
+// so that a precise garbage collector can scan them.
+func (p Program) SetGCData(enable bool) {
+	p.gcData = enable
+}
+
 func (p Program) SetTypeBackground(fullName string, bg Background) {
 	p.gocvt.typbg.Store(fullName, bg)
 }
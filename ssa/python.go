@@ -71,6 +71,33 @@ func (p Program) SetPython(py any) {
 
 // -----------------------------------------------------------------------------
 
+const (
+	// NamePyKwargs is the name of the trailing *py.Object parameter of a
+	// Python function that receives keyword arguments (a dict, or nil).
+	NamePyKwargs = "__llgo_kwargs"
+)
+
+// HasNamePyKwargs reports whether sig is a Python function signature that
+// takes keyword arguments, i.e. its last parameter is named NamePyKwargs, or
+// the one before the variadic parameter is if sig is variadic.
+func HasNamePyKwargs(sig *types.Signature) bool {
+	return pyKwargsIndex(sig) >= 0
+}
+
+// pyKwargsIndex returns the index of the NamePyKwargs parameter of sig, or
+// -1 if there is none.
+func pyKwargsIndex(sig *types.Signature) int {
+	params := sig.Params()
+	i := params.Len() - 1
+	if sig.Variadic() {
+		i--
+	}
+	if i >= 0 && params.At(i).Name() == NamePyKwargs {
+		return i
+	}
+	return -1
+}
+
 func (p Package) pyFunc(fullName string, sig *types.Signature) Expr {
 	p.NeedPyInit = true
 	return p.NewFunc(fullName, sig, InC).Expr
@@ -126,7 +153,6 @@ func (p Program) tyCallFunctionObjArgs() *types.Signature {
 	return p.callFOArgs
 }
 
-/*
 // func(*Object, *Object, *Object) *Object
 func (p Program) tyCall() *types.Signature {
 	if p.callArgs == nil {
@@ -137,7 +163,6 @@ func (p Program) tyCall() *types.Signature {
 	}
 	return p.callArgs
 }
-*/
 
 // func(*Object, uintptr, *Object) cint
 func (p Program) tyListSetItem() *types.Signature {
@@ -347,6 +372,9 @@ func (b Builder) pyCall(fn Expr, args []Expr) (ret Expr) {
 	pkg := b.Pkg
 	fn = b.Load(fn)
 	sig := fn.raw.Type.(*types.Signature)
+	if i := pyKwargsIndex(sig); i >= 0 {
+		return b.pyCallKw(fn, args, i)
+	}
 	params := sig.Params()
 	n := params.Len()
 	switch n {
@@ -371,6 +399,18 @@ func (b Builder) pyCall(fn Expr, args []Expr) (ret Expr) {
 	return
 }
 
+// pyCallKw calls fn(*args, **kwargs), where args[kw] is the kwargs dict (may
+// be nil) and the others, including the variadic ones after it, are packed
+// into a positional tuple.
+func (b Builder) pyCallKw(fn Expr, args []Expr, kw int) Expr {
+	prog := b.Prog
+	pos := make([]Expr, 0, len(args)-1)
+	pos = append(pos, args[:kw]...)
+	pos = append(pos, args[kw+1:]...)
+	call := b.Pkg.pyFunc("PyObject_Call", prog.tyCall())
+	return b.Call(call, fn, b.PyTuple(pos...), args[kw])
+}
+
 // PyNewList(n uintptr) *Object
 func (b Builder) PyNewList(n Expr) (ret Expr) {
 	prog := b.Prog
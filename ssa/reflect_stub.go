@@ -0,0 +1,378 @@
+/*
+ * Copyright (c) 2025 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+import (
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/goplus/llvm"
+)
+
+const (
+	// FuncStubPrefix prefixes the runtime.FuncStub entry of a function type.
+	FuncStubPrefix = "__llgo_rstub."
+
+	// PromotedStubsPrefix prefixes the runtime.PromotedStubs entry of a
+	// method type.
+	PromotedStubsPrefix = "__llgo_rpromo."
+
+	// NumPromotedStubs is the number of trampolines in a runtime.PromotedStubs
+	// entry.
+	NumPromotedStubs = 4
+
+	reflectCallStub   = "__llgo_rcall."
+	reflectFuncStub   = "__llgo_rfunc."
+	reflectMethodStub = "__llgo_rmethod."
+)
+
+// Modes of runtime.PromotedStub, how a trampoline finds the receiver of the
+// method it calls from the address of the struct the method is promoted to.
+const (
+	promotedAddr  = iota // the address of the embedded field
+	promotedDeref        // the word stored in the embedded field
+	promotedIface        // the data word of the embedded interface
+)
+
+var (
+	tyUnsafePtr    = types.Typ[types.UnsafePointer]
+	tyUnsafePtrPtr = types.NewPointer(tyUnsafePtr)
+
+	// func(fn, ctx unsafe.Pointer, args *unsafe.Pointer, ret unsafe.Pointer)
+	reflectCallSig = types.NewSignatureType(nil, nil, nil, types.NewTuple(
+		types.NewParam(token.NoPos, nil, "fn", tyUnsafePtr),
+		types.NewParam(token.NoPos, nil, "ctx", tyUnsafePtr),
+		types.NewParam(token.NoPos, nil, "args", tyUnsafePtrPtr),
+		types.NewParam(token.NoPos, nil, "ret", tyUnsafePtr),
+	), nil, false)
+
+	// struct PromotedStub {
+	//	Code  unsafe.Pointer
+	//	Fn    unsafe.Pointer
+	//	Off   uintptr
+	//	Mode  uintptr
+	//	Index uintptr
+	// }
+	promotedStubType = types.NewStruct([]*types.Var{
+		types.NewField(token.NoPos, nil, "Code", tyUnsafePtr, false),
+		types.NewField(token.NoPos, nil, "Fn", tyUnsafePtr, false),
+		types.NewField(token.NoPos, nil, "Off", types.Typ[types.Uintptr], false),
+		types.NewField(token.NoPos, nil, "Mode", types.Typ[types.Uintptr], false),
+		types.NewField(token.NoPos, nil, "Index", types.Typ[types.Uintptr], false),
+	}, nil)
+
+	// func(args *unsafe.Pointer, ret unsafe.Pointer), the Go function a
+	// MakeFunc trampoline hands its arguments to.
+	reflectHandlerSig = types.NewSignatureType(nil, nil, nil, types.NewTuple(
+		types.NewParam(token.NoPos, nil, "args", tyUnsafePtrPtr),
+		types.NewParam(token.NoPos, nil, "ret", tyUnsafePtr),
+	), nil, false)
+)
+
+// SetReflectStubs sets whether to emit the reflect call and MakeFunc
+// trampolines of function types, so reflect doesn't need libffi.
+func (p Program) SetReflectStubs(enable bool) {
+	p.reflectStubs = enable
+}
+
+// reflectResults returns the struct type that holds the results of sig in
+// memory, in the layout reflect reads and writes them.
+func reflectResults(sig *types.Signature) *types.Struct {
+	out := sig.Results()
+	n := out.Len()
+	flds := make([]*types.Var, n)
+	for i := 0; i < n; i++ {
+		flds[i] = types.NewField(token.NoPos, nil, "r"+strconv.Itoa(i), out.At(i).Type(), false)
+	}
+	return types.NewStruct(flds, nil)
+}
+
+// abiFuncStub emits the runtime.FuncStub entry of the function type t whose
+// ABI type is typ:
+//
+//	struct FuncStub {
+//		Type    *abi.Type
+//		Call    unsafe.Pointer
+//		Closure unsafe.Pointer
+//	}
+func (p Package) abiFuncStub(t *types.Signature, name string, typ Expr) {
+	entry := FuncStubPrefix + name
+	if p.VarOf(entry) != nil {
+		return
+	}
+	prog := p.Prog
+	sig := funcType(prog, t).(*types.Signature)
+	call := p.reflectCall(sig, name)
+	fn := p.reflectFunc(sig, name)
+	vptr := prog.VoidPtr()
+	g := p.doNewVar(entry, prog.Pointer(prog.Struct(prog.AbiTypePtr(), vptr, vptr)))
+	g.impl.SetInitializer(prog.ctx.ConstStruct([]llvm.Value{
+		typ.impl, call.impl, fn.impl,
+	}, false))
+	g.impl.SetGlobalConstant(true)
+	g.impl.SetLinkage(llvm.WeakODRLinkage)
+}
+
+// reflectCall emits the trampoline reflect.Value.Call uses to call a closure
+// or method of signature sig. It loads the arguments from the pointers in
+// args, calls fn with ctx and stores the results into ret.
+func (p Package) reflectCall(sig *types.Signature, name string) Function {
+	stub := p.NewFunc(reflectCallStub+name, reflectCallSig, InC)
+	stub.impl.SetLinkage(llvm.LinkOnceODRLinkage)
+	prog := p.Prog
+	b := stub.MakeBody(1)
+	ctx := types.NewParam(token.NoPos, nil, closureCtx, tyUnsafePtr)
+	fn := b.PtrCast(prog.rawType(FuncAddCtx(ctx, sig)), stub.Param(0))
+	params := sig.Params()
+	n := params.Len()
+	args := make([]Expr, n+1)
+	args[0] = stub.Param(1)
+	for i := 0; i < n; i++ {
+		ptr := b.Load(b.Advance(stub.Param(2), prog.IntVal(uint64(i), prog.Int())))
+		t := prog.rawType(params.At(i).Type())
+		args[i+1] = b.Load(b.PtrCast(prog.Pointer(t), ptr))
+	}
+	ret := b.Call(fn, args...)
+	switch out := sig.Results(); out.Len() {
+	case 0:
+	case 1:
+		t := prog.rawType(out.At(0).Type())
+		b.Store(b.PtrCast(prog.Pointer(t), stub.Param(3)), ret)
+	default:
+		t := prog.rawType(reflectResults(sig))
+		ptr := b.PtrCast(prog.Pointer(t), stub.Param(3))
+		for i := 0; i < out.Len(); i++ {
+			b.Store(b.FieldAddr(ptr, i), b.Extract(ret, i))
+		}
+	}
+	b.Return()
+	return stub
+}
+
+// reflectFunc emits the code of the closures reflect.MakeFunc returns for
+// signature sig. Its ctx points to a func(args *unsafe.Pointer, ret
+// unsafe.Pointer), which gets pointers to the arguments and stores the
+// results into ret.
+func (p Package) reflectFunc(sig *types.Signature, name string) Function {
+	ctx := types.NewParam(token.NoPos, nil, closureCtx, tyUnsafePtr)
+	stub := p.NewFunc(reflectFuncStub+name, FuncAddCtx(ctx, sig), InC)
+	stub.impl.SetLinkage(llvm.LinkOnceODRLinkage)
+	prog := p.Prog
+	b := stub.MakeBody(1)
+	params := sig.Params()
+	n := params.Len()
+	vptr := prog.VoidPtr()
+	args := prog.Nil(prog.Pointer(vptr))
+	if n > 0 {
+		argv := b.AllocaT(prog.rawType(types.NewArray(tyUnsafePtr, int64(n))))
+		args = b.PtrCast(prog.Pointer(vptr), argv)
+		for i := 0; i < n; i++ {
+			arg := b.AllocaT(prog.rawType(params.At(i).Type()))
+			b.Store(arg, stub.Param(i+1))
+			b.Store(b.Advance(args, prog.IntVal(uint64(i), prog.Int())), b.PtrCast(vptr, arg))
+		}
+	}
+	out := sig.Results()
+	ret := prog.Nil(vptr)
+	var results Expr
+	switch out.Len() {
+	case 0:
+	case 1:
+		results = b.AllocaT(prog.rawType(out.At(0).Type()))
+		ret = b.PtrCast(vptr, results)
+	default:
+		results = b.AllocaT(prog.rawType(reflectResults(sig)))
+		ret = b.PtrCast(vptr, results)
+	}
+	handler := prog.Closure(reflectHandlerSig)
+	b.Call(b.Load(b.PtrCast(prog.Pointer(handler), stub.Param(0))), args, ret)
+	switch out.Len() {
+	case 0:
+		b.Return()
+	case 1:
+		b.Return(b.Load(results))
+	default:
+		rets := make([]Expr, out.Len())
+		for i := range rets {
+			rets[i] = b.Load(b.FieldAddr(results, i))
+		}
+		b.Return(rets...)
+	}
+	return stub
+}
+
+// abiPromotedStubs emits the runtime.PromotedStubs entry of the method type
+// t whose ABI type is typ:
+//
+//	struct PromotedStubs {
+//		Type  *abi.Type
+//		Stubs [NumPromotedStubs]PromotedStub
+//	}
+//
+// reflect.StructOf fills in the records of the entry, whose trampolines are
+// then the code of methods it promotes from embedded fields.
+func (p Package) abiPromotedStubs(t *types.Signature, name string, typ Expr) {
+	entry := PromotedStubsPrefix + name
+	if p.VarOf(entry) != nil {
+		return
+	}
+	prog := p.Prog
+	sig := funcType(prog, t).(*types.Signature)
+	g := p.doNewVar(entry, prog.Pointer(promotedStubsType(prog)))
+	vals := make([]llvm.Value, NumPromotedStubs+1)
+	vals[0] = typ.impl
+	uptr := prog.Uintptr()
+	for i := 0; i < NumPromotedStubs; i++ {
+		stub := p.promotedMethod(sig, name, g, i)
+		vals[i+1] = prog.ctx.ConstStruct([]llvm.Value{
+			stub.impl,
+			prog.Nil(prog.VoidPtr()).impl,
+			prog.IntVal(0, uptr).impl,
+			prog.IntVal(0, uptr).impl,
+			prog.IntVal(0, uptr).impl,
+		}, false)
+	}
+	g.impl.SetInitializer(prog.ctx.ConstStruct(vals, false))
+	g.impl.SetLinkage(llvm.WeakODRLinkage)
+}
+
+// promotedStubsType returns the type of runtime.PromotedStubs entries. The
+// stubs are laid out as fields rather than an array, so that each trampoline
+// addresses its own record without an index check.
+func promotedStubsType(prog Program) Type {
+	fields := make([]*types.Var, NumPromotedStubs+1)
+	fields[0] = types.NewField(token.NoPos, nil, "Type", tyUnsafePtr, false)
+	for i := 1; i < len(fields); i++ {
+		fields[i] = types.NewField(token.NoPos, nil, "S"+strconv.Itoa(i-1), promotedStubType, false)
+	}
+	return prog.rawType(types.NewStruct(fields, nil))
+}
+
+// promotedMethod emits the trampoline i of the runtime.PromotedStubs entry g
+// of methods of signature sig. Called with the address of a struct, it finds
+// the receiver as the record i of g says and calls the method with it.
+func (p Package) promotedMethod(sig *types.Signature, name string, g Global, i int) Function {
+	ctx := types.NewParam(token.NoPos, nil, closureCtx, tyUnsafePtr)
+	ftyp := FuncAddCtx(ctx, sig)
+	stub := p.NewFunc(reflectMethodStub+strconv.Itoa(i)+"."+name, ftyp, InC)
+	stub.impl.SetLinkage(llvm.LinkOnceODRLinkage)
+	prog := p.Prog
+	b := stub.MakeBody(1)
+	vptr := prog.VoidPtr()
+	vpptr := prog.VoidPtrPtr()
+	uptr := prog.Uintptr()
+	rec := b.FieldAddr(g.Expr, i+1)
+	p0 := b.Advance(stub.Param(0), b.Load(b.FieldAddr(rec, 2)))
+	rcvr := b.AllocaT(vptr)
+	b.Store(rcvr, p0)
+	fn := b.AllocaT(vptr)
+	b.Store(fn, b.Load(b.FieldAddr(rec, 1)))
+	mode := b.Load(b.FieldAddr(rec, 3))
+	b.IfThen(b.BinOp(token.EQL, mode, prog.IntVal(promotedDeref, uptr)), func() {
+		b.Store(rcvr, b.Load(b.PtrCast(vpptr, p0)))
+	})
+	b.IfThen(b.BinOp(token.EQL, mode, prog.IntVal(promotedIface, uptr)), func() {
+		words := b.PtrCast(vpptr, p0)
+		itab := b.PtrCast(vpptr, b.Load(words))
+		b.Store(rcvr, b.Load(b.Advance(words, prog.IntVal(1, prog.Int()))))
+		// the method table of an itab follows its inter, _type and hash words
+		idx := b.BinOp(token.ADD, b.Load(b.FieldAddr(rec, 4)), prog.IntVal(3, uptr))
+		b.Store(fn, b.Load(b.Advance(itab, idx)))
+	})
+	n := sig.Params().Len()
+	args := make([]Expr, n+1)
+	args[0] = b.Load(rcvr)
+	for j := 0; j < n; j++ {
+		args[j+1] = stub.Param(j + 1)
+	}
+	ret := b.Call(b.PtrCast(prog.rawType(ftyp), b.Load(fn)), args...)
+	switch out := sig.Results(); out.Len() {
+	case 0:
+		b.Return()
+	case 1:
+		b.Return(ret)
+	default:
+		rets := make([]Expr, out.Len())
+		for j := range rets {
+			rets[j] = b.Extract(ret, j)
+		}
+		b.Return(rets...)
+	}
+	return stub
+}
+
+// InitFuncStubs creates the function fname that stores the runtime.FuncStub
+// and runtime.PromotedStubs entries among the given global symbols into
+// runtime.funcStubs and runtime.promotedStubs. It returns nil if there are no
+// such entries.
+func (p Package) InitFuncStubs(fname string, globals []string) Function {
+	var stubs, promoted []string
+	for _, name := range globals {
+		if strings.HasPrefix(name, FuncStubPrefix) {
+			stubs = append(stubs, name)
+		} else if strings.HasPrefix(name, PromotedStubsPrefix) {
+			promoted = append(promoted, name)
+		}
+	}
+	if len(stubs) == 0 && len(promoted) == 0 {
+		return nil
+	}
+	initFn := p.NewFunc(fname, NoArgsNoRet, InC)
+	b := initFn.MakeBody(1)
+	prog := p.Prog
+	if len(stubs) > 0 {
+		vptr := prog.VoidPtr()
+		entry := prog.Struct(prog.AbiTypePtr(), vptr, vptr)
+		p.initStubList(b, fname, "funcStubs", entry, true, stubs)
+	}
+	if len(promoted) > 0 {
+		p.initStubList(b, fname+"$promoted", "promotedStubs", promotedStubsType(prog), false, promoted)
+	}
+	b.Return()
+	return initFn
+}
+
+// initStubList stores a slice of the addresses of the entries names, of type
+// entry, into the runtime variable rtVar.
+func (p Package) initStubList(b Builder, prefix, rtVar string, entry Type, constant bool, names []string) {
+	prog := p.Prog
+	vptr := prog.VoidPtr()
+	fields := make([]llvm.Value, len(names))
+	for i, name := range names {
+		g := p.doNewVar(name, prog.Pointer(entry))
+		g.impl.SetLinkage(llvm.ExternalLinkage)
+		g.impl.SetGlobalConstant(constant)
+		fields[i] = g.impl
+	}
+	atyp := prog.rawType(types.NewArray(tyUnsafePtr, int64(len(names))))
+	array := p.doNewVar(prefix+"$array", prog.Pointer(atyp))
+	array.Init(Expr{llvm.ConstArray(vptr.ll, fields), atyp})
+	array.impl.SetGlobalConstant(true)
+	size := uint64(len(names))
+	typ := prog.Slice(vptr)
+	slice := p.doNewVar(prefix+"$slice", prog.Pointer(typ))
+	slice.impl.SetInitializer(prog.ctx.ConstStruct([]llvm.Value{
+		array.impl,
+		prog.IntVal(size, prog.Int()).impl,
+		prog.IntVal(size, prog.Int()).impl,
+	}, false))
+	slice.impl.SetGlobalConstant(true)
+	g := p.NewVarEx(PkgRuntime+"."+rtVar, prog.Pointer(typ))
+	b.Store(g.Expr, b.Load(slice.Expr))
+}
@@ -65,6 +65,46 @@ func TestUnsafeString(t *testing.T) {
 	b.Return()
 }
 
+func TestDivideByZeroCheck(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Chdir("../../runtime")
+	defer os.Chdir(wd)
+	prog := NewProgram(nil)
+	prog.SetRuntime(func() *types.Package {
+		fset := token.NewFileSet()
+		imp := packages.NewImporter(fset)
+		pkg, _ := imp.Import(PkgRuntime)
+		return pkg
+	})
+	pkg := prog.NewPackage("foo", "foo")
+	tyInt := types.Typ[types.Int]
+	params := types.NewTuple(types.NewVar(0, nil, "x", tyInt), types.NewVar(0, nil, "y", tyInt))
+	rets := types.NewTuple(types.NewVar(0, nil, "", tyInt))
+	sig := types.NewSignatureType(nil, nil, nil, params, rets, false)
+
+	quo := pkg.NewFunc("quo", sig, InGo)
+	b := quo.MakeBody(1)
+	b.Return(b.BinOp(token.QUO, quo.Param(0), quo.Param(1)))
+
+	rem := pkg.NewFunc("rem3", sig, InGo)
+	b = rem.MakeBody(1)
+	b.Return(b.BinOp(token.REM, rem.Param(0), prog.Val(3)))
+
+	ir := pkg.String()
+	_, quoIR, _ := strings.Cut(ir, "@foo.quo(")
+	quoIR, remIR, _ := strings.Cut(quoIR, "@foo.rem3(")
+	remIR, _, _ = strings.Cut(remIR, "\n}")
+	if !strings.Contains(quoIR, "AssertDivideByZero") {
+		t.Errorf("x / y doesn't check y against zero:\n%s", ir)
+	}
+	if strings.Contains(remIR, "AssertDivideByZero") {
+		t.Errorf("x %% 3 checks 3 against zero:\n%s", ir)
+	}
+}
+
 func TestTooManyConditionalDefers(t *testing.T) {
 	wd, err := os.Getwd()
 	if err != nil {
@@ -798,6 +838,68 @@ func TestPyFunc(t *testing.T) {
 	}
 }
 
+func TestPyKwargsCall(t *testing.T) {
+	prog := NewProgram(nil)
+	py := types.NewPackage("foo", "foo")
+	o := types.NewTypeName(0, py, "Object", nil)
+	types.NewNamed(o, types.Typ[types.Int], nil)
+	py.Scope().Insert(o)
+	prog.SetPython(py)
+	objPtr := prog.PyObjectPtr().RawType()
+	params := types.NewTuple(
+		types.NewParam(0, nil, "x", objPtr),
+		types.NewParam(0, nil, NamePyKwargs, objPtr))
+	sig := types.NewSignatureType(nil, nil, nil, params, types.NewTuple(types.NewParam(0, nil, "", objPtr)), false)
+	if !HasNamePyKwargs(sig) || HasNamePyKwargs(NoArgsNoRet) {
+		t.Fatal("HasNamePyKwargs failed")
+	}
+	pkg := prog.NewPackage("bar", "foo/bar")
+	fn := pkg.PyNewFunc("__llgo_py.mod.fn", sig, true)
+	b := pkg.NewFunc("main", NoArgsNoRet, InGo).MakeBody(1)
+	x := prog.Nil(prog.PyObjectPtr())
+	b.Call(fn.Expr, x, x)
+	b.Return()
+	ir := pkg.String()
+	for _, want := range []string{"@PyTuple_New(i64 1)", "@PyObject_Call(ptr"} {
+		if !strings.Contains(ir, want) {
+			t.Fatalf("missing %q in:\n%s", want, ir)
+		}
+	}
+	if strings.Contains(ir, "PyObject_CallFunctionObjArgs") {
+		t.Fatalf("unexpected positional call in:\n%s", ir)
+	}
+}
+
+func TestPyKwargsVArgCall(t *testing.T) {
+	prog := NewProgram(nil)
+	py := types.NewPackage("foo", "foo")
+	o := types.NewTypeName(0, py, "Object", nil)
+	types.NewNamed(o, types.Typ[types.Int], nil)
+	py.Scope().Insert(o)
+	prog.SetPython(py)
+	objPtr := prog.PyObjectPtr().RawType()
+	params := types.NewTuple(
+		types.NewParam(0, nil, "x", objPtr),
+		types.NewParam(0, nil, NamePyKwargs, objPtr),
+		VArg())
+	sig := types.NewSignatureType(nil, nil, nil, params, types.NewTuple(types.NewParam(0, nil, "", objPtr)), true)
+	if !HasNamePyKwargs(sig) {
+		t.Fatal("HasNamePyKwargs failed")
+	}
+	pkg := prog.NewPackage("bar", "foo/bar")
+	fn := pkg.PyNewFunc("__llgo_py.mod.fn", sig, true)
+	b := pkg.NewFunc("main", NoArgsNoRet, InGo).MakeBody(1)
+	x := prog.Nil(prog.PyObjectPtr())
+	b.Call(fn.Expr, x, x, x, x)
+	b.Return()
+	ir := pkg.String()
+	for _, want := range []string{"@PyTuple_New(i64 3)", "@PyObject_Call(ptr"} {
+		if !strings.Contains(ir, want) {
+			t.Fatalf("missing %q in:\n%s", want, ir)
+		}
+	}
+}
+
 func TestVar(t *testing.T) {
 	prog := NewProgram(nil)
 	pkg := prog.NewPackage("bar", "foo/bar")
@@ -1449,3 +1551,108 @@ func TestInitAbiTypesForEmptySelection(t *testing.T) {
 		t.Fatalf("InitAbiTypesFor with empty selection = %v, want nil", fn)
 	}
 }
+
+func TestInlineAsmEx(t *testing.T) {
+	prog := NewProgram(&Target{GOOS: "linux", GOARCH: "amd64"})
+	pkg := prog.NewPackage("bar", "foo/bar")
+
+	f64 := types.Typ[types.Float64]
+	vec := types.NewArray(types.Typ[types.Float32], 4)
+	params := types.NewTuple(
+		types.NewVar(0, nil, "x", f64),
+		types.NewVar(0, nil, "p", types.NewPointer(types.Typ[types.Int64])),
+		types.NewVar(0, nil, "v", vec),
+	)
+	rets := types.NewTuple(types.NewVar(0, nil, "", f64))
+	sig := types.NewSignatureType(nil, nil, nil, params, rets, false)
+	fn := pkg.NewFunc("fn", sig, InGo)
+	b := fn.MakeBody(1)
+	outputs := []AsmOperand{{Name: "r", Type: prog.Float64()}, {Name: "w", Type: prog.Type(vec, InGo)}}
+	inputs := []AsmOperand{
+		{Name: "x", Value: fn.Param(0)},
+		{Name: "p", Value: fn.Param(1), Memory: true},
+		{Name: "v", Value: fn.Param(2)},
+	}
+	ret, err := b.InlineAsmEx("# ${0} ${1} ${2} ${3} ${4}", outputs, inputs, []string{"memory", "cc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ret) != 2 {
+		t.Fatalf("InlineAsmEx returned %d values, want 2", len(ret))
+	}
+	b.Return(ret[0])
+
+	ir := pkg.String()
+	want := `"=x,=x,x,*m,x,~{memory},~{cc}"(double %0, ptr elementtype(i64) %1, <4 x float> `
+	if !strings.Contains(ir, want) {
+		t.Fatalf("InlineAsmEx: %s not found in\n%s", want, ir)
+	}
+	if !strings.Contains(ir, "call { double, <4 x float> } asm sideeffect") {
+		t.Fatalf("InlineAsmEx: unexpected return type in\n%s", ir)
+	}
+
+	if _, err := b.InlineAsmEx("nop", nil, nil, []string{"{rax}"}); err == nil {
+		t.Fatal("InlineAsmEx: expected error for an invalid clobber")
+	}
+	str := []AsmOperand{{Name: "s", Type: prog.String()}}
+	if _, err := b.InlineAsmEx("nop", str, nil, nil); err == nil {
+		t.Fatal("InlineAsmEx: expected error for a string operand")
+	}
+}
+
+func TestInlineAsmExEntryAlloca(t *testing.T) {
+	prog := NewProgram(&Target{GOOS: "linux", GOARCH: "amd64"})
+	pkg := prog.NewPackage("bar", "foo/bar")
+
+	vec := types.NewArray(types.Typ[types.Float32], 4)
+	params := types.NewTuple(types.NewVar(0, nil, "v", vec))
+	sig := types.NewSignatureType(nil, nil, nil, params, nil, false)
+	fn := pkg.NewFunc("fn", sig, InGo)
+	b := fn.MakeBody(2)
+	b.Jump(fn.Block(1))
+	b.SetBlock(fn.Block(1))
+	inputs := []AsmOperand{{Name: "v", Value: fn.Param(0)}}
+	if _, err := b.InlineAsmEx("# ${0}", nil, inputs, nil); err != nil {
+		t.Fatal(err)
+	}
+	b.Jump(fn.Block(1))
+
+	ir := pkg.String()
+	entry, loop, ok := strings.Cut(ir, "_llgo_1:")
+	if !ok {
+		t.Fatalf("no _llgo_1 block in\n%s", ir)
+	}
+	if !strings.Contains(entry, "alloca [4 x float]") || strings.Contains(loop, "alloca") {
+		t.Fatalf("InlineAsmEx: operand memory not allocated in the entry block:\n%s", ir)
+	}
+}
+
+func TestInlineAsmExTargets(t *testing.T) {
+	tests := []struct {
+		target  Target
+		typ     types.Type
+		wantErr string
+	}{
+		{Target{GOOS: "linux", GOARCH: "arm64"}, types.Typ[types.Float32], ""},
+		{Target{GOOS: "linux", GOARCH: "arm64"}, types.NewArray(types.Typ[types.Uint8], 16), ""},
+		{Target{GOOS: "linux", GOARCH: "386"}, types.NewArray(types.Typ[types.Uint8], 32), "32-byte vector operands are not supported on 386"},
+		{Target{GOOS: "linux", GOARCH: "riscv64"}, types.NewArray(types.Typ[types.Int32], 4), "16-byte vector operands are not supported on riscv64"},
+		{Target{GOOS: "linux", GOARCH: "arm", Target: "esp32", LLVMTarget: "xtensa-esp32-none-elf"}, types.Typ[types.Float64], "no floating-point registers"},
+		{Target{GOOS: "wasip1", GOARCH: "wasm"}, types.Typ[types.Int32], "not supported on wasm"},
+	}
+	for _, tt := range tests {
+		prog := NewProgram(&tt.target)
+		pkg := prog.NewPackage("bar", "foo/bar")
+		fn := pkg.NewFunc("fn", NoArgsNoRet, InGo)
+		b := fn.MakeBody(1)
+		_, err := b.InlineAsmEx("nop", []AsmOperand{{Name: "x", Type: prog.Type(tt.typ, InGo)}}, nil, nil)
+		b.Return()
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s/%s %v: unexpected error: %v", tt.target.GOARCH, tt.target.LLVMTarget, tt.typ, err)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s/%s %v: got error %v, want %q", tt.target.GOARCH, tt.target.LLVMTarget, tt.typ, err, tt.wantErr)
+		}
+	}
+}
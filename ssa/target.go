@@ -29,6 +29,8 @@ type Target struct {
 	GOARCH string
 	GOARM  string // "5", "6", "7" (default)
 	Target string // target name from -target flag (e.g., "esp32", "arm7tdmi", "wasi")
+
+	LLVMTarget string // LLVM triple of the -target flag (e.g., "xtensa-esp32-none-elf")
 }
 
 func (p *Target) targetInfo() (llvm.TargetData, llvm.TargetMachine) {
@@ -80,6 +82,12 @@ func (p *Target) Spec() (spec TargetSpec) {
 		}
 	case "wasm":
 		llvmarch = "wasm32"
+	case "loong64":
+		llvmarch = "loongarch64"
+	case "ppc64le":
+		llvmarch = "powerpc64le"
+	case "mips64le":
+		llvmarch = "mips64el"
 	default:
 		llvmarch = goarch
 	}
@@ -108,6 +116,13 @@ func (p *Target) Spec() (spec TargetSpec) {
 		spec.Triple += "-gnu"
 	} else if goarch == "arm" {
 		spec.Triple += "-gnueabihf"
+	} else if llvmos == "linux" {
+		switch goarch {
+		case "loong64", "ppc64le", "s390x":
+			spec.Triple += "-gnu"
+		case "mips64", "mips64le":
+			spec.Triple += "-gnuabi64"
+		}
 	}
 	switch goarch {
 	case "386":
@@ -136,6 +151,15 @@ func (p *Target) Spec() (spec TargetSpec) {
 	case "wasm":
 		spec.CPU = "generic"
 		spec.Features = "+bulk-memory,+mutable-globals,+nontrapping-fptoint,+sign-ext"
+	case "loong64":
+		spec.CPU = "generic-la64"
+		spec.Features = "+64bit,+d,+f,+ual"
+	case "ppc64le":
+		spec.CPU = "pwr8" // minimum required by Go
+	case "s390x":
+		spec.CPU = "z13" // minimum required by Go
+	case "mips64", "mips64le":
+		spec.CPU = "mips64r2"
 	}
 	return
 }
@@ -0,0 +1,86 @@
+package test
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// Buffers retained across collections: pointer-free buffers are allocated
+// in memory the GC doesn't scan, so collections get cheaper as they grow,
+// while buffers of the same size holding pointers are fully scanned.
+const (
+	gcBenchBuffers = 64
+	gcBenchBufSize = 256 << 10
+)
+
+var gcBenchSink any
+
+func benchmarkGC(b *testing.B, retained any) {
+	gcBenchSink = retained
+	runtime.GC()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		runtime.GC()
+	}
+	b.StopTimer()
+	gcBenchSink = nil
+}
+
+func BenchmarkGCRetainedBytes(b *testing.B) {
+	bufs := make([][]byte, gcBenchBuffers)
+	for i := range bufs {
+		bufs[i] = make([]byte, gcBenchBufSize)
+	}
+	benchmarkGC(b, bufs)
+}
+
+func BenchmarkGCRetainedFloats(b *testing.B) {
+	bufs := make([][]float64, gcBenchBuffers)
+	for i := range bufs {
+		bufs[i] = make([]float64, gcBenchBufSize/8)
+	}
+	benchmarkGC(b, bufs)
+}
+
+func BenchmarkGCRetainedStrings(b *testing.B) {
+	strs := make([]string, gcBenchBuffers)
+	for i := range strs {
+		strs[i] = strings.Repeat("x", gcBenchBufSize)
+	}
+	benchmarkGC(b, strs)
+}
+
+// BenchmarkGCRetainedPointers is the baseline: the same amount of memory,
+// holding pointers, has to be scanned on every collection.
+func BenchmarkGCRetainedPointers(b *testing.B) {
+	bufs := make([][]*byte, gcBenchBuffers)
+	for i := range bufs {
+		bufs[i] = make([]*byte, gcBenchBufSize/8)
+	}
+	benchmarkGC(b, bufs)
+}
+
+func BenchmarkAppendBytes(b *testing.B) {
+	chunk := make([]byte, 4<<10)
+	for i := 0; i < b.N; i++ {
+		var buf []byte
+		for j := 0; j < 64; j++ {
+			buf = append(buf, chunk...)
+		}
+		gcBenchSink = buf
+	}
+	gcBenchSink = nil
+}
+
+func BenchmarkStringConcat(b *testing.B) {
+	part := strings.Repeat("y", 1<<10)
+	for i := 0; i < b.N; i++ {
+		s := ""
+		for j := 0; j < 64; j++ {
+			s += part
+		}
+		gcBenchSink = s
+	}
+	gcBenchSink = nil
+}
@@ -0,0 +1,174 @@
+//go:build darwin || linux
+
+package test
+
+import (
+	"errors"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+const netpollConns = 10000
+
+// raiseFileLimit raises the limit of open files to n, if allowed.
+func raiseFileLimit(n uint64) bool {
+	var lim syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &lim); err != nil {
+		return false
+	}
+	if lim.Cur >= n {
+		return true
+	}
+	if lim.Max < n {
+		return false
+	}
+	lim.Cur = n
+	return syscall.Setrlimit(syscall.RLIMIT_NOFILE, &lim) == nil
+}
+
+// TestNetpollManyConns keeps 10k connections open, blocks a reader on each
+// of them and then sends a message on each. The netpoller waits on all the
+// descriptors, but under llgo every goroutine is a thread of its own, so each
+// blocked reader still holds a thread, parked until its descriptor is ready.
+func TestNetpollManyConns(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping load test in short mode")
+	}
+	if !raiseFileLimit(2*netpollConns + 256) {
+		t.Skipf("can't open %d files", 2*netpollConns)
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	accepted := make(chan net.Conn, netpollConns)
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				close(accepted)
+				return
+			}
+			accepted <- c
+		}
+	}()
+
+	clients := make([]net.Conn, 0, netpollConns)
+	servers := make([]net.Conn, 0, netpollConns)
+	defer func() {
+		for _, c := range clients {
+			c.Close()
+		}
+		for _, c := range servers {
+			c.Close()
+		}
+	}()
+	for i := 0; i < netpollConns; i++ {
+		c, err := net.Dial("tcp", ln.Addr().String())
+		if err != nil {
+			t.Fatalf("dial %d: %v", i, err)
+		}
+		clients = append(clients, c)
+		servers = append(servers, <-accepted)
+	}
+
+	// Park a reader on every connection before any data is sent.
+	var ready, wg sync.WaitGroup
+	errs := make(chan error, netpollConns)
+	for i := range servers {
+		ready.Add(1)
+		wg.Add(1)
+		go func(c net.Conn) {
+			defer wg.Done()
+			buf := make([]byte, 4)
+			c.SetReadDeadline(time.Now().Add(30 * time.Second))
+			ready.Done()
+			if _, err := io.ReadFull(c, buf); err != nil {
+				errs <- err
+				return
+			}
+			if string(buf) != "ping" {
+				errs <- errors.New("unexpected message " + string(buf))
+			}
+		}(servers[i])
+	}
+	ready.Wait()
+	time.Sleep(200 * time.Millisecond)
+
+	start := time.Now()
+	for i, c := range clients {
+		if _, err := c.Write([]byte("ping")); err != nil {
+			t.Fatalf("write %d: %v", i, err)
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+	t.Logf("%d connections, one message each in %v", netpollConns, time.Since(start))
+}
+
+func TestNetpollDeadline(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	c, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	s, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	// An idle read times out at its deadline.
+	s.SetReadDeadline(time.Now().Add(50 * time.Millisecond))
+	start := time.Now()
+	_, err = s.Read(make([]byte, 1))
+	if !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Fatalf("Read error = %v, want deadline exceeded", err)
+	}
+	if d := time.Since(start); d < 40*time.Millisecond || d > 5*time.Second {
+		t.Fatalf("Read timed out after %v", d)
+	}
+
+	// Extending the deadline of a blocked read lets it complete.
+	s.SetReadDeadline(time.Now().Add(50 * time.Millisecond))
+	done := make(chan error, 1)
+	go func() {
+		_, err := s.Read(make([]byte, 1))
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	s.SetReadDeadline(time.Time{})
+	time.Sleep(100 * time.Millisecond)
+	c.Write([]byte{1})
+	if err := <-done; err != nil {
+		t.Fatalf("Read error = %v after clearing the deadline", err)
+	}
+
+	// Closing the connection unblocks a pending read.
+	go func() {
+		_, err := s.Read(make([]byte, 1))
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	s.Close()
+	if err := <-done; !errors.Is(err, net.ErrClosed) {
+		t.Fatalf("Read error = %v after Close, want %v", err, net.ErrClosed)
+	}
+}
@@ -274,3 +274,57 @@ func TestMultipleChannels(t *testing.T) {
 		}
 	}
 }
+
+func TestIgnoreThenNotify(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("Skipping on Windows and Plan 9")
+	}
+
+	// SIGUSR1 terminates the process unless it is ignored or caught.
+	signal.Ignore(syscall.SIGUSR1)
+	defer signal.Reset(syscall.SIGUSR1)
+	if err := syscall.Kill(os.Getpid(), syscall.SIGUSR1); err != nil {
+		t.Fatalf("Kill error: %v", err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGUSR1)
+	defer signal.Stop(c)
+	if signal.Ignored(syscall.SIGUSR1) {
+		t.Error("SIGUSR1 still ignored after Notify")
+	}
+	if err := syscall.Kill(os.Getpid(), syscall.SIGUSR1); err != nil {
+		t.Fatalf("Kill error: %v", err)
+	}
+	select {
+	case sig := <-c:
+		if sig != syscall.SIGUSR1 {
+			t.Errorf("Received signal %v, want SIGUSR1", sig)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for SIGUSR1")
+	}
+}
+
+func TestNotifySyncSignalFromKill(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("Skipping on Windows and Plan 9")
+	}
+
+	// A synchronous signal sent by kill is delivered like any other.
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGSEGV)
+	defer signal.Stop(c)
+	if err := syscall.Kill(os.Getpid(), syscall.SIGSEGV); err != nil {
+		t.Fatalf("Kill error: %v", err)
+	}
+	select {
+	case sig := <-c:
+		if sig != syscall.SIGSEGV {
+			t.Errorf("Received signal %v, want SIGSEGV", sig)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for SIGSEGV")
+	}
+}
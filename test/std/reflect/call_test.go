@@ -0,0 +1,146 @@
+package reflect_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type callPoint struct {
+	X, Y int16
+}
+
+func (p callPoint) Add(q callPoint) callPoint {
+	return callPoint{p.X + q.X, p.Y + q.Y}
+}
+
+func (p *callPoint) Scale(k int8) {
+	p.X *= int16(k)
+	p.Y *= int16(k)
+}
+
+func TestValueCallMixedArgs(t *testing.T) {
+	fn := func(b bool, i8 int8, f32 float32, s string, p callPoint, xs []int) (int8, float32, string, bool) {
+		return i8 + int8(len(xs)), f32 * 2, s + fmt.Sprint(p.X+p.Y), !b
+	}
+	out := reflect.ValueOf(fn).Call([]reflect.Value{
+		reflect.ValueOf(true),
+		reflect.ValueOf(int8(3)),
+		reflect.ValueOf(float32(1.5)),
+		reflect.ValueOf("sum="),
+		reflect.ValueOf(callPoint{1, 2}),
+		reflect.ValueOf([]int{1, 2, 3}),
+	})
+	if len(out) != 4 {
+		t.Fatalf("Call returned %d results, want 4", len(out))
+	}
+	if got := out[0].Int(); got != 6 {
+		t.Errorf("out[0] = %d, want 6", got)
+	}
+	if got := out[1].Float(); got != 3 {
+		t.Errorf("out[1] = %v, want 3", got)
+	}
+	if got := out[2].String(); got != "sum=3" {
+		t.Errorf("out[2] = %q, want %q", got, "sum=3")
+	}
+	if got := out[3].Bool(); got {
+		t.Errorf("out[3] = %v, want false", got)
+	}
+}
+
+func TestValueCallInterfaceArg(t *testing.T) {
+	fn := func(s fmt.Stringer, e error) string {
+		return s.String() + ":" + e.Error()
+	}
+	out := reflect.ValueOf(fn).Call([]reflect.Value{
+		reflect.ValueOf(MyIntStringer(1)),
+		reflect.ValueOf(fmt.Errorf("boom")),
+	})
+	if got := out[0].String(); got != "myint:boom" {
+		t.Errorf("Call = %q, want %q", got, "myint:boom")
+	}
+}
+
+func TestValueCallVariadic(t *testing.T) {
+	out := reflect.ValueOf(strings.Join).Call([]reflect.Value{
+		reflect.ValueOf([]string{"a", "b", "c"}),
+		reflect.ValueOf("-"),
+	})
+	if got := out[0].String(); got != "a-b-c" {
+		t.Errorf("Join = %q, want %q", got, "a-b-c")
+	}
+	sum := func(base int, xs ...int) int {
+		for _, x := range xs {
+			base += x
+		}
+		return base
+	}
+	out = reflect.ValueOf(sum).Call([]reflect.Value{
+		reflect.ValueOf(1), reflect.ValueOf(2), reflect.ValueOf(3),
+	})
+	if got := out[0].Int(); got != 6 {
+		t.Errorf("sum = %d, want 6", got)
+	}
+}
+
+func TestValueCallMethods(t *testing.T) {
+	p := &callPoint{1, 2}
+	v := reflect.ValueOf(p)
+	out := v.MethodByName("Add").Call([]reflect.Value{reflect.ValueOf(callPoint{10, 20})})
+	if got := out[0].Interface().(callPoint); got != (callPoint{11, 22}) {
+		t.Errorf("Add = %v, want {11 22}", got)
+	}
+	v.MethodByName("Scale").Call([]reflect.Value{reflect.ValueOf(int8(3))})
+	if *p != (callPoint{3, 6}) {
+		t.Errorf("after Scale p = %v, want {3 6}", *p)
+	}
+	var s fmt.Stringer = MyIntStringer(0)
+	out = reflect.ValueOf(&s).Elem().Method(0).Call(nil)
+	if got := out[0].String(); got != "myint" {
+		t.Errorf("String = %q, want %q", got, "myint")
+	}
+}
+
+func TestMakeFuncResults(t *testing.T) {
+	var swap func(int8, string, callPoint) (callPoint, string, int8)
+	fv := reflect.MakeFunc(reflect.TypeOf(swap), func(args []reflect.Value) []reflect.Value {
+		return []reflect.Value{args[2], args[1], args[0]}
+	})
+	reflect.ValueOf(&swap).Elem().Set(fv)
+	p, s, i := swap(-7, "mid", callPoint{4, 5})
+	if p != (callPoint{4, 5}) || s != "mid" || i != -7 {
+		t.Errorf("swap = %v, %q, %d, want {4 5}, \"mid\", -7", p, s, i)
+	}
+
+	var pred func(bool, float64) bool
+	fv = reflect.MakeFunc(reflect.TypeOf(pred), func(args []reflect.Value) []reflect.Value {
+		return []reflect.Value{reflect.ValueOf(args[0].Bool() && args[1].Float() > 1)}
+	})
+	reflect.ValueOf(&pred).Elem().Set(fv)
+	if !pred(true, 2.5) || pred(true, 0.5) || pred(false, 2.5) {
+		t.Error("pred returned wrong results")
+	}
+}
+
+func TestMakeFuncRetainsArgs(t *testing.T) {
+	var saved []reflect.Value
+	var f func(callPoint, []byte)
+	fv := reflect.MakeFunc(reflect.TypeOf(f), func(args []reflect.Value) []reflect.Value {
+		saved = args
+		return nil
+	})
+	reflect.ValueOf(&f).Elem().Set(fv)
+	f(callPoint{8, 9}, []byte("hi"))
+	f(callPoint{0, 0}, nil)
+	if saved[0].Interface().(callPoint) != (callPoint{}) || saved[1].Len() != 0 {
+		t.Errorf("saved args = %v, %v", saved[0], saved[1])
+	}
+	out := fv.Call([]reflect.Value{reflect.ValueOf(callPoint{1, 1}), reflect.ValueOf([]byte("x"))})
+	if len(out) != 0 {
+		t.Errorf("Call returned %d results, want 0", len(out))
+	}
+	if got := saved[0].Interface().(callPoint); got != (callPoint{1, 1}) {
+		t.Errorf("saved[0] = %v, want {1 1}", got)
+	}
+}
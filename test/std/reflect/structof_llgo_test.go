@@ -0,0 +1,117 @@
+//go:build llgo
+
+package reflect_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type adder interface {
+	Add(callPoint) callPoint
+}
+
+type scaler interface {
+	Scale(int8)
+}
+
+func TestStructOfEmbeddedInterface(t *testing.T) {
+	typ := reflect.StructOf([]reflect.StructField{
+		{Name: "ID", Type: reflect.TypeOf(0)},
+		{Name: "Stringer", Type: reflect.TypeOf((*fmt.Stringer)(nil)).Elem(), Anonymous: true},
+	})
+	if typ.NumMethod() != 1 {
+		t.Fatalf("NumMethod = %d, want 1", typ.NumMethod())
+	}
+	v := reflect.New(typ).Elem()
+	v.Field(0).SetInt(7)
+	v.Field(1).Set(reflect.ValueOf(MyIntStringer(0)))
+	s, ok := v.Interface().(fmt.Stringer)
+	if !ok {
+		t.Fatal("struct doesn't implement fmt.Stringer")
+	}
+	if got := s.String(); got != "myint" {
+		t.Errorf("String = %q, want %q", got, "myint")
+	}
+	if got := v.Method(0).Call(nil)[0].String(); got != "myint" {
+		t.Errorf("Method(0).Call = %q, want %q", got, "myint")
+	}
+}
+
+func TestStructOfEmbeddedValue(t *testing.T) {
+	typ := reflect.StructOf([]reflect.StructField{
+		{Name: "N", Type: reflect.TypeOf(int64(0))},
+		{Name: "CallPoint", Type: reflect.TypeOf(callPoint{}), Anonymous: true},
+	})
+	v := reflect.New(typ).Elem()
+	v.Field(1).Set(reflect.ValueOf(callPoint{1, 2}))
+	a, ok := v.Interface().(adder)
+	if !ok {
+		t.Fatal("struct doesn't implement adder")
+	}
+	if got := a.Add(callPoint{3, 4}); got != (callPoint{4, 6}) {
+		t.Errorf("Add = %v, want {4 6}", got)
+	}
+	if _, ok := v.Interface().(scaler); ok {
+		t.Error("struct shouldn't implement scaler with a value receiver")
+	}
+	fn := typ.Method(0).Func
+	if got := fn.Call([]reflect.Value{v, reflect.ValueOf(callPoint{1, 1})})[0].Interface(); got != (callPoint{2, 3}) {
+		t.Errorf("Method(0).Func = %v, want {2 3}", got)
+	}
+}
+
+func TestStructOfEmbeddedPointer(t *testing.T) {
+	typ := reflect.StructOf([]reflect.StructField{
+		{Name: "Name", Type: reflect.TypeOf("")},
+		{Name: "CallPoint", Type: reflect.TypeOf(&callPoint{}), Anonymous: true},
+	})
+	if typ.NumMethod() != 2 {
+		t.Fatalf("NumMethod = %d, want 2", typ.NumMethod())
+	}
+	p := &callPoint{1, 1}
+	v := reflect.New(typ).Elem()
+	v.Field(0).SetString("p")
+	v.Field(1).Set(reflect.ValueOf(p))
+	s, ok := v.Interface().(scaler)
+	if !ok {
+		t.Fatal("struct doesn't implement scaler")
+	}
+	s.Scale(5)
+	if *p != (callPoint{5, 5}) {
+		t.Errorf("after Scale p = %v, want {5 5}", *p)
+	}
+	m := v.MethodByName("Add")
+	if got := m.Call([]reflect.Value{reflect.ValueOf(callPoint{1, 2})})[0].Interface(); got != (callPoint{6, 7}) {
+		t.Errorf("Add = %v, want {6 7}", got)
+	}
+}
+
+func TestStructOfAmbiguousMethods(t *testing.T) {
+	typ := reflect.StructOf([]reflect.StructField{
+		{Name: "MyIntStringer", Type: reflect.TypeOf(MyIntStringer(0)), Anonymous: true},
+		{Name: "Stringer", Type: reflect.TypeOf((*fmt.Stringer)(nil)).Elem(), Anonymous: true},
+	})
+	if typ.NumMethod() != 0 {
+		t.Errorf("NumMethod = %d, want 0 for ambiguous String", typ.NumMethod())
+	}
+}
+
+func TestStructOfEmbeddedCached(t *testing.T) {
+	fields := []reflect.StructField{
+		{Name: "N", Type: reflect.TypeOf(int64(0))},
+		{Name: "CallPoint", Type: reflect.TypeOf(callPoint{}), Anonymous: true},
+	}
+	typ := reflect.StructOf(fields)
+	for i := 0; i < 100; i++ {
+		if again := reflect.StructOf(fields); again != typ {
+			t.Fatalf("StructOf returned %v, then a different %v", typ, again)
+		}
+	}
+	v := reflect.New(typ).Elem()
+	v.Field(1).Set(reflect.ValueOf(callPoint{1, 2}))
+	if got := v.Interface().(adder).Add(callPoint{1, 1}); got != (callPoint{2, 3}) {
+		t.Errorf("Add = %v, want {2 3}", got)
+	}
+}
@@ -0,0 +1,75 @@
+package runtime_test
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// runDeadlock runs the test named test in a new process, where it does what
+// the TEST_DEADLOCK environment variable tells. The alarm timer of -test.timeout
+// would keep the process from deadlocking.
+func runDeadlock(test, what string) (string, error) {
+	cmd := exec.Command(os.Args[0], "-test.run=^"+test+"$", "-test.timeout=0")
+	cmd.Env = append(os.Environ(), "TEST_DEADLOCK="+what)
+	out, err := cmd.CombinedOutput()
+	return string(out), err
+}
+
+func TestDeadlock(t *testing.T) {
+	switch os.Getenv("TEST_DEADLOCK") {
+	case "chan":
+		<-make(chan int)
+	case "select":
+		go func() {
+			select {}
+		}()
+		var mu sync.Mutex
+		mu.Lock()
+		mu.Lock()
+	case "waitgroup":
+		var wg sync.WaitGroup
+		wg.Add(1)
+		wg.Wait()
+	}
+
+	tests := []struct {
+		what  string
+		wants []string
+	}{
+		{"chan", []string{"[chan receive]:"}},
+		{"select", []string{"[select (no cases)]:", "[sync.Mutex.Lock]:"}},
+		{"waitgroup", []string{"[sync.WaitGroup.Wait]:"}},
+	}
+	for _, tt := range tests {
+		out, err := runDeadlock("TestDeadlock", tt.what)
+		if err == nil {
+			t.Errorf("%s: deadlock didn't fail:\n%s", tt.what, out)
+			continue
+		}
+		for _, want := range append([]string{"fatal error: all goroutines are asleep - deadlock!"}, tt.wants...) {
+			if !strings.Contains(out, want) {
+				t.Errorf("%s: output doesn't contain %q:\n%s", tt.what, want, out)
+			}
+		}
+	}
+}
+
+func TestNoDeadlockWithTimer(t *testing.T) {
+	if os.Getenv("TEST_DEADLOCK") == "timer" {
+		done := make(chan int)
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			done <- 1
+		}()
+		<-done
+		<-time.After(50 * time.Millisecond)
+		return
+	}
+	if out, err := runDeadlock("TestNoDeadlockWithTimer", "timer"); err != nil {
+		t.Fatalf("%v:\n%s", err, out)
+	}
+}
@@ -0,0 +1,167 @@
+package debug_test
+
+import (
+	"math"
+	"os"
+	"os/exec"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"unsafe"
+)
+
+func TestSetGCPercent(t *testing.T) {
+	old := debug.SetGCPercent(50)
+	defer debug.SetGCPercent(old)
+
+	if got := debug.SetGCPercent(-1); got != 50 {
+		t.Errorf("SetGCPercent returned %d, want 50", got)
+	}
+	// Collection is off: allocations must still succeed.
+	var keep [][]byte
+	for i := 0; i < 64; i++ {
+		keep = append(keep, make([]byte, 4<<10))
+	}
+	runtime.KeepAlive(keep)
+
+	if got := debug.SetGCPercent(200); got != -1 {
+		t.Errorf("SetGCPercent returned %d, want -1", got)
+	}
+	runtime.GC()
+}
+
+func TestSetMemoryLimit(t *testing.T) {
+	old := debug.SetMemoryLimit(math.MaxInt64)
+	defer debug.SetMemoryLimit(old)
+
+	if got := debug.SetMemoryLimit(1 << 40); got != math.MaxInt64 {
+		t.Errorf("SetMemoryLimit returned %d, want %d", got, int64(math.MaxInt64))
+	}
+	// A negative limit queries the limit without changing it.
+	if got := debug.SetMemoryLimit(-1); got != 1<<40 {
+		t.Errorf("SetMemoryLimit(-1) returned %d, want %d", got, int64(1<<40))
+	}
+	if got := debug.SetMemoryLimit(-1); got != 1<<40 {
+		t.Errorf("SetMemoryLimit changed the limit to %d", got)
+	}
+}
+
+var limitSink [][]byte
+
+// TestSmallMemoryLimit checks that the memory limit is a soft one: a limit
+// below the live heap makes the GC work harder, but allocations still succeed.
+func TestSmallMemoryLimit(t *testing.T) {
+	if os.Getenv("TEST_SMALL_MEMORY_LIMIT") == "1" {
+		for _, limit := range []int64{0, 1 << 10} {
+			debug.SetMemoryLimit(limit)
+			limitSink = nil
+			for i := 0; i < 256; i++ {
+				limitSink = append(limitSink, make([]byte, 16<<10))
+				_ = make([]byte, 64<<10) // garbage
+			}
+		}
+		println("ok")
+		return
+	}
+	cmd := exec.Command(os.Args[0], "-test.run=^TestSmallMemoryLimit$")
+	cmd.Env = append(os.Environ(), "TEST_SMALL_MEMORY_LIMIT=1", "GOMEMLIMIT=1KiB")
+	out, err := cmd.CombinedOutput()
+	if err != nil || !strings.Contains(string(out), "ok") {
+		t.Fatalf("allocating above the memory limit failed: %v\n%s", err, out)
+	}
+}
+
+func TestFreeOSMemory(t *testing.T) {
+	buf := make([]byte, 1<<20)
+	runtime.KeepAlive(buf)
+	debug.FreeOSMemory()
+}
+
+//go:noinline
+func recurse(n int) int {
+	var buf [64]byte
+	buf[n%len(buf)] = byte(n)
+	if n == 0 {
+		return int(buf[0])
+	}
+	return recurse(n-1) + int(buf[n%len(buf)])
+}
+
+func TestSetMaxStack(t *testing.T) {
+	old := debug.SetMaxStack(1 << 20)
+	defer debug.SetMaxStack(old)
+	// the default limit of Go, though llgo reserves less for a goroutine
+	want := 1000000000
+	if strconv.IntSize == 32 {
+		want = 250000000
+	}
+	if old != want {
+		t.Errorf("default SetMaxStack = %d, want %d", old, want)
+	}
+
+	done := make(chan int)
+	go func() {
+		done <- recurse(1000)
+	}()
+	<-done
+	if got := debug.SetMaxStack(old); got != 1<<20 {
+		t.Errorf("SetMaxStack returned %d, want %d", got, 1<<20)
+	}
+}
+
+func TestStackOverflow(t *testing.T) {
+	if os.Getenv("TEST_STACK_OVERFLOW") == "1" {
+		debug.SetMaxStack(1 << 20)
+		done := make(chan int)
+		go func() {
+			done <- recurse(math.MaxInt32)
+		}()
+		<-done
+		return
+	}
+	cmd := exec.Command(os.Args[0], "-test.run=^TestStackOverflow$")
+	cmd.Env = append(os.Environ(), "TEST_STACK_OVERFLOW=1")
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("stack overflow didn't fail:\n%s", out)
+	}
+	for _, want := range []string{"goroutine stack exceeds 1048576-byte limit", "fatal error: stack overflow"} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("output doesn't contain %q:\n%s", want, out)
+		}
+	}
+}
+
+var faultSink byte
+
+func TestSetPanicOnFault(t *testing.T) {
+	if runtime.GOOS != "linux" && runtime.GOOS != "darwin" {
+		t.Skip("needs mmap")
+	}
+	mem, err := syscall.Mmap(-1, 0, os.Getpagesize(), syscall.PROT_NONE, syscall.MAP_ANON|syscall.MAP_PRIVATE)
+	if err != nil {
+		t.Fatalf("Mmap error: %v", err)
+	}
+	defer syscall.Munmap(mem)
+
+	old := debug.SetPanicOnFault(true)
+	defer debug.SetPanicOnFault(old)
+	defer func() {
+		r := recover()
+		if _, ok := r.(runtime.Error); !ok {
+			t.Fatalf("recovered %v, want a runtime.Error", r)
+		}
+		addr, ok := r.(interface{ Addr() uintptr })
+		if !ok {
+			t.Fatalf("recovered %v without an address", r)
+		}
+		if got, want := addr.Addr(), uintptr(unsafe.Pointer(&mem[0])); got != want {
+			t.Errorf("Addr = %#x, want %#x", got, want)
+		}
+	}()
+	faultSink = *(*byte)(unsafe.Pointer(&mem[0]))
+	t.Fatal("reading a PROT_NONE page didn't fault")
+}
@@ -0,0 +1,36 @@
+package runtime_test
+
+import (
+	"runtime"
+	"testing"
+)
+
+var zero int
+
+func TestDivideByZero(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		f    func() int
+	}{
+		{"int", func() int { return 7 / zero }},
+		{"int%", func() int { return 7 % zero }},
+		{"int8", func() int { return int(int8(-7) / int8(zero)) }},
+		{"uint32", func() int { return int(uint32(7) / uint32(zero)) }},
+		{"uint64%", func() int { return int(uint64(7) % uint64(zero)) }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				err, ok := r.(runtime.Error)
+				if !ok {
+					t.Fatalf("recovered %#v, want a runtime.Error", r)
+				}
+				if got, want := err.Error(), "runtime error: integer divide by zero"; got != want {
+					t.Errorf("Error() = %q, want %q", got, want)
+				}
+			}()
+			tt.f()
+			t.Fatal("dividing by zero didn't panic")
+		})
+	}
+}
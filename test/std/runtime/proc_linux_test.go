@@ -0,0 +1,39 @@
+package runtime_test
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// A goroutine that exits while locked to its thread takes the thread with
+// it. The lock is held after a nested LockOSThread is undone.
+func TestLockOSThread(t *testing.T) {
+	tid := syscall.Getpid()
+	// the main thread can't exit: Go wedges it instead
+	for i := 0; i < 10 && tid == syscall.Getpid(); i++ {
+		tids := make(chan int)
+		go func() {
+			runtime.LockOSThread()
+			runtime.LockOSThread()
+			runtime.UnlockOSThread()
+			tids <- syscall.Gettid()
+		}()
+		tid = <-tids
+	}
+	if tid == syscall.Getpid() {
+		t.Skip("goroutines ran on the main thread")
+	}
+	task := fmt.Sprintf("/proc/self/task/%d", tid)
+	for start := time.Now(); ; time.Sleep(time.Millisecond) {
+		if _, err := os.Stat(task); os.IsNotExist(err) {
+			return
+		}
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("thread %d outlived the goroutine locked to it", tid)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+//go:build llgo
+
+package runtime_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Goroutines aren't preempted in llgo: once GOMAXPROCS is set, ones that
+// never yield run at most GOMAXPROCS at a time, however long they run.
+func TestGOMAXPROCSBusyGoroutines(t *testing.T) {
+	const procs = 2
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(procs))
+
+	var running, peak atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 4*procs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			for start := time.Now(); time.Since(start) < 30*time.Millisecond; {
+			}
+			running.Add(-1)
+		}()
+	}
+	wg.Wait()
+	if p := peak.Load(); p > procs {
+		t.Errorf("%d busy goroutines ran at once, want at most %d", p, procs)
+	}
+}
+
+// Until GOMAXPROCS is set, goroutines that spin waiting for others don't keep
+// them from running, even with more spinners than CPUs.
+func TestSpinWait(t *testing.T) {
+	if os.Getenv("TEST_SPIN_WAIT") == "1" {
+		var flag atomic.Bool
+		var wg sync.WaitGroup
+		for i := 0; i < 2*runtime.NumCPU(); i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for !flag.Load() {
+				}
+			}()
+		}
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			flag.Store(true)
+		}()
+		wg.Wait()
+		println("ok")
+		return
+	}
+	// a child process, as the tests here set GOMAXPROCS
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, os.Args[0], "-test.run=^TestSpinWait$")
+	for _, kv := range os.Environ() {
+		if !strings.HasPrefix(kv, "GOMAXPROCS=") {
+			cmd.Env = append(cmd.Env, kv)
+		}
+	}
+	cmd.Env = append(cmd.Env, "TEST_SPIN_WAIT=1")
+	out, err := cmd.CombinedOutput()
+	if ctx.Err() != nil {
+		t.Fatalf("goroutines spinning on a flag hung\n%s", out)
+	}
+	if err != nil || !strings.Contains(string(out), "ok") {
+		t.Fatalf("spinning on a flag failed: %v\n%s", err, out)
+	}
+}
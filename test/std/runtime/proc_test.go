@@ -0,0 +1,80 @@
+package runtime_test
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGOMAXPROCS(t *testing.T) {
+	old := runtime.GOMAXPROCS(0)
+	if old < 1 {
+		t.Fatalf("GOMAXPROCS(0) = %d, want a positive value", old)
+	}
+	defer runtime.GOMAXPROCS(old)
+
+	if got := runtime.GOMAXPROCS(3); got != old {
+		t.Errorf("GOMAXPROCS(3) = %d, want %d", got, old)
+	}
+	if got := runtime.GOMAXPROCS(-1); got != 3 {
+		t.Errorf("GOMAXPROCS(-1) = %d, want 3", got)
+	}
+}
+
+func TestGOMAXPROCSBoundsRunning(t *testing.T) {
+	const procs = 2
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(procs))
+
+	var running, peak atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 4*procs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				n := running.Add(1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+				for start := time.Now(); time.Since(start) < 50*time.Microsecond; {
+				}
+				running.Add(-1)
+				runtime.Gosched()
+			}
+		}()
+	}
+	wg.Wait()
+	if p := peak.Load(); p > procs {
+		t.Errorf("%d goroutines ran at once, want at most %d", p, procs)
+	}
+}
+
+func TestGOMAXPROCSBlockedGoroutines(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+
+	// Goroutines blocked in channel operations don't hold on to the only
+	// proc.
+	const n = 8
+	start := make(chan struct{})
+	done := make(chan int, n)
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			<-start
+			done <- i
+		}(i)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(start)
+	for i := 0; i < n; i++ {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("only %d of %d goroutines finished", i, n)
+		}
+	}
+}
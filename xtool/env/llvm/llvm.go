@@ -44,7 +44,7 @@ const (
 // checks the LLVM_CONFIG environment variable first, then searches in PATH. If
 // not found, it returns [ldLLVMConfigBin] as a last resort.
 func defaultLLVMConfigBin() string {
-	bin := os.Getenv("LLVM_CONFIG")
+	bin := env.Getenv("LLVM_CONFIG")
 	if bin != "" {
 		return bin
 	}
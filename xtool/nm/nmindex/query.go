@@ -19,11 +19,44 @@ package nmindex
 import (
 	"bufio"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/goplus/llgo/xtool/nm"
 )
 
+// DefaultDir returns the directory of the index built by "nmindex mk".
+func DefaultDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".llgo", "nmindex"), nil
+}
+
+// LibName returns the name to link the library arFile by -l, eg. "foo" for
+// /usr/lib/libfoo.so.1, or "" if arFile is not named as a library.
+func LibName(arFile string) string {
+	name := filepath.Base(arFile)
+	if i := strings.Index(name, ".so"); i > 0 && (len(name) == i+3 || name[i+3] == '.') {
+		name = name[:i]
+	} else {
+		switch ext := filepath.Ext(name); ext {
+		case ".a", ".dylib", ".tbd":
+			name = strings.TrimSuffix(name, ext)
+		case ".lib", ".dll":
+			return strings.TrimSuffix(name, ext)
+		default:
+			return ""
+		}
+	}
+	name, ok := strings.CutPrefix(name, "lib")
+	if !ok {
+		return ""
+	}
+	return name
+}
+
 // MatchedItem represents a matched item
 type MatchedItem struct {
 	ObjFile string
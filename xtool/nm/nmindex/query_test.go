@@ -0,0 +1,51 @@
+package nmindex
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goplus/llgo/xtool/nm"
+)
+
+func TestLibName(t *testing.T) {
+	for in, want := range map[string]string{
+		"/usr/lib/libfoo.so":        "foo",
+		"/usr/lib/libfoo.so.1.2":    "foo",
+		"/usr/lib/libfoo.a":         "foo",
+		"/usr/lib/libz.1.dylib":     "z.1",
+		"/usr/lib/libSystem.B.tbd":  "System.B",
+		"C:/lib/foo.lib":            "foo",
+		"/usr/lib/crt1.o":           "",
+		"/usr/lib/foo.a":            "",
+		"/usr/lib/libsomething.sox": "",
+	} {
+		if got := LibName(in); got != want {
+			t.Errorf("LibName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestQuery(t *testing.T) {
+	dir := t.TempDir()
+	idx := "nm /usr/lib/libfoo.a\nfile foo.o\nT foo_open\nD foo_version\nfile bar.o\nT foo_close\n"
+	if err := os.WriteFile(filepath.Join(dir, "foo.a-x.pub"), []byte(idx), 0644); err != nil {
+		t.Fatal(err)
+	}
+	files, err := Query(dir, "foo_open")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].ArFile != "/usr/lib/libfoo.a" || len(files[0].Items) != 1 {
+		t.Fatalf("Query(foo_open) = %v", files)
+	}
+	if item := files[0].Items[0]; item.ObjFile != "foo.o" || item.Type != nm.Text {
+		t.Errorf("Query(foo_open) item = %+v", item)
+	}
+	if files, _ = Query(dir, "foo_*"); len(files) != 1 || len(files[0].Items) != 3 {
+		t.Errorf("Query(foo_*) = %v", files)
+	}
+	if files, _ = Query(dir, "bar"); len(files) != 0 {
+		t.Errorf("Query(bar) = %v", files)
+	}
+}